@@ -3,8 +3,8 @@ module github.com/aadhi0612/iritamod
 go 1.18
 
 require (
-	github.com/aadhi0612/iritamod v1.4.0
 	github.com/cosmos/cosmos-sdk v0.45.1
+	github.com/cosmos/ibc-go/v2 v2.0.3
 	github.com/gogo/protobuf v1.3.3
 	github.com/golang/protobuf v1.5.2
 	github.com/gorilla/mux v1.8.0
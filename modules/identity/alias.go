@@ -8,33 +8,58 @@ import (
 )
 
 const (
-	ModuleName              = types.ModuleName
-	StoreKey                = types.StoreKey
-	QuerierRoute            = types.QuerierRoute
-	RouterKey               = types.RouterKey
-	QueryIdentity           = types.QueryIdentity
-	EventTypeCreateIdentity = types.EventTypeCreateIdentity
-	EventTypeUpdateIdentity = types.EventTypeUpdateIdentity
-	AttributeValueCategory  = types.AttributeValueCategory
-	AttributeKeyID          = types.AttributeKeyID
-	AttributeKeyOwner       = types.AttributeKeyOwner
-	DoNotModifyDesc         = types.DoNotModifyDesc
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	QuerierRoute                 = types.QuerierRoute
+	RouterKey                    = types.RouterKey
+	QueryIdentity                = types.QueryIdentity
+	EventTypeCreateIdentity      = types.EventTypeCreateIdentity
+	EventTypeUpdateIdentity      = types.EventTypeUpdateIdentity
+	EventTypeVerifySignature     = types.EventTypeVerifySignature
+	EventTypeRegisterDataSchema  = types.EventTypeRegisterDataSchema
+	EventTypeAddTrustedIssuer    = types.EventTypeAddTrustedIssuer
+	EventTypeRemoveTrustedIssuer = types.EventTypeRemoveTrustedIssuer
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeKeyID               = types.AttributeKeyID
+	AttributeKeyOwner            = types.AttributeKeyOwner
+	AttributeKeyPubKey           = types.AttributeKeyPubKey
+	AttributeKeyDataHash         = types.AttributeKeyDataHash
+	AttributeKeySchemaID         = types.AttributeKeySchemaID
+	AttributeKeyIssuerID         = types.AttributeKeyIssuerID
+	AttributeKeyOperator         = types.AttributeKeyOperator
+	DoNotModifyDesc              = types.DoNotModifyDesc
 )
 
 var (
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	ModuleCdc           = types.ModuleCdc
-	DefaultGenesisState = types.DefaultGenesisState
-	ValidateGenesis     = types.ValidateGenesis
-	NewGenesisState     = types.NewGenesisState
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	NewValidateIdentityDecorator    = keeper.NewValidateIdentityDecorator
+	NewIdentityRequirementDecorator = keeper.NewIdentityRequirementDecorator
+	NewSigVerificationDecorator     = keeper.NewSigVerificationDecorator
+	NewExtensionOptionsDecorator    = keeper.NewExtensionOptionsDecorator
+	NewSigVerificationGasConsumer   = keeper.NewSigVerificationGasConsumer
+	ModuleCdc                       = types.ModuleCdc
+	DefaultGenesisState             = types.DefaultGenesisState
+	ValidateGenesis                 = types.ValidateGenesis
+	NewGenesisState                 = types.NewGenesisState
+	DefaultParams                   = types.DefaultParams
 )
 
 type (
-	Keeper              = keeper.Keeper
-	Identity            = types.Identity
-	GenesisState        = types.GenesisState
-	MsgCreateIdentity   = types.MsgCreateIdentity
-	MsgUpdateIdentity   = types.MsgUpdateIdentity
-	QueryIdentityParams = types.QueryIdentityParams
+	Keeper                 = keeper.Keeper
+	Identity               = types.Identity
+	GenesisState           = types.GenesisState
+	MsgCreateIdentity      = types.MsgCreateIdentity
+	MsgUpdateIdentity      = types.MsgUpdateIdentity
+	MsgVerifySignature     = types.MsgVerifySignature
+	MsgRegisterDataSchema  = types.MsgRegisterDataSchema
+	DataSchema             = types.DataSchema
+	MsgAddTrustedIssuer    = types.MsgAddTrustedIssuer
+	MsgRemoveTrustedIssuer = types.MsgRemoveTrustedIssuer
+	TrustedIssuer          = types.TrustedIssuer
+	CertificateStatus      = types.CertificateStatus
+	IdentityRequirement    = types.IdentityRequirement
+	IdentityRequirements   = types.IdentityRequirements
+	Params                 = types.Params
+	QueryIdentityParams    = types.QueryIdentityParams
 )
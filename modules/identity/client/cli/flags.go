@@ -18,8 +18,9 @@ const (
 
 // common flagsets to add to various functions
 var (
-	FsCreateIdentity = flag.NewFlagSet("", flag.ContinueOnError)
-	FsUpdateIdentity = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateIdentity  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateIdentity  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsVerifySignature = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -35,4 +36,6 @@ func init() {
 	FsUpdateIdentity.String(FlagCertificateFile, "", "file path of the X.509 certificate to be added")
 	FsUpdateIdentity.String(FlagCredentials, types.DoNotModifyDesc, "uri pointing to credentials of the identity")
 	FsUpdateIdentity.String(FlagData, types.DoNotModifyDesc, "custom data of the identity")
+
+	FsVerifySignature.String(FlagPubKeyAlgo, "", "algorithm of the public key used to verify the signature (rsa|dsa|ecdsa|ed25519|sm2)")
 }
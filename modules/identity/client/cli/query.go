@@ -25,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 
 	identityQueryCmd.AddCommand(
 		GetCmdQueryIdentity(),
+		GetCmdQueryDataSchema(),
+		GetCmdQueryTrustedIssuers(),
+		GetCmdQueryParams(),
 	)
 
 	return identityQueryCmd
@@ -35,7 +38,7 @@ func GetCmdQueryIdentity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "identity [id]",
 		Short:   "Query an identity",
-		Long:    "Query details of an identity with the specified ID.",
+		Long:    "Query details of an identity with the specified ID, including the validity statuses of the certificates.",
 		Example: fmt.Sprintf("$ %s query identity identity <id>", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -49,7 +52,95 @@ func GetCmdQueryIdentity() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintProto(res.Identity)
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDataSchema implements the query data schema command.
+func GetCmdQueryDataSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schema [id]",
+		Short:   "Query a data schema",
+		Long:    "Query the JSON schema for the identity data with the specified ID.",
+		Example: fmt.Sprintf("$ %s query identity schema <id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DataSchema(context.Background(), &types.QueryDataSchemaRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.DataSchema)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustedIssuers implements the query trusted issuers command.
+func GetCmdQueryTrustedIssuers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trusted-issuers",
+		Short:   "Query the trusted issuers",
+		Long:    "Query the trusted issuer CAs of the identity certificates.",
+		Example: fmt.Sprintf("$ %s query identity trusted-issuers", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TrustedIssuers(
+				context.Background(),
+				&types.QueryTrustedIssuersRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trusted issuers")
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current identity parameters",
+		Long:    "Query the current parameters of the identity module.",
+		Example: fmt.Sprintf("$ %s query identity params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
@@ -30,6 +30,10 @@ func NewTxCmd() *cobra.Command {
 	identityTxCmd.AddCommand(
 		NewCreateIdentityCmd(),
 		NewUpdateIdentityCmd(),
+		NewVerifySignatureCmd(),
+		NewRegisterDataSchemaCmd(),
+		NewAddTrustedIssuerCmd(),
+		NewRemoveTrustedIssuerCmd(),
 	)
 
 	return identityTxCmd
@@ -177,6 +181,176 @@ func NewUpdateIdentityCmd() *cobra.Command {
 	return cmd
 }
 
+// NewVerifySignatureCmd implements verifying a signature with an identity command
+func NewVerifySignatureCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-signature [id] [data] [signature]",
+		Short: "Verify a signature with the public keys of an identity",
+		Long:  "Verify the hex encoded signature of the given data with the public keys of the specified algorithm registered for an identity.",
+		Example: fmt.Sprintf(
+			"$ %s tx identity verify-signature <id> <data> <signature> "+
+				"--pubkey-algo=<pubkey-algorithm> "+
+				"--from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			id, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			signature, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			algo, err := cmd.Flags().GetString(FlagPubKeyAlgo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVerifySignature(id, types.PubKeyAlgorithmFromString(algo), []byte(args[1]), signature, sender)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsVerifySignature)
+	_ = cmd.MarkFlagRequired(FlagPubKeyAlgo)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterDataSchemaCmd implements registering a data schema command
+func NewRegisterDataSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-schema [id] [schema-file]",
+		Short: "Register a JSON schema for the identity data",
+		Long: "Register a JSON schema for the identity data. The identity data referencing " +
+			"the schema by the \"$schema\" field is validated against the schema.",
+		Example: fmt.Sprintf(
+			"$ %s tx identity register-schema <id> <schema-file> "+
+				"--from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			schema, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read the schema file: %s", err.Error())
+			}
+
+			msg := types.NewMsgRegisterDataSchema(args[0], string(schema), owner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAddTrustedIssuerCmd implements adding a trusted issuer command
+func NewAddTrustedIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-trusted-issuer [cert-file]",
+		Short: "Add a trusted issuer CA of the identity certificates",
+		Long:  "Add a trusted issuer CA of the identity certificates. Only the ID admins are allowed to add trusted issuers.",
+		Example: fmt.Sprintf(
+			"$ %s tx identity add-trusted-issuer <cert-file> "+
+				"--from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator := clientCtx.GetFromAddress()
+
+			cert, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read the certificate file: %s", err.Error())
+			}
+
+			msg := types.NewMsgAddTrustedIssuer(string(cert), operator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRemoveTrustedIssuerCmd implements removing a trusted issuer command
+func NewRemoveTrustedIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-trusted-issuer [id]",
+		Short: "Remove a trusted issuer CA of the identity certificates",
+		Long:  "Remove a trusted issuer CA of the identity certificates. Only the ID admins are allowed to remove trusted issuers.",
+		Example: fmt.Sprintf(
+			"$ %s tx identity remove-trusted-issuer <id> "+
+				"--from mykey",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator := clientCtx.GetFromAddress()
+
+			id, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trusted issuer id: %s", err.Error())
+			}
+
+			msg := types.NewMsgRemoveTrustedIssuer(id, operator)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func preCheckCmd(cmd *cobra.Command, _ []string) error {
 	flags := cmd.Flags()
 
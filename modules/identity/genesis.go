@@ -10,6 +10,16 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
 		panic(err.Error())
 	}
 
+	k.SetParams(ctx, data.Params)
+
+	for _, schema := range data.DataSchemas {
+		k.SetDataSchema(ctx, schema)
+	}
+
+	for _, issuer := range data.TrustedIssuers {
+		k.SetTrustedIssuer(ctx, issuer)
+	}
+
 	for _, identity := range data.Identities {
 		if err := k.SetIdentity(ctx, identity); err != nil {
 			panic(err.Error())
@@ -29,5 +39,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
 		},
 	)
 
-	return NewGenesisState(identities)
+	dataSchemas := make([]DataSchema, 0)
+
+	k.IterateDataSchemas(
+		ctx,
+		func(schema DataSchema) bool {
+			dataSchemas = append(dataSchemas, schema)
+			return false
+		},
+	)
+
+	return NewGenesisState(identities, k.GetParams(ctx), dataSchemas, k.GetTrustedIssuers(ctx))
 }
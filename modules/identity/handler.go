@@ -22,6 +22,22 @@ func NewHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.UpdateIdentity(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *MsgVerifySignature:
+			res, err := msgServer.VerifySignature(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgRegisterDataSchema:
+			res, err := msgServer.RegisterDataSchema(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgAddTrustedIssuer:
+			res, err := msgServer.AddTrustedIssuer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgRemoveTrustedIssuer:
+			res, err := msgServer.RemoveTrustedIssuer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -0,0 +1,377 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+)
+
+// ValidateIdentityDecorator checks that the trusted issuers are managed by the ID admins
+type ValidateIdentityDecorator struct {
+	permKeeper types.PermKeeper
+}
+
+// NewValidateIdentityDecorator creates a new ValidateIdentityDecorator
+func NewValidateIdentityDecorator(permKeeper types.PermKeeper) ValidateIdentityDecorator {
+	return ValidateIdentityDecorator{
+		permKeeper: permKeeper,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (vid ValidateIdentityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *types.MsgAddTrustedIssuer:
+			if err := vid.validateIDAdmin(ctx, msg.Operator); err != nil {
+				return ctx, err
+			}
+		case *types.MsgRemoveTrustedIssuer:
+			if err := vid.validateIDAdmin(ctx, msg.Operator); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (vid ValidateIdentityDecorator) validateIDAdmin(ctx sdk.Context, addr string) error {
+	accAddr, _ := sdk.AccAddressFromBech32(addr)
+	if !vid.permKeeper.IsIDAdmin(ctx, accAddr) {
+		return sdkerrors.Wrapf(types.ErrUnauthorizedOperator, "account (%s) does not have the ID admin role", addr)
+	}
+	return nil
+}
+
+// IdentityRequirementDecorator requires the signers of the designated message types to own
+// registered identities, optionally carrying certificates issued by the trusted issuers.
+// The messages wrapped in authz.MsgExec are checked against the requirements as well
+type IdentityRequirementDecorator struct {
+	k            Keeper
+	requirements types.IdentityRequirements
+}
+
+// NewIdentityRequirementDecorator creates a new IdentityRequirementDecorator with the
+// identity requirements keyed by the message type URLs
+func NewIdentityRequirementDecorator(k Keeper, requirements types.IdentityRequirements) IdentityRequirementDecorator {
+	return IdentityRequirementDecorator{
+		k:            k,
+		requirements: requirements,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (ird IdentityRequirementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if len(ird.requirements) > 0 {
+		if err := ird.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (ird IdentityRequirementDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := ird.validateMsgs(ctx, innerMsgs); err != nil {
+				return err
+			}
+		}
+
+		requirement, ok := ird.requirements[sdk.MsgTypeURL(msg)]
+		if !ok {
+			continue
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if err := ird.validateSigner(ctx, signer, requirement); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ird IdentityRequirementDecorator) validateSigner(ctx sdk.Context, signer sdk.AccAddress, requirement types.IdentityRequirement) error {
+	if !requirement.TrustedCertificate {
+		if !ird.k.HasOwnerIdentity(ctx, signer) {
+			return sdkerrors.Wrapf(types.ErrIdentityRequired, "account (%s) does not own any identity", signer)
+		}
+		return nil
+	}
+
+	qualified := false
+	ird.k.IterateOwnerIdentities(
+		ctx, signer,
+		func(identityID tmbytes.HexBytes) (stop bool) {
+			owner, found := ird.k.GetOwner(ctx, identityID)
+			qualified = found && owner.Equals(signer) && ird.k.HasTrustedCertificate(ctx, identityID)
+			return qualified
+		},
+	)
+
+	if !qualified {
+		return sdkerrors.Wrapf(types.ErrIdentityRequired, "account (%s) does not own any identity with a trusted certificate", signer)
+	}
+
+	return nil
+}
+
+// ExtensionOptionsDecorator rejects all the tx extension options except ExtensionOptionIdentitySigner.
+// It is intended to replace the RejectExtensionOptionsDecorator of the SDK for the apps
+// opting in to the identity based tx authentication
+type ExtensionOptionsDecorator struct{}
+
+// NewExtensionOptionsDecorator creates a new ExtensionOptionsDecorator
+func NewExtensionOptionsDecorator() ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (eod ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if hasExtOptsTx, ok := tx.(ante.HasExtensionOptionsTx); ok {
+		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+			if opt.TypeUrl != types.ExtensionOptionIdentitySignerTypeURL {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "unknown extension option %s", opt.TypeUrl)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SigVerificationDecorator verifies the tx signatures, authenticating the signers bound by
+// ExtensionOptionIdentitySigner with the public keys registered on their identities, including
+// the keys parsed from the identity certificates. The bound signer must be the identity owner.
+// Other signers are verified with the account public keys as the SDK SigVerificationDecorator does.
+// It is intended to replace the SigVerificationDecorator of the SDK
+type SigVerificationDecorator struct {
+	k               Keeper
+	ak              ante.AccountKeeper
+	signModeHandler authsigning.SignModeHandler
+	sdkDecorator    ante.SigVerificationDecorator
+}
+
+// NewSigVerificationDecorator creates a new SigVerificationDecorator
+func NewSigVerificationDecorator(k Keeper, ak ante.AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		k:               k,
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		sdkDecorator:    ante.NewSigVerificationDecorator(ak, signModeHandler),
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	extOpt, err := svd.k.GetIdentitySignerOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if extOpt == nil {
+		return svd.sdkDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+	if len(sigs) != len(signerAddrs) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	signerSet := make(map[string]bool, len(signerAddrs))
+	for _, signer := range signerAddrs {
+		signerSet[signer.String()] = true
+	}
+
+	for _, identitySigner := range extOpt.Signers {
+		if !signerSet[identitySigner.Signer] {
+			return ctx, sdkerrors.Wrapf(types.ErrInvalidIdentitySigner, "%s is not a signer of the tx", identitySigner.Signer)
+		}
+	}
+
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, svd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		signerData := authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+		}
+		if ctx.BlockHeight() == 0 {
+			signerData.AccountNumber = 0
+		}
+
+		if simulate {
+			continue
+		}
+
+		identitySigner, bound := extOpt.GetSigner(signerAddrs[i])
+		if bound {
+			err = svd.verifyIdentitySignature(ctx, identitySigner, acc, signerData, sig.Data, tx)
+		} else {
+			err = svd.verifyAccountSignature(acc, signerData, sig.Data, tx)
+		}
+
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifyIdentitySignature verifies the signature with the public keys of the identity owned by the signer
+func (svd SigVerificationDecorator) verifyIdentitySignature(
+	ctx sdk.Context,
+	identitySigner types.IdentitySigner,
+	acc authtypes.AccountI,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	tx sdk.Tx,
+) error {
+	singleSigData, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrInvalidIdentitySigner, "multisig is not supported by identity signers")
+	}
+
+	if singleSigData.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return sdkerrors.Wrapf(types.ErrInvalidIdentitySigner, "identity signers must sign in %s, got %s", signing.SignMode_SIGN_MODE_DIRECT, singleSigData.SignMode)
+	}
+
+	id, _ := hex.DecodeString(identitySigner.Id)
+
+	identity, found := svd.k.GetIdentity(ctx, id)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownIdentity, identitySigner.Id)
+	}
+
+	if identity.Owner != acc.GetAddress().String() {
+		return sdkerrors.Wrapf(types.ErrNotAuthorized, "signer %s is not the owner of the identity %s", acc.GetAddress(), identitySigner.Id)
+	}
+
+	signBytes, err := svd.signModeHandler.GetSignBytes(singleSigData.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := svd.k.VerifySignature(ctx, id, identitySigner.Algorithm, signBytes, singleSigData.Signature); err != nil {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"identity signature verification failed; please verify account number (%d) and chain-id (%s): %s",
+			signerData.AccountNumber, signerData.ChainID, err,
+		)
+	}
+
+	return nil
+}
+
+// verifyAccountSignature verifies the signature with the public key of the signer account
+func (svd SigVerificationDecorator) verifyAccountSignature(
+	acc authtypes.AccountI,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	tx sdk.Tx,
+) error {
+	pubKey := acc.GetPubKey()
+	if pubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+
+	if err := authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx); err != nil {
+		return sdkerrors.Wrap(
+			sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", signerData.AccountNumber, signerData.ChainID),
+		)
+	}
+
+	return nil
+}
+
+// GetIdentitySignerOption returns the ExtensionOptionIdentitySigner carried by the tx if any
+func (k Keeper) GetIdentitySignerOption(tx sdk.Tx) (*types.ExtensionOptionIdentitySigner, error) {
+	hasExtOptsTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var extOpt *types.ExtensionOptionIdentitySigner
+
+	for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+		if opt.TypeUrl != types.ExtensionOptionIdentitySignerTypeURL {
+			continue
+		}
+
+		if extOpt != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidIdentitySigner, "duplicate identity signer extension option")
+		}
+
+		extOpt = new(types.ExtensionOptionIdentitySigner)
+		if err := k.cdc.Unmarshal(opt.Value, extOpt); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		if err := extOpt.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return extOpt, nil
+}
+
+// NewSigVerificationGasConsumer returns a signature verification gas consumer which charges
+// the signatures produced by identity public keys, and delegates the others to the given consumer.
+// The identity signers have no public key set on the accounts
+func NewSigVerificationGasConsumer(consumer ante.SignatureVerificationGasConsumer) ante.SignatureVerificationGasConsumer {
+	return func(meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+		if sig.PubKey == nil {
+			meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: identity")
+			return nil
+		}
+
+		return consumer(meter, sig, params)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/aadhi0612/iritamod/modules/identity/types"
 )
@@ -32,5 +34,62 @@ func (k Keeper) Identity(c context.Context, req *types.QueryIdentityRequest) (*t
 		return nil, status.Errorf(codes.NotFound, "identity %s not found", req.Id)
 	}
 
-	return &types.QueryIdentityResponse{Identity: &identity}, nil
+	return &types.QueryIdentityResponse{
+		Identity:            &identity,
+		CertificateStatuses: k.GetCertificateStatuses(ctx, id),
+	}, nil
+}
+
+// DataSchema queries a data schema by id
+func (k Keeper) DataSchema(c context.Context, req *types.QueryDataSchemaRequest) (*types.QueryDataSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schema, found := k.GetDataSchema(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "data schema %s not found", req.Id)
+	}
+
+	return &types.QueryDataSchemaResponse{DataSchema: &schema}, nil
+}
+
+// TrustedIssuers queries the trusted issuer CAs of the identity certificates
+func (k Keeper) TrustedIssuers(c context.Context, req *types.QueryTrustedIssuersRequest) (*types.QueryTrustedIssuersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	issuers := make([]types.TrustedIssuer, 0)
+	store := ctx.KVStore(k.storeKey)
+	issuerStore := prefix.NewStore(store, types.TrustedIssuerKey)
+	pageRes, err := query.Paginate(issuerStore, shapePageRequest(req.Pagination), func(key []byte, value []byte) error {
+		var issuer types.TrustedIssuer
+		if err := k.cdc.Unmarshal(value, &issuer); err != nil {
+			return err
+		}
+
+		issuers = append(issuers, issuer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryTrustedIssuersResponse{TrustedIssuers: issuers, Pagination: pageRes}, nil
+}
+
+// Params queries the parameters of the identity module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
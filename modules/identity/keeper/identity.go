@@ -29,6 +29,14 @@ func (k Keeper) CreateIdentity(
 		return sdkerrors.Wrap(types.ErrIdentityExists, id.String())
 	}
 
+	if err := k.ValidateCredentials(ctx, credentials); err != nil {
+		return err
+	}
+
+	if err := k.ValidateData(ctx, data); err != nil {
+		return err
+	}
+
 	if pubKey != nil {
 		if err := k.AddPubKey(ctx, id, pubKey); err != nil {
 			return err
@@ -74,6 +82,18 @@ func (k Keeper) UpdateIdentity(
 		return sdkerrors.Wrap(types.ErrNotAuthorized, "owner not matching")
 	}
 
+	if credentials != types.DoNotModifyDesc {
+		if err := k.ValidateCredentials(ctx, credentials); err != nil {
+			return err
+		}
+	}
+
+	if data != types.DoNotModifyDesc {
+		if err := k.ValidateData(ctx, data); err != nil {
+			return err
+		}
+	}
+
 	if pubKey != nil {
 		if err := k.AddPubKey(ctx, id, pubKey); err != nil {
 			return err
@@ -96,6 +116,53 @@ func (k Keeper) UpdateIdentity(
 	return nil
 }
 
+// VerifySignature verifies the signature of the given data with the public keys
+// of the specified algorithm registered for the identity, returning the public key
+// which the signature is verified by
+func (k Keeper) VerifySignature(
+	ctx sdk.Context,
+	id tmbytes.HexBytes,
+	algorithm types.PubKeyAlgorithm,
+	data []byte,
+	signature []byte,
+) (types.PubKeyInfo, error) {
+	if !k.HasIdentity(ctx, id) {
+		return types.PubKeyInfo{}, sdkerrors.Wrap(types.ErrUnknownIdentity, id.String())
+	}
+
+	var (
+		found      bool
+		signingKey types.PubKeyInfo
+	)
+
+	k.IteratePubKeys(
+		ctx, id,
+		func(pubKey types.PubKeyInfo) (stop bool) {
+			if pubKey.Algorithm != algorithm {
+				return false
+			}
+
+			found = true
+			if err := pubKey.VerifySignature(data, signature); err == nil {
+				signingKey = pubKey
+				return true
+			}
+
+			return false
+		},
+	)
+
+	if !found {
+		return types.PubKeyInfo{}, sdkerrors.Wrapf(types.ErrUnknownPubKey, "no %s public key found for identity %s", algorithm, id)
+	}
+
+	if len(signingKey.PubKey) == 0 {
+		return types.PubKeyInfo{}, sdkerrors.Wrapf(types.ErrInvalidSignature, "signature not verified by any %s public key of identity %s", algorithm, id)
+	}
+
+	return signingKey, nil
+}
+
 // AddPubKey adds the given public key for the identity
 func (k Keeper) AddPubKey(ctx sdk.Context, identityID tmbytes.HexBytes, pubKey *types.PubKeyInfo) error {
 	pubKeyIdentityID, found := k.GetPubKeyIdentity(ctx, pubKey)
@@ -104,6 +171,10 @@ func (k Keeper) AddPubKey(ctx sdk.Context, identityID tmbytes.HexBytes, pubKey *
 			return sdkerrors.Wrap(types.ErrPubKeyExists, "")
 		}
 	} else {
+		if maxPubKeys := k.MaxPubKeys(ctx); k.countPubKeys(ctx, identityID) >= maxPubKeys {
+			return sdkerrors.Wrapf(types.ErrTooManyPubKeys, "the identity can not have more than %d public keys", maxPubKeys)
+		}
+
 		k.SetPubKey(ctx, identityID, pubKey)
 	}
 
@@ -116,6 +187,14 @@ func (k Keeper) AddCertificate(ctx sdk.Context, identityID tmbytes.HexBytes, cer
 	certHash := tmhash.Sum([]byte(cert))
 
 	if !k.HasCertificate(ctx, identityID, certHash) {
+		if err := k.ValidateCertificate(ctx, cert); err != nil {
+			return err
+		}
+
+		if maxCerts := k.MaxCertificates(ctx); k.countCertificates(ctx, identityID) >= maxCerts {
+			return sdkerrors.Wrapf(types.ErrTooManyCertificates, "the identity can not have more than %d certificates", maxCerts)
+		}
+
 		k.SetCertificate(ctx, identityID, certHash, cert)
 
 		certPubKey := types.GetPubKeyFromCertificate([]byte(cert))
@@ -125,10 +204,16 @@ func (k Keeper) AddCertificate(ctx sdk.Context, identityID tmbytes.HexBytes, cer
 	return nil
 }
 
-// SetOwner sets the owner of the given identity
+// SetOwner sets the owner of the given identity, and indexes the identity by the owner
 func (k Keeper) SetOwner(ctx sdk.Context, identityID tmbytes.HexBytes, owner sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+
+	if prevOwner, found := k.GetOwner(ctx, identityID); found {
+		store.Delete(types.GetOwnerIdentityKey(prevOwner, identityID))
+	}
+
 	store.Set(types.GetOwnerKey(identityID), owner.Bytes())
+	store.Set(types.GetOwnerIdentityKey(owner, identityID), []byte{})
 }
 
 // GetOwner gets the owner of the specified identity
@@ -345,6 +430,40 @@ func (k Keeper) IterateCertificates(
 	}
 }
 
+// IterateOwnerIdentities iterates through the IDs of all identities owned by the specified owner
+func (k Keeper) IterateOwnerIdentities(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	op func(identityID tmbytes.HexBytes) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	subspace := types.GetOwnerIdentitySubspace(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, subspace)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		identityID := tmbytes.HexBytes(iterator.Key()[len(subspace):])
+
+		if stop := op(identityID); stop {
+			break
+		}
+	}
+}
+
+// HasOwnerIdentity returns true if the specified owner owns any identity, false otherwise
+func (k Keeper) HasOwnerIdentity(ctx sdk.Context, owner sdk.AccAddress) (found bool) {
+	k.IterateOwnerIdentities(
+		ctx, owner,
+		func(identityID tmbytes.HexBytes) (stop bool) {
+			identityOwner, ok := k.GetOwner(ctx, identityID)
+			found = ok && identityOwner.Equals(owner)
+			return found
+		},
+	)
+	return found
+}
+
 // IterateIdentities iterates through all identities
 func (k Keeper) IterateIdentities(
 	ctx sdk.Context,
@@ -364,3 +483,27 @@ func (k Keeper) IterateIdentities(
 		}
 	}
 }
+
+// countPubKeys returns the number of the public keys of the specified identity
+func (k Keeper) countPubKeys(ctx sdk.Context, identityID tmbytes.HexBytes) (count uint64) {
+	k.IteratePubKeys(
+		ctx, identityID,
+		func(types.PubKeyInfo) (stop bool) {
+			count++
+			return false
+		},
+	)
+	return count
+}
+
+// countCertificates returns the number of the certificates of the specified identity
+func (k Keeper) countCertificates(ctx sdk.Context, identityID tmbytes.HexBytes) (count uint64) {
+	k.IterateCertificates(
+		ctx, identityID,
+		func(string) (stop bool) {
+			count++
+			return false
+		},
+	)
+	return count
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strings"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+)
+
+// AddTrustedIssuer adds the given CA certificate as a trusted issuer of the identity certificates,
+// returning the issuer ID
+func (k Keeper) AddTrustedIssuer(ctx sdk.Context, certificate string, operator sdk.AccAddress) (tmbytes.HexBytes, error) {
+	cert := strings.TrimSpace(certificate)
+	id := types.GetCertificateHash(cert)
+
+	if k.HasTrustedIssuer(ctx, id) {
+		return nil, sdkerrors.Wrap(types.ErrTrustedIssuerExists, id.String())
+	}
+
+	if err := types.CheckCertificateExpiry([]byte(cert), ctx.BlockTime()); err != nil {
+		return nil, err
+	}
+
+	k.SetTrustedIssuer(ctx, types.TrustedIssuer{
+		Id:          id.String(),
+		Certificate: cert,
+		Operator:    operator.String(),
+	})
+
+	return id, nil
+}
+
+// RemoveTrustedIssuer removes the specified trusted issuer.
+// The certificates issued by the removed issuer are kept but no longer trusted
+func (k Keeper) RemoveTrustedIssuer(ctx sdk.Context, id tmbytes.HexBytes) error {
+	if !k.HasTrustedIssuer(ctx, id) {
+		return sdkerrors.Wrap(types.ErrUnknownTrustedIssuer, id.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTrustedIssuerKey(id))
+
+	return nil
+}
+
+// SetTrustedIssuer sets the given trusted issuer
+func (k Keeper) SetTrustedIssuer(ctx sdk.Context, issuer types.TrustedIssuer) {
+	store := ctx.KVStore(k.storeKey)
+
+	id, _ := hex.DecodeString(issuer.Id)
+
+	bz := k.cdc.MustMarshal(&issuer)
+	store.Set(types.GetTrustedIssuerKey(id), bz)
+}
+
+// GetTrustedIssuer retrieves the trusted issuer of the specified ID
+func (k Keeper) GetTrustedIssuer(ctx sdk.Context, id tmbytes.HexBytes) (issuer types.TrustedIssuer, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTrustedIssuerKey(id))
+	if bz == nil {
+		return issuer, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &issuer)
+	return issuer, true
+}
+
+// HasTrustedIssuer returns true if the specified trusted issuer exists, false otherwise
+func (k Keeper) HasTrustedIssuer(ctx sdk.Context, id tmbytes.HexBytes) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTrustedIssuerKey(id))
+}
+
+// IterateTrustedIssuers iterates through all trusted issuers
+func (k Keeper) IterateTrustedIssuers(
+	ctx sdk.Context,
+	op func(issuer types.TrustedIssuer) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TrustedIssuerKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var issuer types.TrustedIssuer
+		k.cdc.MustUnmarshal(iterator.Value(), &issuer)
+
+		if stop := op(issuer); stop {
+			break
+		}
+	}
+}
+
+// GetTrustedIssuers returns all trusted issuers
+func (k Keeper) GetTrustedIssuers(ctx sdk.Context) []types.TrustedIssuer {
+	issuers := make([]types.TrustedIssuer, 0)
+
+	k.IterateTrustedIssuers(
+		ctx,
+		func(issuer types.TrustedIssuer) (stop bool) {
+			issuers = append(issuers, issuer)
+			return false
+		},
+	)
+
+	return issuers
+}
+
+// ValidateCertificate validates the given identity certificate against the expiry at the
+// current block time, and the trusted issuers if VerifyCertificateIssuer is enabled
+func (k Keeper) ValidateCertificate(ctx sdk.Context, cert string) error {
+	if err := types.CheckCertificateExpiry([]byte(cert), ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	if !k.VerifyCertificateIssuer(ctx) {
+		return nil
+	}
+
+	if status := types.GetCertificateStatus(cert, k.GetTrustedIssuers(ctx), ctx.BlockTime()); !status.Trusted {
+		return sdkerrors.Wrap(types.ErrUntrustedCertificate, "the certificate is not issued by any trusted issuer")
+	}
+
+	return nil
+}
+
+// GetCertificateStatuses returns the validity statuses of the certificates of the specified identity
+func (k Keeper) GetCertificateStatuses(ctx sdk.Context, identityID tmbytes.HexBytes) []types.CertificateStatus {
+	trustedIssuers := k.GetTrustedIssuers(ctx)
+	statuses := make([]types.CertificateStatus, 0)
+
+	k.IterateCertificates(
+		ctx, identityID,
+		func(cert string) (stop bool) {
+			statuses = append(statuses, types.GetCertificateStatus(cert, trustedIssuers, ctx.BlockTime()))
+			return false
+		},
+	)
+
+	return statuses
+}
+
+// HasTrustedCertificate returns true if the specified identity carries an unexpired certificate
+// issued by a trusted issuer, false otherwise
+func (k Keeper) HasTrustedCertificate(ctx sdk.Context, identityID tmbytes.HexBytes) bool {
+	for _, status := range k.GetCertificateStatuses(ctx, identityID) {
+		if status.Trusted && !status.Expired {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,21 +7,29 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/aadhi0612/iritamod/modules/identity/types"
 )
 
 // Keeper defines the identity keeper
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.Codec
+	storeKey   sdk.StoreKey
+	cdc        codec.Codec
+	paramSpace paramstypes.Subspace
 }
 
 // NewKeeper creates a new identity Keeper instance
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Codec, key sdk.StoreKey, paramSpace paramstypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
+	}
+
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace,
 	}
 }
 
@@ -4,16 +4,30 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/sm2"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/aadhi0612/iritamod/modules/identity/keeper"
 	"github.com/aadhi0612/iritamod/modules/identity/types"
@@ -38,6 +52,7 @@ var (
 type KeeperTestSuite struct {
 	suite.Suite
 
+	app    *simapp.SimApp
 	ctx    sdk.Context
 	keeper *keeper.Keeper
 }
@@ -49,6 +64,7 @@ func TestKeeperTestSuite(t *testing.T) {
 func (suite *KeeperTestSuite) SetupTest() {
 	app := simapp.Setup(false)
 
+	suite.app = app
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.keeper = &app.IdentityKeeper
 }
@@ -99,6 +115,394 @@ func (suite *KeeperTestSuite) TestUpdateIdentity() {
 	suite.Equal(testData, identity.Data)
 }
 
+func (suite *KeeperTestSuite) TestVerifySignature() {
+	privKey := sm2.GenPrivKey()
+	pubKeyInfo := types.PubKeyInfo{PubKey: tmbytes.HexBytes(privKey.PubKey().Bytes()).String(), Algorithm: types.SM2}
+
+	err := suite.keeper.CreateIdentity(suite.ctx, testID, &pubKeyInfo, "", testCredentials, testData, testOwner)
+	suite.NoError(err)
+
+	msg := []byte("test message")
+	sig, err := privKey.Sign(msg)
+	suite.NoError(err)
+
+	pubKey, err := suite.keeper.VerifySignature(suite.ctx, testID, types.SM2, msg, sig)
+	suite.NoError(err)
+	suite.Equal(pubKeyInfo, pubKey)
+
+	_, err = suite.keeper.VerifySignature(suite.ctx, testID, types.SM2, []byte("tampered message"), sig)
+	suite.ErrorIs(err, types.ErrInvalidSignature)
+
+	_, err = suite.keeper.VerifySignature(suite.ctx, testID, types.ED25519, msg, sig)
+	suite.ErrorIs(err, types.ErrUnknownPubKey)
+
+	_, err = suite.keeper.VerifySignature(suite.ctx, tmbytes.HexBytes(uuid.NewV4().Bytes()), types.SM2, msg, sig)
+	suite.ErrorIs(err, types.ErrUnknownIdentity)
+}
+
+func (suite *KeeperTestSuite) TestVerifyECDSASignature() {
+	msg := []byte("test message")
+	digest := sha256.Sum256(msg)
+
+	for _, curve := range []elliptic.Curve{elliptic.P224(), elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		privKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+		suite.NoError(err)
+
+		id := tmbytes.HexBytes(uuid.NewV4().Bytes())
+		pubKey := elliptic.MarshalCompressed(curve, privKey.X, privKey.Y)
+		pubKeyInfo := types.PubKeyInfo{PubKey: tmbytes.HexBytes(pubKey).String(), Algorithm: types.ECDSA}
+		suite.NoError(pubKeyInfo.Validate(), curve.Params().Name)
+
+		err = suite.keeper.CreateIdentity(suite.ctx, id, &pubKeyInfo, "", testCredentials, testData, testOwner)
+		suite.NoError(err, curve.Params().Name)
+
+		sig, err := ecdsa.SignASN1(rand.Reader, privKey, digest[:])
+		suite.NoError(err)
+
+		_, err = suite.keeper.VerifySignature(suite.ctx, id, types.ECDSA, msg, sig)
+		suite.NoError(err, curve.Params().Name)
+
+		_, err = suite.keeper.VerifySignature(suite.ctx, id, types.ECDSA, []byte("tampered message"), sig)
+		suite.ErrorIs(err, types.ErrInvalidSignature, curve.Params().Name)
+	}
+
+	invalidPubKeyInfo := types.PubKeyInfo{PubKey: tmbytes.HexBytes(testPubKeyECDSA).String(), Algorithm: types.ECDSA}
+	suite.ErrorIs(invalidPubKeyInfo.Validate(), types.ErrInvalidPubKey)
+}
+
+func (suite *KeeperTestSuite) TestIdentityLimits() {
+	params := types.NewParams(32, 64, 2, 1, false)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.CreateIdentity(suite.ctx, testID, &testPubKeySM2Info, testCertificate, testCredentials, strings.Repeat("d", 33), testOwner)
+	suite.ErrorIs(err, types.ErrInvalidData)
+
+	err = suite.keeper.CreateIdentity(suite.ctx, testID, &testPubKeySM2Info, testCertificate, strings.Repeat("c", 65), testData, testOwner)
+	suite.ErrorIs(err, types.ErrInvalidCredentials)
+
+	err = suite.keeper.CreateIdentity(suite.ctx, testID, &testPubKeySM2Info, testCertificate, testCredentials, testData, testOwner)
+	suite.NoError(err)
+
+	err = suite.keeper.UpdateIdentity(suite.ctx, testID, &testPubKeyECDSAInfo, "", types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.ErrorIs(err, types.ErrTooManyPubKeys)
+}
+
+func (suite *KeeperTestSuite) TestDataSchema() {
+	schemaID := "profile/v1"
+	schema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "maxLength": 16},
+			"age": {"type": "integer", "minimum": 0}
+		},
+		"required": ["name"],
+		"additionalProperties": false
+	}`
+
+	err := suite.keeper.RegisterDataSchema(suite.ctx, schemaID, schema, testOwner)
+	suite.NoError(err)
+
+	err = suite.keeper.RegisterDataSchema(suite.ctx, schemaID, schema, testOwner)
+	suite.ErrorIs(err, types.ErrDataSchemaExists)
+
+	dataSchema, found := suite.keeper.GetDataSchema(suite.ctx, schemaID)
+	suite.True(found)
+	suite.Equal(schema, dataSchema.Schema)
+	suite.Equal(testOwner.String(), dataSchema.Owner)
+
+	testCases := []struct {
+		data    string
+		expPass bool
+	}{
+		{`{"$schema": "profile/v1", "name": "alice", "age": 20}`, true},
+		{`{"$schema": "profile/v1", "age": 20}`, false},
+		{`{"$schema": "profile/v1", "name": "alice", "age": 2.5}`, false},
+		{`{"$schema": "profile/v1", "name": "alice", "email": "alice@example.com"}`, false},
+		{`{"$schema": "profile/v2", "name": "alice"}`, false},
+		{`{"name": "alice", "email": "alice@example.com"}`, true},
+		{testData, true},
+	}
+
+	for i, tc := range testCases {
+		err := suite.keeper.ValidateData(suite.ctx, tc.data)
+		if tc.expPass {
+			suite.NoError(err, "data %d failed", i)
+		} else {
+			suite.Error(err, "invalid data %d passed", i)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestTrustedIssuer() {
+	now := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(now)
+
+	caCert, caKey := genCertificate(suite.T(), "test-ca", true, now.Add(time.Hour), "", nil)
+	issuedCert, _ := genCertificate(suite.T(), "test-issued", false, now.Add(time.Hour), caCert, caKey)
+	untrustedCACert, untrustedCAKey := genCertificate(suite.T(), "test-untrusted-ca", true, now.Add(time.Hour), "", nil)
+	untrustedCert, _ := genCertificate(suite.T(), "test-untrusted", false, now.Add(time.Hour), untrustedCACert, untrustedCAKey)
+
+	issuerID, err := suite.keeper.AddTrustedIssuer(ctx, caCert, testOwner)
+	suite.NoError(err)
+	suite.Equal(types.GetCertificateHash(caCert), issuerID)
+
+	_, err = suite.keeper.AddTrustedIssuer(ctx, caCert, testOwner)
+	suite.ErrorIs(err, types.ErrTrustedIssuerExists)
+
+	issuer, found := suite.keeper.GetTrustedIssuer(ctx, issuerID)
+	suite.True(found)
+	suite.Equal(testOwner.String(), issuer.Operator)
+
+	err = suite.keeper.CreateIdentity(ctx, testID, nil, issuedCert, "", "", testOwner)
+	suite.NoError(err)
+
+	err = suite.keeper.UpdateIdentity(ctx, testID, nil, untrustedCert, types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.NoError(err)
+
+	statuses := suite.keeper.GetCertificateStatuses(ctx, testID)
+	suite.Len(statuses, 2)
+
+	for _, status := range statuses {
+		suite.False(status.Expired)
+
+		if status.CertificateHash == types.GetCertificateHash(issuedCert).String() {
+			suite.True(status.Trusted)
+			suite.Equal(issuerID.String(), status.TrustedIssuerId)
+		} else {
+			suite.False(status.Trusted)
+			suite.Empty(status.TrustedIssuerId)
+		}
+	}
+
+	// expired certificates are rejected
+	expiredCtx := ctx.WithBlockTime(now.Add(2 * time.Hour))
+	expiredCert, _ := genCertificate(suite.T(), "test-expired", false, now.Add(time.Hour), caCert, caKey)
+
+	err = suite.keeper.UpdateIdentity(expiredCtx, testID, nil, expiredCert, types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.ErrorIs(err, types.ErrCertificateExpired)
+
+	for _, status := range suite.keeper.GetCertificateStatuses(expiredCtx, testID) {
+		suite.True(status.Expired)
+	}
+
+	// untrusted certificates are rejected when the issuer verification is enabled
+	params := types.DefaultParams()
+	params.VerifyCertificateIssuer = true
+	suite.keeper.SetParams(ctx, params)
+
+	anotherUntrustedCert, _ := genCertificate(suite.T(), "test-untrusted-2", false, now.Add(time.Hour), untrustedCACert, untrustedCAKey)
+	err = suite.keeper.UpdateIdentity(ctx, testID, nil, anotherUntrustedCert, types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.ErrorIs(err, types.ErrUntrustedCertificate)
+
+	anotherIssuedCert, _ := genCertificate(suite.T(), "test-issued-2", false, now.Add(time.Hour), caCert, caKey)
+	err = suite.keeper.UpdateIdentity(ctx, testID, nil, anotherIssuedCert, types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.NoError(err)
+
+	err = suite.keeper.RemoveTrustedIssuer(ctx, issuerID)
+	suite.NoError(err)
+
+	err = suite.keeper.RemoveTrustedIssuer(ctx, issuerID)
+	suite.ErrorIs(err, types.ErrUnknownTrustedIssuer)
+
+	for _, status := range suite.keeper.GetCertificateStatuses(ctx, testID) {
+		suite.False(status.Trusted)
+	}
+}
+
+func (suite *KeeperTestSuite) TestIdentityRequirementDecorator() {
+	now := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(now)
+
+	txConfig := simapp.MakeEncodingConfig().TxConfig
+	nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	decorator := keeper.NewIdentityRequirementDecorator(*suite.keeper, types.IdentityRequirements{
+		sdk.MsgTypeURL(&types.MsgRegisterDataSchema{}): {},
+		sdk.MsgTypeURL(&types.MsgVerifySignature{}):    {TrustedCertificate: true},
+	})
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		suite.NoError(txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+
+	schemaMsg := types.NewMsgRegisterDataSchema("profile/v1", `{"type": "object"}`, testOwner)
+	verifyMsg := types.NewMsgVerifySignature(testID, types.ECDSA, []byte("data"), []byte("signature"), testOwner)
+	grantee := sdk.AccAddress([]byte("test-granteetest-gra"))
+	execMsg := authz.NewMsgExec(grantee, []sdk.Msg{schemaMsg})
+
+	// the messages not designated are not subject to the requirements
+	_, err := decorator.AnteHandle(ctx, newTx(types.NewMsgCreateIdentity(testID, nil, "", "", testOwner, "")), false, nextAnte)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(schemaMsg), false, nextAnte)
+	suite.ErrorIs(err, types.ErrIdentityRequired)
+
+	_, err = decorator.AnteHandle(ctx, newTx(&execMsg), false, nextAnte)
+	suite.ErrorIs(err, types.ErrIdentityRequired)
+
+	err = suite.keeper.CreateIdentity(ctx, testID, nil, "", "", "", testOwner)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(schemaMsg), false, nextAnte)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(&execMsg), false, nextAnte)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(verifyMsg), false, nextAnte)
+	suite.ErrorIs(err, types.ErrIdentityRequired)
+
+	caCert, caKey := genCertificate(suite.T(), "test-ca", true, now.Add(time.Hour), "", nil)
+	issuedCert, _ := genCertificate(suite.T(), "test-issued", false, now.Add(time.Hour), caCert, caKey)
+
+	_, err = suite.keeper.AddTrustedIssuer(ctx, caCert, testOwner)
+	suite.NoError(err)
+
+	err = suite.keeper.UpdateIdentity(ctx, testID, nil, issuedCert, types.DoNotModifyDesc, types.DoNotModifyDesc, testOwner)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(verifyMsg), false, nextAnte)
+	suite.NoError(err)
+
+	// the trusted certificate requirement is not met once the certificate expires
+	_, err = decorator.AnteHandle(ctx.WithBlockTime(now.Add(2*time.Hour)), newTx(verifyMsg), false, nextAnte)
+	suite.ErrorIs(err, types.ErrIdentityRequired)
+}
+
+func (suite *KeeperTestSuite) TestSigVerificationDecorator() {
+	privKey := sm2.GenPrivKey()
+	pubKeyInfo := types.PubKeyInfo{PubKey: tmbytes.HexBytes(privKey.PubKey().Bytes()).String(), Algorithm: types.SM2}
+
+	err := suite.keeper.CreateIdentity(suite.ctx, testID, &pubKeyInfo, "", testCredentials, testData, testOwner)
+	suite.NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, testOwner)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	txConfig := simapp.MakeEncodingConfig().TxConfig
+	decorator := keeper.NewSigVerificationDecorator(*suite.keeper, suite.app.AccountKeeper, txConfig.SignModeHandler())
+	nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	msg, err := codectypes.NewAnyWithValue(types.NewMsgUpdateIdentity(testID, nil, "", testCredentials, testOwner, testData))
+	suite.NoError(err)
+	extOpt, err := codectypes.NewAnyWithValue(
+		types.NewExtensionOptionIdentitySigner(types.NewIdentitySigner(testOwner, testID, types.SM2)),
+	)
+	suite.NoError(err)
+
+	body := &txtypes.TxBody{Messages: []*codectypes.Any{msg}, ExtensionOptions: []*codectypes.Any{extOpt}}
+	bodyBz, err := body.Marshal()
+	suite.NoError(err)
+
+	authInfo := &txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{types.NewIdentitySignerInfo(acc.GetSequence())},
+		Fee:         &txtypes.Fee{GasLimit: 200000},
+	}
+	authInfoBz, err := authInfo.Marshal()
+	suite.NoError(err)
+
+	signDoc := txtypes.SignDoc{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz, ChainId: ctx.ChainID(), AccountNumber: acc.GetAccountNumber()}
+	signBytes, err := signDoc.Marshal()
+	suite.NoError(err)
+
+	testCases := []struct {
+		signer  crypto.PrivKey
+		expPass bool
+	}{
+		{privKey, true},
+		{sm2.GenPrivKey(), false},
+	}
+
+	for _, tc := range testCases {
+		sig, err := tc.signer.Sign(signBytes)
+		suite.NoError(err)
+
+		txRaw := txtypes.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz, Signatures: [][]byte{sig}}
+		txBytes, err := txRaw.Marshal()
+		suite.NoError(err)
+
+		tx, err := txConfig.TxDecoder()(txBytes)
+		suite.NoError(err)
+
+		_, err = decorator.AnteHandle(ctx, tx, false, nextAnte)
+		if tc.expPass {
+			suite.NoError(err)
+		} else {
+			suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+		}
+	}
+
+	// identity signers are only allowed to sign in SIGN_MODE_DIRECT
+	aminoSignerInfo := types.NewIdentitySignerInfo(acc.GetSequence())
+	aminoSignerInfo.ModeInfo.GetSingle().Mode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	authInfo.SignerInfos = []*txtypes.SignerInfo{aminoSignerInfo}
+	authInfoBz, err = authInfo.Marshal()
+	suite.NoError(err)
+
+	signDoc.AuthInfoBytes = authInfoBz
+	signBytes, err = signDoc.Marshal()
+	suite.NoError(err)
+
+	sig, err := privKey.Sign(signBytes)
+	suite.NoError(err)
+
+	txRaw := txtypes.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz, Signatures: [][]byte{sig}}
+	txBytes, err := txRaw.Marshal()
+	suite.NoError(err)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(ctx, tx, false, nextAnte)
+	suite.ErrorIs(err, types.ErrInvalidIdentitySigner)
+}
+
+// genCertificate generates a PEM-encoded ECDSA certificate signed by the given parent,
+// or a self-signed one if the parent is nil
+func genCertificate(
+	t *testing.T,
+	commonName string,
+	isCA bool,
+	notAfter time.Time,
+	parentCert string,
+	parentKey *ecdsa.PrivateKey,
+) (string, *ecdsa.PrivateKey) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             notAfter.Add(-24 * time.Hour),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	}
+
+	parent, signingKey := template, privKey
+	if len(parentCert) > 0 {
+		block, _ := pem.Decode([]byte(parentCert))
+		parent, err = x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		signingKey = parentKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &privKey.PublicKey, signingKey)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), privKey
+}
+
 const testCertificate = `-----BEGIN CERTIFICATE-----
 MIIDTDCCAjQCCQDvRoz+e/HRpDANBgkqhkiG9w0BAQsFADBoMQswCQYDVQQGEwJj
 bjELMAkGA1UECAwCc2gxCzAJBgNVBAcMAnBkMQswCQYDVQQKDAJiajELMAkGA1UE
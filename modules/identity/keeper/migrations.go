@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/aadhi0612/iritamod/modules/identity/migrations/v2"
+)
+
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates from version 1 to 2, setting the absent params to the defaults
+// and indexing the existing identities by the owner.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.k.storeKey, m.k.paramSpace)
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/aadhi0612/iritamod/modules/identity/types"
@@ -69,3 +72,100 @@ func (m msgServer) UpdateIdentity(goCtx context.Context, msg *types.MsgUpdateIde
 	})
 	return &types.MsgUpdateIdentityResponse{}, nil
 }
+
+func (m msgServer) VerifySignature(goCtx context.Context, msg *types.MsgVerifySignature) (*types.MsgVerifySignatureResponse, error) {
+	id, _ := hex.DecodeString(msg.Id)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pubKey, err := m.Keeper.VerifySignature(ctx, id, msg.Algorithm, msg.Data, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVerifySignature,
+			sdk.NewAttribute(types.AttributeKeyID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyPubKey, pubKey.PubKey),
+			sdk.NewAttribute(types.AttributeKeyDataHash, tmbytes.HexBytes(tmhash.Sum(msg.Data)).String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgVerifySignatureResponse{PubKey: &pubKey}, nil
+}
+
+func (m msgServer) RegisterDataSchema(goCtx context.Context, msg *types.MsgRegisterDataSchema) (*types.MsgRegisterDataSchemaResponse, error) {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RegisterDataSchema(ctx, msg.Id, msg.Schema, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterDataSchema,
+			sdk.NewAttribute(types.AttributeKeySchemaID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	})
+	return &types.MsgRegisterDataSchemaResponse{}, nil
+}
+
+func (m msgServer) AddTrustedIssuer(goCtx context.Context, msg *types.MsgAddTrustedIssuer) (*types.MsgAddTrustedIssuerResponse, error) {
+	operator, _ := sdk.AccAddressFromBech32(msg.Operator)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.AddTrustedIssuer(ctx, msg.Certificate, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddTrustedIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuerID, id.String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+	return &types.MsgAddTrustedIssuerResponse{Id: id.String()}, nil
+}
+
+func (m msgServer) RemoveTrustedIssuer(goCtx context.Context, msg *types.MsgRemoveTrustedIssuer) (*types.MsgRemoveTrustedIssuerResponse, error) {
+	id, _ := hex.DecodeString(msg.Id)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.RemoveTrustedIssuer(ctx, id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveTrustedIssuer,
+			sdk.NewAttribute(types.AttributeKeyIssuerID, msg.Id),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+	return &types.MsgRemoveTrustedIssuerResponse{}, nil
+}
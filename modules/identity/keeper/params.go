@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+)
+
+// ParamKeyTable for identity module
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+// MaxDataLength returns the maximum length of the identity data
+func (k Keeper) MaxDataLength(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxDataLength, &res)
+	return
+}
+
+// MaxCredentialsLength returns the maximum length of the identity credentials
+func (k Keeper) MaxCredentialsLength(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxCredentialsLength, &res)
+	return
+}
+
+// MaxPubKeys returns the maximum number of the public keys per identity
+func (k Keeper) MaxPubKeys(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxPubKeys, &res)
+	return
+}
+
+// MaxCertificates returns the maximum number of the certificates per identity
+func (k Keeper) MaxCertificates(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxCertificates, &res)
+	return
+}
+
+// VerifyCertificateIssuer returns true if the identity certificates are required to be
+// issued by the trusted issuers, false otherwise
+func (k Keeper) VerifyCertificateIssuer(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyVerifyCertificateIssuer, &res)
+	return
+}
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params to the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+)
+
+// RegisterDataSchema registers a JSON schema for the identity data.
+// The registered schema is immutable
+func (k Keeper) RegisterDataSchema(ctx sdk.Context, id string, schema string, owner sdk.AccAddress) error {
+	if k.HasDataSchema(ctx, id) {
+		return sdkerrors.Wrap(types.ErrDataSchemaExists, id)
+	}
+
+	if maxLength := k.MaxDataLength(ctx); uint64(len(schema)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrInvalidDataSchema, "length of the schema must not be greater than %d", maxLength)
+	}
+
+	k.SetDataSchema(ctx, types.DataSchema{
+		Id:     id,
+		Schema: schema,
+		Owner:  owner.String(),
+	})
+
+	return nil
+}
+
+// SetDataSchema sets the given data schema
+func (k Keeper) SetDataSchema(ctx sdk.Context, schema types.DataSchema) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshal(&schema)
+	store.Set(types.GetDataSchemaKey(schema.Id), bz)
+}
+
+// GetDataSchema retrieves the data schema of the specified ID
+func (k Keeper) GetDataSchema(ctx sdk.Context, id string) (schema types.DataSchema, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDataSchemaKey(id))
+	if bz == nil {
+		return schema, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &schema)
+	return schema, true
+}
+
+// HasDataSchema returns true if the specified data schema exists, false otherwise
+func (k Keeper) HasDataSchema(ctx sdk.Context, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDataSchemaKey(id))
+}
+
+// IterateDataSchemas iterates through all data schemas
+func (k Keeper) IterateDataSchemas(
+	ctx sdk.Context,
+	op func(schema types.DataSchema) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.DataSchemaKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schema types.DataSchema
+		k.cdc.MustUnmarshal(iterator.Value(), &schema)
+
+		if stop := op(schema); stop {
+			break
+		}
+	}
+}
+
+// ValidateData validates the identity data against the size limit and the data schema
+// referenced by the "$schema" field of the data if any
+func (k Keeper) ValidateData(ctx sdk.Context, data string) error {
+	if maxLength := k.MaxDataLength(ctx); uint64(len(data)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "length of the data must not be greater than %d", maxLength)
+	}
+
+	schemaID, ok := types.GetDataSchemaID(data)
+	if !ok {
+		return nil
+	}
+
+	schema, found := k.GetDataSchema(ctx, schemaID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownDataSchema, schemaID)
+	}
+
+	return types.ValidateDataWithSchema(data, schema.Schema)
+}
+
+// ValidateCredentials validates the identity credentials against the size limit
+func (k Keeper) ValidateCredentials(ctx sdk.Context, credentials string) error {
+	if maxLength := k.MaxCredentialsLength(ctx); uint64(len(credentials)) > maxLength {
+		return sdkerrors.Wrapf(types.ErrInvalidCredentials, "length of the credentials uri must not be greater than %d", maxLength)
+	}
+
+	return nil
+}
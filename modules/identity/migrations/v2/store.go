@@ -0,0 +1,44 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+//   - Set the params absent from the param store to the default values.
+//   - Index the existing identities by the owner:
+//     0x09 | len(owner) | owner | identityID => []byte{}
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, paramSpace paramstypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+	migrateOwnerIdentities(ctx.KVStore(storeKey))
+
+	return nil
+}
+
+// migrateParams sets the params absent from the param store to the default values
+func migrateParams(ctx sdk.Context, paramSpace paramstypes.Subspace) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// migrateOwnerIdentities indexes the identities by the owner
+func migrateOwnerIdentities(store sdk.KVStore) {
+	iterator := sdk.KVStorePrefixIterator(store, types.OwnerKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		identityID := iterator.Key()[len(types.OwnerKey):]
+		owner := iterator.Value()
+
+		store.Set(types.GetOwnerIdentityKey(owner, identityID), []byte{})
+	}
+}
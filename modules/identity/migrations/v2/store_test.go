@@ -0,0 +1,81 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/identity/keeper"
+	v2 "github.com/aadhi0612/iritamod/modules/identity/migrations/v2"
+	"github.com/aadhi0612/iritamod/modules/identity/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeEncodingConfig()
+
+	identityKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(identityKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+	kvStore := ctx.KVStore(identityKey)
+
+	paramSpace := paramstypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(keeper.ParamKeyTable())
+
+	identityID1 := tmhash.Sum([]byte("identity1"))[:16]
+	identityID2 := tmhash.Sum([]byte("identity2"))[:16]
+	owner1 := sdk.AccAddress(tmhash.SumTruncated([]byte("owner1")))
+	owner2 := sdk.AccAddress(tmhash.SumTruncated([]byte("owner2")))
+
+	// the v1 store, without the params and the owner index
+	kvStore.Set(types.GetOwnerKey(identityID1), owner1)
+	kvStore.Set(types.GetOwnerKey(identityID2), owner2)
+
+	// the param set before the migration is kept
+	paramSpace.Set(ctx, types.KeyMaxPubKeys, uint64(4))
+
+	require.NoError(t, v2.MigrateStore(ctx, identityKey, paramSpace))
+
+	expParams := types.DefaultParams()
+	expParams.MaxPubKeys = 4
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, expParams, params)
+
+	testCases := []struct {
+		name       string
+		owner      sdk.AccAddress
+		identityID []byte
+	}{
+		{"identity1", owner1, identityID1},
+		{"identity2", owner2, identityID2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, kvStore.Has(types.GetOwnerIdentityKey(tc.owner, tc.identityID)))
+			require.Equal(t, []byte(tc.owner), kvStore.Get(types.GetOwnerKey(tc.identityID)))
+		})
+	}
+
+	require.False(t, kvStore.Has(types.GetOwnerIdentityKey(owner1, identityID2)))
+}
@@ -24,12 +24,26 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateIdentity{}, "iritamod/identity/MsgCreateIdentity", nil)
 	cdc.RegisterConcrete(&MsgUpdateIdentity{}, "iritamod/identity/MsgUpdateIdentity", nil)
+	cdc.RegisterConcrete(&MsgVerifySignature{}, "iritamod/identity/MsgVerifySignature", nil)
+	cdc.RegisterConcrete(&MsgRegisterDataSchema{}, "iritamod/identity/MsgRegisterDataSchema", nil)
+	cdc.RegisterConcrete(&MsgAddTrustedIssuer{}, "iritamod/identity/MsgAddTrustedIssuer", nil)
+	cdc.RegisterConcrete(&MsgRemoveTrustedIssuer{}, "iritamod/identity/MsgRemoveTrustedIssuer", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateIdentity{},
 		&MsgUpdateIdentity{},
+		&MsgVerifySignature{},
+		&MsgRegisterDataSchema{},
+		&MsgAddTrustedIssuer{},
+		&MsgRemoveTrustedIssuer{},
+	)
+
+	registry.RegisterInterface(
+		"iritamod.identity.ExtensionOptionI",
+		(*ExtensionOptionI)(nil),
+		&ExtensionOptionIdentitySigner{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
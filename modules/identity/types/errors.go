@@ -16,4 +16,19 @@ var (
 	ErrUnknownIdentity            = sdkerrors.Register(ModuleName, 9, "unknown identity")
 	ErrUnsupportedPubKeyAlgorithm = sdkerrors.Register(ModuleName, 10, "unsupported public key algorithm; only RSA, DSA, ECDSA, ED25519 and SM2 supported")
 	ErrNotAuthorized              = sdkerrors.Register(ModuleName, 11, "owner not matching")
+	ErrInvalidSignature           = sdkerrors.Register(ModuleName, 12, "invalid signature")
+	ErrUnknownPubKey              = sdkerrors.Register(ModuleName, 13, "unknown public key")
+	ErrInvalidIdentitySigner      = sdkerrors.Register(ModuleName, 14, "invalid identity signer")
+	ErrInvalidDataSchema          = sdkerrors.Register(ModuleName, 15, "invalid data schema")
+	ErrInvalidData                = sdkerrors.Register(ModuleName, 16, "invalid data")
+	ErrDataSchemaExists           = sdkerrors.Register(ModuleName, 17, "data schema already exists")
+	ErrUnknownDataSchema          = sdkerrors.Register(ModuleName, 18, "unknown data schema")
+	ErrTooManyPubKeys             = sdkerrors.Register(ModuleName, 19, "too many public keys")
+	ErrTooManyCertificates        = sdkerrors.Register(ModuleName, 20, "too many certificates")
+	ErrCertificateExpired         = sdkerrors.Register(ModuleName, 21, "certificate expired")
+	ErrUntrustedCertificate       = sdkerrors.Register(ModuleName, 22, "certificate not issued by a trusted issuer")
+	ErrTrustedIssuerExists        = sdkerrors.Register(ModuleName, 23, "trusted issuer already exists")
+	ErrUnknownTrustedIssuer       = sdkerrors.Register(ModuleName, 24, "unknown trusted issuer")
+	ErrUnauthorizedOperator       = sdkerrors.Register(ModuleName, 25, "operator does not have the ID admin role")
+	ErrIdentityRequired           = sdkerrors.Register(ModuleName, 26, "signer does not own a qualified identity")
 )
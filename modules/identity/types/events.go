@@ -2,10 +2,19 @@ package types
 
 // identity module event types
 const (
-	EventTypeCreateIdentity = "create_identity"
-	EventTypeUpdateIdentity = "update_identity"
+	EventTypeCreateIdentity      = "create_identity"
+	EventTypeUpdateIdentity      = "update_identity"
+	EventTypeVerifySignature     = "verify_signature"
+	EventTypeRegisterDataSchema  = "register_data_schema"
+	EventTypeAddTrustedIssuer    = "add_trusted_issuer"
+	EventTypeRemoveTrustedIssuer = "remove_trusted_issuer"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyID         = "id"
 	AttributeKeyOwner      = "owner"
+	AttributeKeyPubKey     = "pubkey"
+	AttributeKeyDataHash   = "data_hash"
+	AttributeKeySchemaID   = "schema_id"
+	AttributeKeyIssuerID   = "issuer_id"
+	AttributeKeyOperator   = "operator"
 )
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PermKeeper defines the expected perm keeper
+type PermKeeper interface {
+	IsIDAdmin(ctx sdk.Context, address sdk.AccAddress) bool
+}
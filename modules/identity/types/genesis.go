@@ -1,18 +1,63 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // NewGenesisState constructs a new GenesisState instance
-func NewGenesisState(identities []Identity) *GenesisState {
-	return &GenesisState{Identities: identities}
+func NewGenesisState(
+	identities []Identity,
+	params Params,
+	dataSchemas []DataSchema,
+	trustedIssuers []TrustedIssuer,
+) *GenesisState {
+	return &GenesisState{
+		Identities:     identities,
+		Params:         params,
+		DataSchemas:    dataSchemas,
+		TrustedIssuers: trustedIssuers,
+	}
 }
 
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // ValidateGenesis validates the provided identity genesis state to ensure the
 // expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, schema := range data.DataSchemas {
+		if err := ValidateDataSchemaID(schema.Id); err != nil {
+			return err
+		}
+
+		if err := ValidateDataSchema(schema.Schema); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(schema.Owner); err != nil {
+			return err
+		}
+	}
+
+	for _, issuer := range data.TrustedIssuers {
+		if err := CheckIssuerCertificate([]byte(issuer.Certificate)); err != nil {
+			return err
+		}
+
+		if issuer.Id != GetCertificateHash(issuer.Certificate).String() {
+			return sdkerrors.Wrapf(ErrInvalidID, "trusted issuer id %s does not match the certificate", issuer.Id)
+		}
+	}
+
 	for _, identity := range data.Identities {
 		if err := identity.Validate(); err != nil {
 			return err
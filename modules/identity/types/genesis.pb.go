@@ -25,7 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the identity module's genesis state.
 type GenesisState struct {
-	Identities []Identity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities"`
+	Identities     []Identity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities"`
+	Params         Params          `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	DataSchemas    []DataSchema    `protobuf:"bytes,3,rep,name=data_schemas,json=dataSchemas,proto3" json:"data_schemas"`
+	TrustedIssuers []TrustedIssuer `protobuf:"bytes,4,rep,name=trusted_issuers,json=trustedIssuers,proto3" json:"trusted_issuers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +71,27 @@ func (m *GenesisState) GetIdentities() []Identity {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDataSchemas() []DataSchema {
+	if m != nil {
+		return m.DataSchemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrustedIssuers() []TrustedIssuer {
+	if m != nil {
+		return m.TrustedIssuers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "iritamod.identity.GenesisState")
 }
@@ -75,20 +99,26 @@ func init() {
 func init() { proto.RegisterFile("identity/genesis.proto", fileDescriptor_0c7c49d412bcd530) }
 
 var fileDescriptor_0c7c49d412bcd530 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0x4c, 0x49, 0xcd,
-	0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xcc, 0x2c, 0xca, 0x2c, 0x49, 0xcc, 0xcd, 0x4f, 0xd1, 0x83, 0x29, 0x90,
-	0x12, 0x87, 0x2b, 0x85, 0x31, 0x20, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
-	0x10, 0x0b, 0x22, 0xaa, 0x14, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x32, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x91, 0x8b, 0x0b, 0xaa, 0x2f, 0x33, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48,
-	0x5a, 0x0f, 0xc3, 0x1a, 0x3d, 0x4f, 0x28, 0xc3, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x24,
-	0x4d, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x92, 0x9e,
-	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x94, 0x99, 0x98, 0x97, 0x95, 0x99,
-	0x9a, 0x98, 0xa9, 0x0f, 0x33, 0x5c, 0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0xee, 0x70,
-	0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x4b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x8e, 0xc9, 0x22, 0x05, 0x01, 0x00, 0x00,
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x93, 0xb6, 0x74, 0xb8, 0x16, 0xc5, 0x20, 0x1a, 0x2b, 0x9e, 0xc5, 0xa9, 0x53, 0x4e,
+	0xab, 0xe8, 0x6c, 0x11, 0xa5, 0x8b, 0x8a, 0x75, 0x72, 0x29, 0xd7, 0xde, 0x91, 0x1e, 0x98, 0x5e,
+	0xc8, 0xf7, 0x65, 0xe8, 0xbf, 0xf0, 0x3f, 0xf8, 0x67, 0x3a, 0x76, 0x74, 0x12, 0x49, 0xfe, 0x88,
+	0x78, 0xb9, 0x84, 0x82, 0xd9, 0x5e, 0x3e, 0x9e, 0xf7, 0x79, 0xe1, 0x8e, 0x1c, 0x28, 0x21, 0x97,
+	0xa8, 0x70, 0xc5, 0x42, 0xb9, 0x94, 0xa0, 0x20, 0x88, 0x13, 0x8d, 0xda, 0xdb, 0x53, 0x89, 0x42,
+	0x1e, 0x69, 0x11, 0x94, 0x40, 0xef, 0xb0, 0x42, 0xcb, 0x50, 0xb0, 0xbd, 0xfd, 0x50, 0x87, 0xda,
+	0x44, 0xf6, 0x97, 0x8a, 0xeb, 0xd9, 0x67, 0x83, 0x74, 0x1f, 0x0a, 0xe7, 0x04, 0x39, 0x4a, 0xef,
+	0x96, 0x10, 0x5b, 0x54, 0x12, 0x7c, 0xb7, 0xdf, 0x1c, 0x74, 0x86, 0xc7, 0xc1, 0xbf, 0x9d, 0x60,
+	0x6c, 0xc3, 0xa8, 0xb5, 0xfe, 0x3e, 0x75, 0x5e, 0xb6, 0x4a, 0xde, 0x0d, 0x69, 0xc7, 0x3c, 0xe1,
+	0x11, 0xf8, 0x8d, 0xbe, 0x3b, 0xe8, 0x0c, 0x8f, 0x6a, 0xea, 0xcf, 0x06, 0xb0, 0x65, 0x8b, 0x7b,
+	0xf7, 0xa4, 0x2b, 0x38, 0xf2, 0x29, 0xcc, 0x17, 0x32, 0xe2, 0xe0, 0x37, 0xcd, 0xfa, 0x49, 0x4d,
+	0xfd, 0x8e, 0x23, 0x9f, 0x18, 0xca, 0x2a, 0x3a, 0xa2, 0xba, 0x80, 0xf7, 0x44, 0x76, 0x31, 0x49,
+	0x01, 0xa5, 0x98, 0x2a, 0x80, 0x54, 0x26, 0xe0, 0xb7, 0x8c, 0xaa, 0x5f, 0xa3, 0x7a, 0x2d, 0xc8,
+	0xb1, 0x01, 0xad, 0x6d, 0x07, 0xb7, 0x8f, 0x30, 0x7a, 0x5c, 0x67, 0xd4, 0xdd, 0x64, 0xd4, 0xfd,
+	0xc9, 0xa8, 0xfb, 0x91, 0x53, 0x67, 0x93, 0x53, 0xe7, 0x2b, 0xa7, 0xce, 0xdb, 0x55, 0xa8, 0x70,
+	0x91, 0xce, 0x82, 0xb9, 0x8e, 0x18, 0xe7, 0x62, 0xa1, 0xce, 0xaf, 0x2f, 0x86, 0xac, 0x5c, 0x61,
+	0x91, 0x16, 0xe9, 0xbb, 0x84, 0xea, 0x2f, 0x18, 0xae, 0x62, 0x09, 0xb3, 0xb6, 0x79, 0xfc, 0xcb,
+	0xdf, 0x01, 0x00, 0x0e, 0x45, 0x8f, 0x87, 0xd8, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +141,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TrustedIssuers) > 0 {
+		for iNdEx := len(m.TrustedIssuers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedIssuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DataSchemas) > 0 {
+		for iNdEx := len(m.DataSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Identities) > 0 {
 		for iNdEx := len(m.Identities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +219,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DataSchemas) > 0 {
+		for _, e := range m.DataSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TrustedIssuers) > 0 {
+		for _, e := range m.TrustedIssuers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +305,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSchemas = append(m.DataSchemas, DataSchema{})
+			if err := m.DataSchemas[len(m.DataSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedIssuers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedIssuers = append(m.TrustedIssuers, TrustedIssuer{})
+			if err := m.TrustedIssuers[len(m.TrustedIssuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -92,8 +92,8 @@ func (pki PubKeyInfo) Validate() error {
 		}
 
 	case ECDSA:
-		if len(pubKey) != 33 {
-			return sdkerrors.Wrapf(ErrInvalidPubKey, "size of the ECDSA public key must be %d in bytes", 33)
+		if ecdsaCurveFromPubKey(pubKey) == nil {
+			return sdkerrors.Wrap(ErrInvalidPubKey, "ECDSA public key must be a compressed P-224, P-256, P-384 or P-521 key")
 		}
 
 	case ED25519:
@@ -119,6 +119,52 @@ func (pki PubKeyInfo) PubKeyBytes() []byte {
 	return bz
 }
 
+// VerifySignature verifies the signature of the given message with the public key.
+// ED25519 and SM2 signatures are verified against the raw message, while RSA, DSA
+// and ECDSA signatures are verified against the SHA-256 digest of the message
+func (pki PubKeyInfo) VerifySignature(msg []byte, sig []byte) error {
+	pubKey, err := hex.DecodeString(pki.PubKey)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidPubKey, err.Error())
+	}
+
+	var valid bool
+
+	switch pki.Algorithm {
+	case RSA:
+		valid = verifyRSASignature(pubKey, msg, sig)
+
+	case DSA:
+		valid = verifyDSASignature(pubKey, msg, sig)
+
+	case ECDSA:
+		valid = verifyECDSASignature(pubKey, msg, sig)
+
+	case ED25519:
+		if len(pubKey) != ed25519.PubKeySize {
+			return sdkerrors.Wrapf(ErrInvalidPubKey, "size of the ED25519 public key must be %d in bytes", ed25519.PubKeySize)
+		}
+		valid = ed25519.PubKey(pubKey).VerifySignature(msg, sig)
+
+	case SM2:
+		if len(pubKey) != sm2.PubKeySize {
+			return sdkerrors.Wrapf(ErrInvalidPubKey, "size of the SM2 public key must be %d in bytes", sm2.PubKeySize)
+		}
+		var sm2PubKey sm2.PubKeySm2
+		copy(sm2PubKey[:], pubKey)
+		valid = sm2PubKey.VerifySignature(msg, sig)
+
+	default:
+		return sdkerrors.Wrap(ErrUnsupportedPubKeyAlgorithm, "")
+	}
+
+	if !valid {
+		return sdkerrors.Wrapf(ErrInvalidSignature, "signature verification failed with the %s public key %s", pki.Algorithm, pki.PubKey)
+	}
+
+	return nil
+}
+
 // PubKeyAlgorithmFromString converts the given string to PubKeyAlgorithm
 func PubKeyAlgorithmFromString(str string) PubKeyAlgorithm {
 	if pkAlgo, ok := PubKeyAlgorithm_value[strings.ToUpper(str)]; ok {
@@ -7,16 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	strconv "strconv"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -146,48 +150,326 @@ func (m *PubKeyInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PubKeyInfo proto.InternalMessageInfo
 
+// ExtensionOptionIdentitySigner defines a tx extension option which binds the
+// signers of a tx to the identities whose public keys produce the signatures
+type ExtensionOptionIdentitySigner struct {
+	Signers []IdentitySigner `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers"`
+}
+
+func (m *ExtensionOptionIdentitySigner) Reset()         { *m = ExtensionOptionIdentitySigner{} }
+func (m *ExtensionOptionIdentitySigner) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionIdentitySigner) ProtoMessage()    {}
+func (*ExtensionOptionIdentitySigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{2}
+}
+func (m *ExtensionOptionIdentitySigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionIdentitySigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionIdentitySigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionIdentitySigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionIdentitySigner.Merge(m, src)
+}
+func (m *ExtensionOptionIdentitySigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionIdentitySigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionIdentitySigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionIdentitySigner proto.InternalMessageInfo
+
+// IdentitySigner defines a tx signer authenticated by an identity public key
+type IdentitySigner struct {
+	Signer    string          `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id        string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Algorithm PubKeyAlgorithm `protobuf:"varint,3,opt,name=algorithm,proto3,enum=iritamod.identity.PubKeyAlgorithm" json:"algorithm,omitempty"`
+}
+
+func (m *IdentitySigner) Reset()         { *m = IdentitySigner{} }
+func (m *IdentitySigner) String() string { return proto.CompactTextString(m) }
+func (*IdentitySigner) ProtoMessage()    {}
+func (*IdentitySigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{3}
+}
+func (m *IdentitySigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentitySigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentitySigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentitySigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentitySigner.Merge(m, src)
+}
+func (m *IdentitySigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentitySigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentitySigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentitySigner proto.InternalMessageInfo
+
+// Params defines the parameters for the identity module
+type Params struct {
+	MaxDataLength           uint64 `protobuf:"varint,1,opt,name=max_data_length,json=maxDataLength,proto3" json:"max_data_length,omitempty" yaml:"max_data_length"`
+	MaxCredentialsLength    uint64 `protobuf:"varint,2,opt,name=max_credentials_length,json=maxCredentialsLength,proto3" json:"max_credentials_length,omitempty" yaml:"max_credentials_length"`
+	MaxPubKeys              uint64 `protobuf:"varint,3,opt,name=max_pub_keys,json=maxPubKeys,proto3" json:"max_pub_keys,omitempty" yaml:"max_pub_keys"`
+	MaxCertificates         uint64 `protobuf:"varint,4,opt,name=max_certificates,json=maxCertificates,proto3" json:"max_certificates,omitempty" yaml:"max_certificates"`
+	VerifyCertificateIssuer bool   `protobuf:"varint,5,opt,name=verify_certificate_issuer,json=verifyCertificateIssuer,proto3" json:"verify_certificate_issuer,omitempty" yaml:"verify_certificate_issuer"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// DataSchema defines a JSON schema registered for validating the identity data
+type DataSchema struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Owner  string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *DataSchema) Reset()         { *m = DataSchema{} }
+func (m *DataSchema) String() string { return proto.CompactTextString(m) }
+func (*DataSchema) ProtoMessage()    {}
+func (*DataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{5}
+}
+func (m *DataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataSchema.Merge(m, src)
+}
+func (m *DataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataSchema proto.InternalMessageInfo
+
+// TrustedIssuer defines a trusted issuer CA of the identity certificates
+type TrustedIssuer struct {
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Certificate string `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Operator    string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *TrustedIssuer) Reset()         { *m = TrustedIssuer{} }
+func (m *TrustedIssuer) String() string { return proto.CompactTextString(m) }
+func (*TrustedIssuer) ProtoMessage()    {}
+func (*TrustedIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{6}
+}
+func (m *TrustedIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedIssuer.Merge(m, src)
+}
+func (m *TrustedIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedIssuer proto.InternalMessageInfo
+
+// CertificateStatus defines the validity status of an identity certificate
+type CertificateStatus struct {
+	CertificateHash string    `protobuf:"bytes,1,opt,name=certificate_hash,json=certificateHash,proto3" json:"certificate_hash,omitempty" yaml:"certificate_hash"`
+	Issuer          string    `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotAfter        time.Time `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after" yaml:"not_after"`
+	Expired         bool      `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	Trusted         bool      `protobuf:"varint,5,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	TrustedIssuerId string    `protobuf:"bytes,6,opt,name=trusted_issuer_id,json=trustedIssuerId,proto3" json:"trusted_issuer_id,omitempty" yaml:"trusted_issuer_id"`
+}
+
+func (m *CertificateStatus) Reset()         { *m = CertificateStatus{} }
+func (m *CertificateStatus) String() string { return proto.CompactTextString(m) }
+func (*CertificateStatus) ProtoMessage()    {}
+func (*CertificateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2433c1f46177a3e0, []int{7}
+}
+func (m *CertificateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CertificateStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CertificateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateStatus.Merge(m, src)
+}
+func (m *CertificateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateStatus proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("iritamod.identity.PubKeyAlgorithm", PubKeyAlgorithm_name, PubKeyAlgorithm_value)
 	proto.RegisterType((*Identity)(nil), "iritamod.identity.Identity")
 	proto.RegisterType((*PubKeyInfo)(nil), "iritamod.identity.PubKeyInfo")
+	proto.RegisterType((*ExtensionOptionIdentitySigner)(nil), "iritamod.identity.ExtensionOptionIdentitySigner")
+	proto.RegisterType((*IdentitySigner)(nil), "iritamod.identity.IdentitySigner")
+	proto.RegisterType((*Params)(nil), "iritamod.identity.Params")
+	proto.RegisterType((*DataSchema)(nil), "iritamod.identity.DataSchema")
+	proto.RegisterType((*TrustedIssuer)(nil), "iritamod.identity.TrustedIssuer")
+	proto.RegisterType((*CertificateStatus)(nil), "iritamod.identity.CertificateStatus")
 }
 
 func init() { proto.RegisterFile("identity/identity.proto", fileDescriptor_2433c1f46177a3e0) }
 
 var fileDescriptor_2433c1f46177a3e0 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xf5, 0xe4, 0xb7, 0x99, 0xf4, 0xcb, 0x67, 0x46, 0x15, 0x58, 0x46, 0x8c, 0x8d, 0x57, 0x11,
-	0x0b, 0x5b, 0x98, 0x16, 0x89, 0xae, 0x48, 0x48, 0x17, 0x55, 0x55, 0x09, 0xd9, 0x62, 0x03, 0x0b,
-	0x64, 0xc7, 0xd3, 0x74, 0x68, 0xec, 0x89, 0xec, 0xb1, 0x2a, 0xbf, 0x01, 0xf2, 0x8a, 0x17, 0x88,
-	0x84, 0x44, 0x17, 0x2c, 0x79, 0x01, 0xf6, 0x59, 0x76, 0xc9, 0xca, 0x82, 0x64, 0x83, 0x2a, 0xb1,
-	0xe9, 0x13, 0x20, 0xdb, 0x31, 0x2d, 0x45, 0xdd, 0x9d, 0x7b, 0xce, 0x99, 0x3b, 0xe7, 0x5e, 0x5d,
-	0x78, 0x8f, 0x7a, 0x24, 0xe0, 0x94, 0x27, 0x46, 0x05, 0xf4, 0x59, 0xc8, 0x38, 0x43, 0x77, 0x68,
-	0x48, 0xb9, 0xe3, 0x33, 0x4f, 0xaf, 0x04, 0x79, 0x6b, 0xc2, 0x26, 0xac, 0x50, 0x8d, 0x1c, 0x95,
-	0x46, 0xed, 0x17, 0x80, 0x1b, 0xfb, 0x6b, 0x0b, 0xea, 0xc1, 0x1a, 0xf5, 0x24, 0xa0, 0x82, 0x7e,
-	0xc7, 0xaa, 0x51, 0x0f, 0xbd, 0x81, 0x1b, 0xb3, 0xd8, 0x7d, 0x7b, 0x42, 0x92, 0x48, 0xaa, 0xa9,
-	0xf5, 0x7e, 0xd7, 0x7c, 0xa0, 0xff, 0xd3, 0x58, 0x7f, 0x19, 0xbb, 0x07, 0x24, 0xd9, 0x0f, 0x8e,
-	0xd8, 0xf0, 0xe1, 0x22, 0x53, 0x84, 0x8b, 0x4c, 0x69, 0xcf, 0x62, 0x37, 0x7f, 0x75, 0x99, 0x29,
-	0xbd, 0xc4, 0xf1, 0xa7, 0xbb, 0xda, 0x9a, 0xd0, 0xac, 0x5c, 0x3a, 0x20, 0x49, 0x84, 0x34, 0xb8,
-	0x39, 0x26, 0x21, 0xa7, 0x47, 0x74, 0xec, 0x70, 0x12, 0x49, 0x75, 0xb5, 0xde, 0xef, 0x58, 0x7f,
-	0x71, 0x48, 0x85, 0xdd, 0x71, 0x48, 0x8a, 0x8f, 0x9c, 0x69, 0x24, 0x35, 0x8a, 0x64, 0xd7, 0x29,
-	0xb4, 0x05, 0x9b, 0xec, 0x34, 0x20, 0xa1, 0xd4, 0x2c, 0xb4, 0xb2, 0x40, 0x08, 0x36, 0x3c, 0x87,
-	0x3b, 0x52, 0xab, 0x20, 0x0b, 0xbc, 0xdb, 0xf8, 0xf9, 0x51, 0x01, 0x5a, 0x0a, 0x20, 0xbc, 0x0a,
-	0x8c, 0xb6, 0x61, 0x7b, 0x3d, 0x61, 0x39, 0xf6, 0xf0, 0xfe, 0x45, 0xa6, 0xb4, 0xca, 0xb0, 0x97,
-	0x99, 0xf2, 0xdf, 0xf5, 0xf0, 0x9a, 0xd5, 0x2a, 0xb3, 0xa3, 0xe7, 0xb0, 0xe3, 0x4c, 0x27, 0x2c,
-	0xa4, 0xfc, 0xd8, 0x97, 0x6a, 0x2a, 0xe8, 0xf7, 0x4c, 0xed, 0xd6, 0xc5, 0x0c, 0x2a, 0xa7, 0x75,
-	0xf5, 0xa8, 0x0c, 0xf3, 0xe8, 0x2b, 0x80, 0xff, 0xdf, 0x30, 0xa1, 0xa7, 0xf0, 0xee, 0xab, 0xe0,
-	0x24, 0x60, 0xa7, 0xc1, 0x0d, 0x45, 0x14, 0x64, 0x39, 0x9d, 0xab, 0xb7, 0xa8, 0x48, 0x84, 0x75,
-	0xcb, 0x1e, 0x88, 0x40, 0x6e, 0xa7, 0x73, 0x35, 0x87, 0x39, 0x33, 0xb2, 0x07, 0x62, 0xad, 0x64,
-	0x46, 0xf6, 0x20, 0x5f, 0xd6, 0xde, 0x8b, 0x9c, 0xab, 0xcb, 0x9d, 0x74, 0xae, 0x96, 0x05, 0x92,
-	0x60, 0x7b, 0x6f, 0x64, 0xee, 0xec, 0x3c, 0x7e, 0x26, 0x36, 0xe4, 0x6e, 0x3a, 0x57, 0xab, 0x32,
-	0xef, 0x60, 0x1f, 0x9a, 0x62, 0xb3, 0xec, 0x60, 0x1f, 0x9a, 0xf2, 0xe6, 0xfb, 0x4f, 0x58, 0xf8,
-	0x7c, 0x86, 0x85, 0x2f, 0x67, 0x18, 0x0c, 0xad, 0xc5, 0x0f, 0x2c, 0x2c, 0x96, 0x18, 0x9c, 0x2f,
-	0x31, 0xf8, 0xbe, 0xc4, 0xe0, 0xc3, 0x0a, 0x0b, 0xe7, 0x2b, 0x2c, 0x7c, 0x5b, 0x61, 0xe1, 0xf5,
-	0xf6, 0x84, 0xf2, 0xe3, 0xd8, 0xd5, 0xc7, 0xcc, 0x37, 0x5c, 0xea, 0x04, 0xef, 0x28, 0x71, 0xa8,
-	0x51, 0xad, 0xc9, 0xf0, 0x99, 0x17, 0x4f, 0x49, 0xf4, 0xe7, 0x72, 0x0d, 0x9e, 0xcc, 0x48, 0xe4,
-	0xb6, 0x8a, 0xbb, 0x7c, 0xf2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x97, 0x48, 0xc8, 0xdb, 0x02,
-	0x00, 0x00,
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3b, 0x6f, 0xdb, 0x56,
+	0x14, 0x16, 0x25, 0x59, 0x8f, 0xeb, 0x97, 0xcc, 0x1a, 0x36, 0xcb, 0xc4, 0x24, 0x4d, 0x74, 0x30,
+	0x3a, 0x48, 0x8d, 0x9a, 0x04, 0x48, 0xa6, 0x4a, 0xb6, 0x8b, 0x18, 0x69, 0x50, 0x83, 0x4a, 0x50,
+	0xa0, 0x1d, 0xd4, 0x2b, 0xf1, 0x4a, 0xba, 0x88, 0xc8, 0x2b, 0x90, 0x57, 0x8d, 0xb4, 0x75, 0x2c,
+	0x34, 0xf9, 0x0f, 0x18, 0x28, 0xd0, 0x0c, 0x1d, 0xfb, 0x07, 0xba, 0x7b, 0x2a, 0x32, 0x76, 0x62,
+	0x5b, 0x7b, 0x29, 0x02, 0x74, 0xe1, 0x2f, 0x28, 0xee, 0x83, 0x12, 0x2d, 0xd5, 0x4b, 0xb7, 0x7b,
+	0xce, 0x77, 0xce, 0x77, 0xde, 0x24, 0xd8, 0xc7, 0x2e, 0xf2, 0x29, 0xa6, 0xd3, 0x5a, 0xf2, 0xa8,
+	0x8e, 0x02, 0x42, 0x89, 0xba, 0x83, 0x03, 0x4c, 0xa1, 0x47, 0xdc, 0x6a, 0x02, 0xe8, 0xbb, 0x7d,
+	0xd2, 0x27, 0x1c, 0xad, 0xb1, 0x97, 0x30, 0xd4, 0xcd, 0x3e, 0x21, 0xfd, 0x21, 0xaa, 0x71, 0xa9,
+	0x33, 0xee, 0xd5, 0x28, 0xf6, 0x50, 0x48, 0xa1, 0x37, 0x12, 0x06, 0xf6, 0x3f, 0x0a, 0x28, 0x9d,
+	0x49, 0x0e, 0x75, 0x0b, 0x64, 0xb1, 0xab, 0x29, 0x96, 0x72, 0x54, 0x76, 0xb2, 0xd8, 0x55, 0xbf,
+	0x01, 0xa5, 0xd1, 0xb8, 0xd3, 0x7e, 0x8d, 0xa6, 0xa1, 0x96, 0xb5, 0x72, 0x47, 0xeb, 0xf5, 0x83,
+	0xea, 0x4a, 0xe4, 0xea, 0xf9, 0xb8, 0xf3, 0x1c, 0x4d, 0xcf, 0xfc, 0x1e, 0x69, 0x1e, 0x5e, 0x45,
+	0x66, 0xe6, 0x7d, 0x64, 0x16, 0x47, 0xe3, 0x0e, 0xf3, 0x8a, 0x23, 0x73, 0x6b, 0x0a, 0xbd, 0xe1,
+	0x53, 0x5b, 0x2a, 0x6c, 0x87, 0x41, 0xcf, 0xd1, 0x34, 0x54, 0x6d, 0xb0, 0xd1, 0x45, 0x01, 0xc5,
+	0x3d, 0xdc, 0x85, 0x14, 0x85, 0x5a, 0xce, 0xca, 0x1d, 0x95, 0x9d, 0x5b, 0x3a, 0xd5, 0x02, 0xeb,
+	0xdd, 0x00, 0xf1, 0x40, 0x70, 0x18, 0x6a, 0x79, 0x9e, 0x59, 0x5a, 0xa5, 0xee, 0x82, 0x35, 0xf2,
+	0xc6, 0x47, 0x81, 0xb6, 0xc6, 0x31, 0x21, 0xa8, 0x2a, 0xc8, 0xbb, 0x90, 0x42, 0xad, 0xc0, 0x95,
+	0xfc, 0xfd, 0x34, 0xff, 0xf7, 0x8f, 0xa6, 0x62, 0xcf, 0x14, 0x00, 0x16, 0x09, 0xab, 0x0f, 0x41,
+	0x51, 0x56, 0x28, 0xca, 0x6e, 0xde, 0x7b, 0x1f, 0x99, 0x05, 0x91, 0x6c, 0x1c, 0x99, 0x9b, 0xe9,
+	0xe4, 0x6d, 0xa7, 0x20, 0x72, 0x57, 0x3f, 0x03, 0x65, 0x38, 0xec, 0x93, 0x00, 0xd3, 0x81, 0xa7,
+	0x65, 0x2d, 0xe5, 0x68, 0xab, 0x6e, 0xdf, 0xd9, 0x98, 0x46, 0x62, 0xe9, 0x2c, 0x9c, 0x64, 0x32,
+	0x1d, 0x70, 0x70, 0x3a, 0xa1, 0xc8, 0x0f, 0x31, 0xf1, 0xbf, 0x1c, 0x51, 0x4c, 0xfc, 0x64, 0x14,
+	0x2d, 0xdc, 0x67, 0x75, 0x34, 0x40, 0x31, 0xe4, 0xaf, 0x50, 0x53, 0x78, 0xff, 0x0f, 0xff, 0x23,
+	0xcc, 0x6d, 0x9f, 0x66, 0x9e, 0xcd, 0xc0, 0x49, 0xfc, 0xec, 0xef, 0x15, 0xb0, 0xb5, 0xc4, 0xba,
+	0x07, 0x0a, 0x02, 0x95, 0xa3, 0x96, 0x92, 0x1c, 0x7f, 0x76, 0x3e, 0xfe, 0x5b, 0x65, 0xe6, 0xfe,
+	0x7f, 0x99, 0x17, 0x39, 0x50, 0x38, 0x87, 0x01, 0xf4, 0x42, 0xb5, 0x09, 0xb6, 0x3d, 0x38, 0x69,
+	0xb3, 0x81, 0xb4, 0x87, 0xc8, 0xef, 0xd3, 0x01, 0xcf, 0x21, 0xdf, 0xd4, 0xe3, 0xc8, 0xdc, 0x13,
+	0xdd, 0x5e, 0x32, 0xb0, 0x9d, 0x4d, 0x0f, 0x4e, 0x4e, 0x20, 0x85, 0x5f, 0x70, 0x59, 0xfd, 0x0a,
+	0xec, 0x31, 0x93, 0xd4, 0x16, 0x24, 0x54, 0x59, 0x4e, 0x75, 0x18, 0x47, 0xe6, 0xc1, 0x82, 0x6a,
+	0xd5, 0xce, 0x76, 0x76, 0x3d, 0x38, 0x39, 0x5e, 0xe8, 0x25, 0xf1, 0x13, 0xb0, 0xc1, 0x1c, 0xe6,
+	0x2b, 0x9f, 0xe3, 0x74, 0xfb, 0x71, 0x64, 0x7e, 0xb0, 0xa0, 0x4b, 0x50, 0xdb, 0x01, 0x1e, 0x9c,
+	0x9c, 0xcb, 0x65, 0xfe, 0x1c, 0x54, 0x78, 0xac, 0xf4, 0x42, 0xe7, 0xb9, 0xfb, 0xbd, 0x38, 0x32,
+	0xf7, 0x53, 0xd9, 0xa4, 0x2c, 0x6c, 0x87, 0x35, 0xe3, 0x38, 0xbd, 0xf0, 0xdf, 0x82, 0x0f, 0xbf,
+	0x43, 0x01, 0xee, 0x4d, 0xd3, 0x86, 0x6d, 0x1c, 0x86, 0x63, 0xb9, 0xe2, 0xa5, 0xe6, 0x47, 0x71,
+	0x64, 0x5a, 0x82, 0xf0, 0x4e, 0x53, 0xdb, 0xd9, 0x17, 0x58, 0x8a, 0xfc, 0x8c, 0x23, 0x72, 0x24,
+	0xe7, 0x00, 0xb0, 0x8e, 0xb6, 0xba, 0x03, 0xe4, 0xc1, 0x95, 0xbb, 0x67, 0x0b, 0xc2, 0x11, 0xb9,
+	0x0c, 0x52, 0x5a, 0x1c, 0x5b, 0x2e, 0x75, 0x6c, 0x92, 0xb1, 0x0b, 0x36, 0x5f, 0x06, 0xe3, 0x90,
+	0x22, 0x57, 0x04, 0x5a, 0x21, 0x65, 0xb7, 0xbc, 0xc8, 0x46, 0x32, 0xa7, 0x55, 0xaa, 0x0e, 0x4a,
+	0x64, 0x84, 0x02, 0x48, 0x49, 0x12, 0x61, 0x2e, 0xcb, 0x20, 0xbf, 0x65, 0xc1, 0x4e, 0xaa, 0xa4,
+	0x16, 0x85, 0x74, 0xcc, 0x9b, 0x9f, 0x6e, 0xc1, 0x00, 0x86, 0x83, 0xe4, 0x9a, 0x17, 0xcd, 0x5f,
+	0xb6, 0xb0, 0x9d, 0xed, 0x94, 0xea, 0x19, 0x0c, 0x07, 0xac, 0x6c, 0xd9, 0x69, 0x59, 0xb6, 0x90,
+	0xd4, 0x57, 0xa0, 0xec, 0x13, 0xda, 0x86, 0x3d, 0x2a, 0x4b, 0x5f, 0xaf, 0xeb, 0x55, 0xf1, 0x61,
+	0xad, 0x26, 0x1f, 0xd6, 0xea, 0xcb, 0xe4, 0xc3, 0xda, 0xbc, 0xcf, 0x0e, 0x30, 0x8e, 0xcc, 0x8a,
+	0x08, 0x3c, 0x77, 0xb5, 0x2f, 0xfe, 0x30, 0x15, 0xa7, 0xe4, 0x13, 0xda, 0x60, 0xa2, 0xaa, 0x81,
+	0x22, 0x9a, 0x8c, 0x70, 0x80, 0x5c, 0xbe, 0x2a, 0x25, 0x27, 0x11, 0x19, 0x42, 0x45, 0x2f, 0xc5,
+	0xcc, 0x9d, 0x44, 0x54, 0x9f, 0x81, 0x1d, 0xf9, 0x94, 0x93, 0x6e, 0x63, 0x57, 0x7c, 0xe5, 0x9a,
+	0xf7, 0xe3, 0xc8, 0xd4, 0x44, 0xc8, 0x15, 0x13, 0xdb, 0xd9, 0xa6, 0xe9, 0xe1, 0x9c, 0xb9, 0xa2,
+	0xa1, 0x1f, 0xff, 0xaa, 0x80, 0xed, 0xa5, 0xfb, 0x55, 0x1f, 0x83, 0xbd, 0x57, 0xfe, 0x6b, 0x9f,
+	0xbc, 0xf1, 0x97, 0x90, 0x4a, 0x46, 0xd7, 0x67, 0x97, 0xd6, 0x1d, 0xa8, 0x5a, 0x01, 0x39, 0xa7,
+	0xd5, 0xa8, 0x28, 0x7a, 0x71, 0x76, 0x69, 0xb1, 0x27, 0xd3, 0x9c, 0xb4, 0x1a, 0x95, 0xac, 0xd0,
+	0x9c, 0xb4, 0x1a, 0x6c, 0x83, 0x4e, 0x8f, 0x99, 0x2e, 0xa7, 0x97, 0x67, 0x97, 0x96, 0x10, 0x58,
+	0xbd, 0xa7, 0x27, 0xf5, 0x47, 0x8f, 0x1e, 0x3c, 0xa9, 0xe4, 0xf5, 0xf5, 0xd9, 0xa5, 0x95, 0x88,
+	0x8c, 0xa1, 0xf5, 0xa2, 0x5e, 0x59, 0x13, 0x0c, 0xad, 0x17, 0x75, 0x7d, 0xe3, 0x87, 0x9f, 0x8c,
+	0xcc, 0xcf, 0x6f, 0x8d, 0xcc, 0x2f, 0x6f, 0x0d, 0xa5, 0xe9, 0x5c, 0xfd, 0x65, 0x64, 0xae, 0xae,
+	0x0d, 0xe5, 0xdd, 0xb5, 0xa1, 0xfc, 0x79, 0x6d, 0x28, 0x17, 0x37, 0x46, 0xe6, 0xdd, 0x8d, 0x91,
+	0xf9, 0xfd, 0xc6, 0xc8, 0x7c, 0xfd, 0xb0, 0x8f, 0xe9, 0x60, 0xdc, 0xa9, 0x76, 0x89, 0x57, 0x83,
+	0xd0, 0x1d, 0xe0, 0x4f, 0x1e, 0x3f, 0xa8, 0xd7, 0x92, 0x2f, 0x58, 0xcd, 0x23, 0xee, 0x78, 0x88,
+	0xc2, 0xf9, 0xcf, 0xb5, 0x46, 0xa7, 0x23, 0x14, 0x76, 0x0a, 0x7c, 0xa6, 0x9f, 0xfe, 0x3b, 0x00,
+	0xdc, 0x1f, 0x86, 0x2d, 0x7e, 0x07, 0x00, 0x00,
 }
 
 func (x PubKeyAlgorithm) String() string {
@@ -273,104 +555,269 @@ func (this *PubKeyInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Identity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *IdentitySigner) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Identity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*IdentitySigner)
+	if !ok {
+		that2, ok := that.(IdentitySigner)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Signer != that1.Signer {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Identity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x32
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Credentials) > 0 {
-		i -= len(m.Credentials)
-		copy(dAtA[i:], m.Credentials)
-		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Credentials)))
-		i--
-		dAtA[i] = 0x22
+	if this.MaxDataLength != that1.MaxDataLength {
+		return false
 	}
-	if len(m.Certificates) > 0 {
-		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Certificates[iNdEx])
-			copy(dAtA[i:], m.Certificates[iNdEx])
-			i = encodeVarintIdentity(dAtA, i, uint64(len(m.Certificates[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if this.MaxCredentialsLength != that1.MaxCredentialsLength {
+		return false
 	}
-	if len(m.PubKeys) > 0 {
-		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintIdentity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.MaxPubKeys != that1.MaxPubKeys {
+		return false
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	if this.MaxCertificates != that1.MaxCertificates {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PubKeyInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.VerifyCertificateIssuer != that1.VerifyCertificateIssuer {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PubKeyInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *PubKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Algorithm != 0 {
-		i = encodeVarintIdentity(dAtA, i, uint64(m.Algorithm))
-		i--
-		dAtA[i] = 0x10
+func (this *DataSchema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataSchema)
+	if !ok {
+		that2, ok := that.(DataSchema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
+}
+func (this *TrustedIssuer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TrustedIssuer)
+	if !ok {
+		that2, ok := that.(TrustedIssuer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Certificate != that1.Certificate {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+func (this *CertificateStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CertificateStatus)
+	if !ok {
+		that2, ok := that.(CertificateStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CertificateHash != that1.CertificateHash {
+		return false
+	}
+	if this.Issuer != that1.Issuer {
+		return false
+	}
+	if !this.NotAfter.Equal(that1.NotAfter) {
+		return false
+	}
+	if this.Expired != that1.Expired {
+		return false
+	}
+	if this.Trusted != that1.Trusted {
+		return false
+	}
+	if this.TrustedIssuerId != that1.TrustedIssuerId {
+		return false
+	}
+	return true
+}
+func (m *Identity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Identity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Identity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Credentials) > 0 {
+		i -= len(m.Credentials)
+		copy(dAtA[i:], m.Credentials)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Credentials)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Certificates) > 0 {
+		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Certificates[iNdEx])
+			copy(dAtA[i:], m.Certificates[iNdEx])
+			i = encodeVarintIdentity(dAtA, i, uint64(len(m.Certificates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIdentity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.PubKey) > 0 {
 		i -= len(m.PubKey)
@@ -382,77 +829,1207 @@ func (m *PubKeyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIdentity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExtensionOptionIdentitySigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Identity) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ExtensionOptionIdentitySigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionIdentitySigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIdentity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentitySigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentitySigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentitySigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
+	if m.Algorithm != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.PubKeys) > 0 {
-		for _, e := range m.PubKeys {
-			l = e.Size()
-			n += 1 + l + sovIdentity(uint64(l))
-		}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Certificates) > 0 {
-		for _, s := range m.Certificates {
-			l = len(s)
-			n += 1 + l + sovIdentity(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifyCertificateIssuer {
+		i--
+		if m.VerifyCertificateIssuer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxCertificates != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.MaxCertificates))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPubKeys != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.MaxPubKeys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxCredentialsLength != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.MaxCredentialsLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxDataLength != 0 {
+		i = encodeVarintIdentity(dAtA, i, uint64(m.MaxDataLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustedIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CertificateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustedIssuerId) > 0 {
+		i -= len(m.TrustedIssuerId)
+		copy(dAtA[i:], m.TrustedIssuerId)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.TrustedIssuerId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Trusted {
+		i--
+		if m.Trusted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.NotAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.NotAfter):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintIdentity(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CertificateHash) > 0 {
+		i -= len(m.CertificateHash)
+		copy(dAtA[i:], m.CertificateHash)
+		i = encodeVarintIdentity(dAtA, i, uint64(len(m.CertificateHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIdentity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIdentity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Identity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovIdentity(uint64(l))
+		}
+	}
+	if len(m.Certificates) > 0 {
+		for _, s := range m.Certificates {
+			l = len(s)
+			n += 1 + l + sovIdentity(uint64(l))
+		}
+	}
+	l = len(m.Credentials)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	return n
+}
+
+func (m *PubKeyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovIdentity(uint64(m.Algorithm))
+	}
+	return n
+}
+
+func (m *ExtensionOptionIdentitySigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovIdentity(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentitySigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovIdentity(uint64(m.Algorithm))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDataLength != 0 {
+		n += 1 + sovIdentity(uint64(m.MaxDataLength))
+	}
+	if m.MaxCredentialsLength != 0 {
+		n += 1 + sovIdentity(uint64(m.MaxCredentialsLength))
+	}
+	if m.MaxPubKeys != 0 {
+		n += 1 + sovIdentity(uint64(m.MaxPubKeys))
+	}
+	if m.MaxCertificates != 0 {
+		n += 1 + sovIdentity(uint64(m.MaxCertificates))
+	}
+	if m.VerifyCertificateIssuer {
+		n += 2
+	}
+	return n
+}
+
+func (m *DataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	return n
+}
+
+func (m *TrustedIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	return n
+}
+
+func (m *CertificateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CertificateHash)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.NotAfter)
+	n += 1 + l + sovIdentity(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	if m.Trusted {
+		n += 2
+	}
+	l = len(m.TrustedIssuerId)
+	if l > 0 {
+		n += 1 + l + sovIdentity(uint64(l))
+	}
+	return n
+}
+
+func sovIdentity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIdentity(x uint64) (n int) {
+	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Identity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Identity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Identity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, PubKeyInfo{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificates = append(m.Certificates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= PubKeyAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionIdentitySigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionIdentitySigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionIdentitySigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, IdentitySigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentitySigner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentitySigner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentitySigner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= PubKeyAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataLength", wireType)
+			}
+			m.MaxDataLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCredentialsLength", wireType)
+			}
+			m.MaxCredentialsLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCredentialsLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPubKeys", wireType)
+			}
+			m.MaxPubKeys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPubKeys |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCertificates", wireType)
+			}
+			m.MaxCertificates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCertificates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyCertificateIssuer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyCertificateIssuer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Credentials)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	return n
-}
 
-func (m *PubKeyInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovIdentity(uint64(l))
-	}
-	if m.Algorithm != 0 {
-		n += 1 + sovIdentity(uint64(m.Algorithm))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIdentity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIdentity(x uint64) (n int) {
-	return sovIdentity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Identity) Unmarshal(dAtA []byte) error {
+func (m *DataSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -475,10 +2052,10 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Identity: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Identity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -515,9 +2092,9 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -527,29 +2104,27 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIdentity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIdentity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKeys = append(m.PubKeys, PubKeyInfo{})
-			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -577,11 +2152,61 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificates = append(m.Certificates, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -609,11 +2234,11 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credentials = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -641,11 +2266,11 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Certificate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -673,7 +2298,7 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -696,7 +2321,7 @@ func (m *Identity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubKeyInfo) Unmarshal(dAtA []byte) error {
+func (m *CertificateStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -719,15 +2344,15 @@ func (m *PubKeyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubKeyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CertificateStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CertificateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -755,13 +2380,78 @@ func (m *PubKeyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
+			m.CertificateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			m.Algorithm = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIdentity
@@ -771,11 +2461,64 @@ func (m *PubKeyInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Algorithm |= PubKeyAlgorithm(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trusted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trusted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedIssuerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIdentity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedIssuerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIdentity(dAtA[iNdEx:])
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -26,6 +28,9 @@ var (
 	CredentialsKey    = []byte{0x04} // prefix for credentials
 	PubKeyIdentityKey = []byte{0x05} // prefix for mapping public key to identity
 	DataKey           = []byte{0x06}
+	DataSchemaKey     = []byte{0x07} // prefix for data schema
+	TrustedIssuerKey  = []byte{0x08} // prefix for trusted issuer
+	OwnerIdentityKey  = []byte{0x09} // prefix for mapping owner to identity
 )
 
 // GetOwnerKey gets the key for the owner of the specified identity
@@ -79,3 +84,26 @@ func GetPubKeySubspace(identityID []byte) []byte {
 func GetCertificateSubspace(identityID []byte) []byte {
 	return append(CertificateKey, identityID...)
 }
+
+// GetDataSchemaKey gets the key for the data schema of the specified ID
+// VALUE: DataSchema
+func GetDataSchemaKey(schemaID string) []byte {
+	return append(DataSchemaKey, []byte(schemaID)...)
+}
+
+// GetTrustedIssuerKey gets the key for the trusted issuer of the specified ID
+// VALUE: TrustedIssuer
+func GetTrustedIssuerKey(issuerID []byte) []byte {
+	return append(TrustedIssuerKey, issuerID...)
+}
+
+// GetOwnerIdentityKey gets the key for mapping the specified owner to the identity ID
+// VALUE: []byte{}
+func GetOwnerIdentityKey(owner []byte, identityID []byte) []byte {
+	return append(GetOwnerIdentitySubspace(owner), identityID...)
+}
+
+// GetOwnerIdentitySubspace gets the key prefix for the identities of the specified owner
+func GetOwnerIdentitySubspace(owner []byte) []byte {
+	return append(append([]byte{}, OwnerIdentityKey...), address.MustLengthPrefix(owner)...)
+}
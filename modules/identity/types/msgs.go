@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +12,12 @@ import (
 
 // Identity message types and params
 const (
-	TypeMsgCreateIdentity = "create_identity" // type for MsgCreateIdentity
-	TypeMsgUpdateIdentity = "update_identity" // type for MsgUpdateIdentity
+	TypeMsgCreateIdentity      = "create_identity"       // type for MsgCreateIdentity
+	TypeMsgUpdateIdentity      = "update_identity"       // type for MsgUpdateIdentity
+	TypeMsgVerifySignature     = "verify_signature"      // type for MsgVerifySignature
+	TypeMsgRegisterDataSchema  = "register_data_schema"  // type for MsgRegisterDataSchema
+	TypeMsgAddTrustedIssuer    = "add_trusted_issuer"    // type for MsgAddTrustedIssuer
+	TypeMsgRemoveTrustedIssuer = "remove_trusted_issuer" // type for MsgRemoveTrustedIssuer
 
 	IDLength     = 16  // size of the ID in bytes
 	MaxURILength = 140 // maximum size of the URI
@@ -25,6 +30,10 @@ const (
 var (
 	_ sdk.Msg = &MsgCreateIdentity{}
 	_ sdk.Msg = &MsgUpdateIdentity{}
+	_ sdk.Msg = &MsgVerifySignature{}
+	_ sdk.Msg = &MsgRegisterDataSchema{}
+	_ sdk.Msg = &MsgAddTrustedIssuer{}
+	_ sdk.Msg = &MsgRemoveTrustedIssuer{}
 )
 
 // NewMsgCreateIdentity creates a new MsgCreateIdentity instance
@@ -131,6 +140,223 @@ func (msg MsgUpdateIdentity) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+// NewMsgVerifySignature creates a new MsgVerifySignature instance
+func NewMsgVerifySignature(
+	id tmbytes.HexBytes,
+	algorithm PubKeyAlgorithm,
+	data []byte,
+	signature []byte,
+	sender sdk.AccAddress,
+) *MsgVerifySignature {
+	return &MsgVerifySignature{
+		Id:        id.String(),
+		Algorithm: algorithm,
+		Data:      data,
+		Signature: signature,
+		Sender:    sender.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgVerifySignature) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgVerifySignature) Type() string { return TypeMsgVerifySignature }
+
+// GetSignBytes implements Msg.
+func (msg MsgVerifySignature) GetSignBytes() []byte {
+	b := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgVerifySignature) ValidateBasic() error {
+	if msg.Sender == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender missing")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if len(msg.Id) > IdLengthMax*2 || len(msg.Id) < IdLengthMin*2 {
+		return sdkerrors.Wrapf(ErrInvalidID, "size of the ID must be %d ~ %d in bytes", IdLengthMin, IdLengthMax)
+	}
+
+	if _, err := hex.DecodeString(msg.Id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidID, "id not hex encoding")
+	}
+
+	if _, ok := PubKeyAlgorithm_name[int32(msg.Algorithm)]; !ok || msg.Algorithm == UnknownPubKeyAlgorithm {
+		return sdkerrors.Wrap(ErrUnsupportedPubKeyAlgorithm, "")
+	}
+
+	if len(msg.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignature, "data missing")
+	}
+
+	if len(msg.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignature, "signature missing")
+	}
+
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgVerifySignature) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRegisterDataSchema creates a new MsgRegisterDataSchema instance
+func NewMsgRegisterDataSchema(id string, schema string, owner sdk.AccAddress) *MsgRegisterDataSchema {
+	return &MsgRegisterDataSchema{
+		Id:     id,
+		Schema: schema,
+		Owner:  owner.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRegisterDataSchema) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRegisterDataSchema) Type() string { return TypeMsgRegisterDataSchema }
+
+// GetSignBytes implements Msg.
+func (msg MsgRegisterDataSchema) GetSignBytes() []byte {
+	b := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRegisterDataSchema) ValidateBasic() error {
+	if msg.Owner == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner missing")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	if err := ValidateDataSchemaID(msg.Id); err != nil {
+		return err
+	}
+
+	return ValidateDataSchema(msg.Schema)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRegisterDataSchema) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddTrustedIssuer creates a new MsgAddTrustedIssuer instance
+func NewMsgAddTrustedIssuer(certificate string, operator sdk.AccAddress) *MsgAddTrustedIssuer {
+	return &MsgAddTrustedIssuer{
+		Certificate: certificate,
+		Operator:    operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgAddTrustedIssuer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgAddTrustedIssuer) Type() string { return TypeMsgAddTrustedIssuer }
+
+// GetSignBytes implements Msg.
+func (msg MsgAddTrustedIssuer) GetSignBytes() []byte {
+	b := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgAddTrustedIssuer) ValidateBasic() error {
+	if msg.Operator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator missing")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return err
+	}
+
+	return CheckIssuerCertificate([]byte(msg.Certificate))
+}
+
+// GetSigners implements Msg.
+func (msg MsgAddTrustedIssuer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveTrustedIssuer creates a new MsgRemoveTrustedIssuer instance
+func NewMsgRemoveTrustedIssuer(id tmbytes.HexBytes, operator sdk.AccAddress) *MsgRemoveTrustedIssuer {
+	return &MsgRemoveTrustedIssuer{
+		Id:       id.String(),
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRemoveTrustedIssuer) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRemoveTrustedIssuer) Type() string { return TypeMsgRemoveTrustedIssuer }
+
+// GetSignBytes implements Msg.
+func (msg MsgRemoveTrustedIssuer) GetSignBytes() []byte {
+	b := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRemoveTrustedIssuer) ValidateBasic() error {
+	if msg.Operator == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator missing")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+		return err
+	}
+
+	return ValidateTrustedIssuerID(msg.Id)
+}
+
+// GetSigners implements Msg.
+func (msg MsgRemoveTrustedIssuer) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateTrustedIssuerID validates the trusted issuer ID, which is the hex encoded
+// hash of the issuer certificate
+func ValidateTrustedIssuerID(id string) error {
+	bz, err := hex.DecodeString(id)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidID, "trusted issuer id not hex encoding")
+	}
+
+	if len(bz) != tmhash.Size {
+		return sdkerrors.Wrapf(ErrInvalidID, "size of the trusted issuer id must be %d in bytes", tmhash.Size)
+	}
+
+	return nil
+}
+
 // ValidateIdentityFields validates the given identity fields
 func ValidateIdentityFields(
 	id string,
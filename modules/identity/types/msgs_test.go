@@ -187,6 +187,89 @@ func TestMsgUpdateIdentityGetSigners(t *testing.T) {
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
 
+// TestMsgVerifySignatureValidation tests ValidateBasic for MsgVerifySignature
+func TestMsgVerifySignatureValidation(t *testing.T) {
+	emptyAddress := sdk.AccAddress{}
+
+	invalidID := []byte("ID")
+	testMsg := []byte("test message")
+	testSig := []byte("test signature")
+
+	testMsgs := []*MsgVerifySignature{
+		NewMsgVerifySignature(testID, SM2, testMsg, testSig, testOwner),                    // valid msg
+		NewMsgVerifySignature(testID, SM2, testMsg, testSig, emptyAddress),                 // missing sender address
+		NewMsgVerifySignature(nil, SM2, testMsg, testSig, testOwner),                       // missing ID
+		NewMsgVerifySignature(invalidID, SM2, testMsg, testSig, testOwner),                 // invalid ID
+		NewMsgVerifySignature(testID, UnknownPubKeyAlgorithm, testMsg, testSig, testOwner), // invalid public key algorithm
+		NewMsgVerifySignature(testID, SM2, nil, testSig, testOwner),                        // missing data
+		NewMsgVerifySignature(testID, SM2, testMsg, nil, testOwner),                        // missing signature
+	}
+
+	testCases := []struct {
+		msg     *MsgVerifySignature
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing sender address"},
+		{testMsgs[2], false, "missing ID"},
+		{testMsgs[3], false, "invalid ID"},
+		{testMsgs[4], false, "invalid public key algorithm"},
+		{testMsgs[5], false, "missing data"},
+		{testMsgs[6], false, "missing signature"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgRegisterDataSchemaValidation tests ValidateBasic for MsgRegisterDataSchema
+func TestMsgRegisterDataSchemaValidation(t *testing.T) {
+	emptyAddress := sdk.AccAddress{}
+
+	testSchemaID := "profile/v1"
+	testSchema := `{"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}`
+
+	testMsgs := []*MsgRegisterDataSchema{
+		NewMsgRegisterDataSchema(testSchemaID, testSchema, testOwner),                                                  // valid msg
+		NewMsgRegisterDataSchema(testSchemaID, testSchema, emptyAddress),                                               // missing owner address
+		NewMsgRegisterDataSchema("", testSchema, testOwner),                                                            // missing ID
+		NewMsgRegisterDataSchema("1profile", testSchema, testOwner),                                                    // invalid ID
+		NewMsgRegisterDataSchema(testSchemaID, "invalidSchema", testOwner),                                             // invalid schema
+		NewMsgRegisterDataSchema(testSchemaID, `{"type": "object", "required": ["name"]}`, testOwner),                  // undefined required property
+		NewMsgRegisterDataSchema(testSchemaID, `{"type": "object", "properties": {"a": {"type": "date"}}}`, testOwner), // unsupported type
+	}
+
+	testCases := []struct {
+		msg     *MsgRegisterDataSchema
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing owner address"},
+		{testMsgs[2], false, "missing ID"},
+		{testMsgs[3], false, "invalid ID"},
+		{testMsgs[4], false, "invalid schema"},
+		{testMsgs[5], false, "undefined required property"},
+		{testMsgs[6], false, "unsupported type"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
 const testCertificate = `-----BEGIN CERTIFICATE-----
 MIIDTDCCAjQCCQDvRoz+e/HRpDANBgkqhkiG9w0BAQsFADBoMQswCQYDVQQGEwJj
 bjELMAkGA1UECAwCc2gxCzAJBgNVBAcMAnBkMQswCQYDVQQKDAJiajELMAkGA1UE
@@ -219,7 +302,7 @@ func TestValidateGenesis(t *testing.T) {
 		Credentials:  testCredentials,
 		Owner:        testOwner.String(),
 	}
-	err := ValidateGenesis(GenesisState{[]Identity{id}})
+	err := ValidateGenesis(GenesisState{Identities: []Identity{id}, Params: DefaultParams()})
 	require.NoError(t, err)
 }
 
@@ -253,3 +336,45 @@ func TestValMsgCreateIdentity(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRemoveTrustedIssuerValidation(t *testing.T) {
+	emptyAddress := sdk.AccAddress{}
+
+	testIssuerID := GetCertificateHash(testCertificate)
+
+	testMsgs := []*MsgRemoveTrustedIssuer{
+		NewMsgRemoveTrustedIssuer(testIssuerID, testOwner),      // valid msg
+		NewMsgRemoveTrustedIssuer(testIssuerID, emptyAddress),   // missing operator address
+		NewMsgRemoveTrustedIssuer(nil, testOwner),               // missing ID
+		NewMsgRemoveTrustedIssuer(testIssuerID[:16], testOwner), // invalid ID
+	}
+
+	testCases := []struct {
+		msg     *MsgRemoveTrustedIssuer
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing operator address"},
+		{testMsgs[2], false, "missing ID"},
+		{testMsgs[3], false, "invalid ID"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func TestMsgAddTrustedIssuerValidation(t *testing.T) {
+	// the test certificate is not a CA certificate
+	msg := NewMsgAddTrustedIssuer(testCertificate, testOwner)
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidCertificate)
+
+	msg = NewMsgAddTrustedIssuer("invalidCertificate", testOwner)
+	require.Error(t, msg.ValidateBasic())
+}
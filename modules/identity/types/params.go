@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// identity params default values
+const (
+	DefaultMaxDataLength        uint64 = 4096
+	DefaultMaxCredentialsLength uint64 = MaxURILength
+	DefaultMaxPubKeys           uint64 = 16
+	DefaultMaxCertificates      uint64 = 8
+
+	DefaultVerifyCertificateIssuer = false
+)
+
+// Parameter store keys
+var (
+	KeyMaxDataLength        = []byte("MaxDataLength")
+	KeyMaxCredentialsLength = []byte("MaxCredentialsLength")
+	KeyMaxPubKeys           = []byte("MaxPubKeys")
+	KeyMaxCertificates      = []byte("MaxCertificates")
+
+	KeyVerifyCertificateIssuer = []byte("VerifyCertificateIssuer")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new Params instance
+func NewParams(
+	maxDataLength, maxCredentialsLength, maxPubKeys, maxCertificates uint64,
+	verifyCertificateIssuer bool,
+) Params {
+	return Params{
+		MaxDataLength:           maxDataLength,
+		MaxCredentialsLength:    maxCredentialsLength,
+		MaxPubKeys:              maxPubKeys,
+		MaxCertificates:         maxCertificates,
+		VerifyCertificateIssuer: verifyCertificateIssuer,
+	}
+}
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxDataLength, &p.MaxDataLength, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxCredentialsLength, &p.MaxCredentialsLength, validateMaxCredentialsLength),
+		paramtypes.NewParamSetPair(KeyMaxPubKeys, &p.MaxPubKeys, validateLimit),
+		paramtypes.NewParamSetPair(KeyMaxCertificates, &p.MaxCertificates, validateLimit),
+		paramtypes.NewParamSetPair(KeyVerifyCertificateIssuer, &p.VerifyCertificateIssuer, validateBool),
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxDataLength,
+		DefaultMaxCredentialsLength,
+		DefaultMaxPubKeys,
+		DefaultMaxCertificates,
+		DefaultVerifyCertificateIssuer,
+	)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateLimit(p.MaxDataLength); err != nil {
+		return err
+	}
+	if err := validateMaxCredentialsLength(p.MaxCredentialsLength); err != nil {
+		return err
+	}
+	if err := validateLimit(p.MaxPubKeys); err != nil {
+		return err
+	}
+	return validateLimit(p.MaxCertificates)
+}
+
+func validateLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("limit must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxCredentialsLength(i interface{}) error {
+	if err := validateLimit(i); err != nil {
+		return err
+	}
+
+	if v := i.(uint64); v > MaxURILength {
+		return fmt.Errorf("max credentials length must not be greater than %d: %d", MaxURILength, v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
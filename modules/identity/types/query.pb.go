@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -75,7 +77,8 @@ func (m *QueryIdentityRequest) GetId() string {
 
 // QueryIdentityResponse is response type for the Query/Identity RPC method
 type QueryIdentityResponse struct {
-	Identity *Identity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Identity            *Identity           `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	CertificateStatuses []CertificateStatus `protobuf:"bytes,2,rep,name=certificate_statuses,json=certificateStatuses,proto3" json:"certificate_statuses"`
 }
 
 func (m *QueryIdentityResponse) Reset()         { *m = QueryIdentityResponse{} }
@@ -118,33 +121,336 @@ func (m *QueryIdentityResponse) GetIdentity() *Identity {
 	return nil
 }
 
+func (m *QueryIdentityResponse) GetCertificateStatuses() []CertificateStatus {
+	if m != nil {
+		return m.CertificateStatuses
+	}
+	return nil
+}
+
+// QueryDataSchemaRequest is request type for the Query/DataSchema RPC method
+type QueryDataSchemaRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDataSchemaRequest) Reset()         { *m = QueryDataSchemaRequest{} }
+func (m *QueryDataSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSchemaRequest) ProtoMessage()    {}
+func (*QueryDataSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{2}
+}
+func (m *QueryDataSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSchemaRequest.Merge(m, src)
+}
+func (m *QueryDataSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryDataSchemaRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryDataSchemaResponse is response type for the Query/DataSchema RPC method
+type QueryDataSchemaResponse struct {
+	DataSchema *DataSchema `protobuf:"bytes,1,opt,name=data_schema,json=dataSchema,proto3" json:"data_schema,omitempty"`
+}
+
+func (m *QueryDataSchemaResponse) Reset()         { *m = QueryDataSchemaResponse{} }
+func (m *QueryDataSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataSchemaResponse) ProtoMessage()    {}
+func (*QueryDataSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{3}
+}
+func (m *QueryDataSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataSchemaResponse.Merge(m, src)
+}
+func (m *QueryDataSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryDataSchemaResponse) GetDataSchema() *DataSchema {
+	if m != nil {
+		return m.DataSchema
+	}
+	return nil
+}
+
+// QueryTrustedIssuersRequest is request type for the Query/TrustedIssuers RPC method
+type QueryTrustedIssuersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTrustedIssuersRequest) Reset()         { *m = QueryTrustedIssuersRequest{} }
+func (m *QueryTrustedIssuersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustedIssuersRequest) ProtoMessage()    {}
+func (*QueryTrustedIssuersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{4}
+}
+func (m *QueryTrustedIssuersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustedIssuersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustedIssuersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustedIssuersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustedIssuersRequest.Merge(m, src)
+}
+func (m *QueryTrustedIssuersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustedIssuersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustedIssuersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrustedIssuersRequest proto.InternalMessageInfo
+
+func (m *QueryTrustedIssuersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTrustedIssuersResponse is response type for the Query/TrustedIssuers RPC method
+type QueryTrustedIssuersResponse struct {
+	TrustedIssuers []TrustedIssuer     `protobuf:"bytes,1,rep,name=trusted_issuers,json=trustedIssuers,proto3" json:"trusted_issuers"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTrustedIssuersResponse) Reset()         { *m = QueryTrustedIssuersResponse{} }
+func (m *QueryTrustedIssuersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrustedIssuersResponse) ProtoMessage()    {}
+func (*QueryTrustedIssuersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{5}
+}
+func (m *QueryTrustedIssuersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrustedIssuersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrustedIssuersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTrustedIssuersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrustedIssuersResponse.Merge(m, src)
+}
+func (m *QueryTrustedIssuersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrustedIssuersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrustedIssuersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrustedIssuersResponse proto.InternalMessageInfo
+
+func (m *QueryTrustedIssuersResponse) GetTrustedIssuers() []TrustedIssuer {
+	if m != nil {
+		return m.TrustedIssuers
+	}
+	return nil
+}
+
+func (m *QueryTrustedIssuersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1db28350c35965ea, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryIdentityRequest)(nil), "iritamod.identity.QueryIdentityRequest")
 	proto.RegisterType((*QueryIdentityResponse)(nil), "iritamod.identity.QueryIdentityResponse")
+	proto.RegisterType((*QueryDataSchemaRequest)(nil), "iritamod.identity.QueryDataSchemaRequest")
+	proto.RegisterType((*QueryDataSchemaResponse)(nil), "iritamod.identity.QueryDataSchemaResponse")
+	proto.RegisterType((*QueryTrustedIssuersRequest)(nil), "iritamod.identity.QueryTrustedIssuersRequest")
+	proto.RegisterType((*QueryTrustedIssuersResponse)(nil), "iritamod.identity.QueryTrustedIssuersResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "iritamod.identity.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "iritamod.identity.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("identity/query.proto", fileDescriptor_1db28350c35965ea) }
 
 var fileDescriptor_1db28350c35965ea = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0x4c, 0x49, 0xcd,
-	0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0xcc, 0x2c, 0xca, 0x2c, 0x49, 0xcc, 0xcd, 0x4f, 0xd1, 0x83, 0x49, 0x4b, 0x89, 0xc3,
-	0x15, 0xc2, 0x18, 0x10, 0xb5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05,
-	0x99, 0xfa, 0x89, 0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x10, 0x59, 0x25,
-	0x35, 0x2e, 0x91, 0x40, 0x90, 0xc1, 0x9e, 0x50, 0x4d, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25,
-	0x42, 0x7c, 0x5c, 0x4c, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x4c, 0x99, 0x29,
-	0x4a, 0x01, 0x5c, 0xa2, 0x68, 0xea, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0xcc, 0xb9, 0x38,
-	0x60, 0x16, 0x82, 0x95, 0x73, 0x1b, 0x49, 0xeb, 0x61, 0xb8, 0x4e, 0x0f, 0xae, 0x0d, 0xae, 0xd8,
-	0x68, 0x32, 0x23, 0x17, 0x2b, 0xd8, 0x48, 0xa1, 0x4e, 0x46, 0x2e, 0x0e, 0x98, 0x02, 0x21, 0x75,
-	0x2c, 0xba, 0xb1, 0xb9, 0x50, 0x4a, 0x83, 0xb0, 0x42, 0x88, 0x13, 0x95, 0xb4, 0x9a, 0x2e, 0x3f,
-	0x99, 0xcc, 0xa4, 0x22, 0xa4, 0xa4, 0x0f, 0xd3, 0xa1, 0x8f, 0x1e, 0x58, 0x99, 0xa9, 0xc5, 0xfa,
-	0xd5, 0x99, 0x29, 0xb5, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x94, 0x99, 0x98,
-	0x97, 0x95, 0x99, 0x9a, 0x98, 0x89, 0x30, 0x31, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0x61,
-	0x72, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x98, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xb7, 0x23, 0xd5, 0x8e, 0xc8, 0x01, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x50, 0xaa, 0xf2, 0x2a, 0x15, 0x71, 0x0d, 0xb4, 0x75, 0xa9, 0xdb, 0x5a, 0xa5,
+	0x0d, 0x95, 0xb0, 0x21, 0x20, 0x10, 0x0c, 0x0c, 0x85, 0xa5, 0x4b, 0x29, 0x29, 0x12, 0x02, 0x09,
+	0x45, 0x57, 0xfb, 0x70, 0x4e, 0xaa, 0x7d, 0xae, 0xef, 0x3c, 0x04, 0xc4, 0xc2, 0x86, 0xc4, 0x80,
+	0xc4, 0xc8, 0x37, 0x60, 0xe1, 0x0b, 0xf0, 0x01, 0x3a, 0x56, 0x62, 0x61, 0x42, 0x28, 0xe1, 0x83,
+	0xa0, 0xde, 0x9d, 0x9d, 0x38, 0x71, 0xd4, 0x6c, 0xa7, 0x7b, 0xff, 0xf7, 0xde, 0xef, 0xbd, 0xfb,
+	0xdb, 0x50, 0xa3, 0x3e, 0x89, 0x04, 0x15, 0x1d, 0xf7, 0x38, 0x25, 0x49, 0xc7, 0x89, 0x13, 0x26,
+	0x18, 0xba, 0x42, 0x13, 0x2a, 0x70, 0xc8, 0x7c, 0x27, 0x0b, 0x9b, 0x0b, 0xb9, 0x30, 0x3b, 0x28,
+	0xad, 0x79, 0x3d, 0x60, 0x2c, 0x38, 0x22, 0x2e, 0x8e, 0xa9, 0x8b, 0xa3, 0x88, 0x09, 0x2c, 0x28,
+	0x8b, 0xb8, 0x8e, 0xd6, 0x02, 0x16, 0x30, 0x79, 0x74, 0xcf, 0x4e, 0xfa, 0x76, 0xc5, 0x63, 0x3c,
+	0x64, 0x5c, 0xf5, 0x74, 0x63, 0x1c, 0xd0, 0x48, 0x66, 0xa9, 0xb0, 0xbd, 0x09, 0xb5, 0xe7, 0x67,
+	0x91, 0x5d, 0xdd, 0xa9, 0x49, 0x8e, 0x53, 0xc2, 0x05, 0x9a, 0x83, 0x2a, 0xf5, 0x17, 0x8d, 0x35,
+	0xa3, 0x7e, 0xa9, 0x59, 0xa5, 0xbe, 0xfd, 0xc3, 0x80, 0xab, 0x43, 0x42, 0x1e, 0xb3, 0x88, 0x13,
+	0xf4, 0x00, 0x66, 0x32, 0x4c, 0xa9, 0x9f, 0x6d, 0x2c, 0x3b, 0x23, 0x33, 0x39, 0x79, 0x5a, 0x2e,
+	0x46, 0x6f, 0xa0, 0xe6, 0x91, 0x44, 0xd0, 0xb7, 0xd4, 0xc3, 0x82, 0xb4, 0xb8, 0xc0, 0x22, 0xe5,
+	0x84, 0x2f, 0x56, 0xd7, 0x2e, 0xd4, 0x67, 0x1b, 0x1b, 0x25, 0x45, 0x9e, 0xf4, 0xe5, 0x07, 0x52,
+	0xbd, 0x33, 0x75, 0xf2, 0x67, 0xb5, 0xd2, 0x9c, 0xf7, 0x86, 0x03, 0x84, 0xdb, 0x75, 0xb8, 0x26,
+	0x81, 0x9f, 0x62, 0x81, 0x0f, 0xbc, 0x36, 0x09, 0xf1, 0xb8, 0xd9, 0x5e, 0xc1, 0xc2, 0x88, 0x52,
+	0x0f, 0xf7, 0x18, 0x66, 0x7d, 0x2c, 0x70, 0x8b, 0xcb, 0x6b, 0x3d, 0xdf, 0x4a, 0x09, 0xda, 0x40,
+	0x2e, 0xf8, 0xf9, 0xd9, 0x7e, 0x09, 0xa6, 0x2c, 0xfd, 0x22, 0x49, 0xb9, 0x20, 0xfe, 0x2e, 0xe7,
+	0x29, 0x49, 0x78, 0x06, 0xf2, 0x10, 0xa0, 0xff, 0x20, 0xba, 0xf8, 0x92, 0xa3, 0x1e, 0xcc, 0x51,
+	0x26, 0xd9, 0xc7, 0x01, 0xd1, 0xf2, 0xe6, 0x80, 0xd8, 0xfe, 0x6e, 0xc0, 0x72, 0x69, 0x65, 0x0d,
+	0xfe, 0x0c, 0x2e, 0x0b, 0x15, 0x69, 0x51, 0x15, 0x5a, 0x34, 0xe4, 0x5e, 0xd7, 0x4a, 0xe0, 0x0b,
+	0x35, 0xf4, 0x4e, 0xe7, 0x44, 0xa1, 0x30, 0x7a, 0x54, 0x60, 0xad, 0x4a, 0x56, 0xb3, 0x8c, 0x55,
+	0x01, 0x14, 0x60, 0x6b, 0x80, 0x24, 0xeb, 0x3e, 0x4e, 0x70, 0x98, 0x4d, 0x6f, 0xef, 0xc1, 0x7c,
+	0xe1, 0x36, 0xf7, 0xd3, 0x74, 0x2c, 0x6f, 0xf2, 0x85, 0x8c, 0x02, 0xab, 0x14, 0x4d, 0xaa, 0xe5,
+	0x8d, 0x9f, 0x53, 0x70, 0x51, 0x16, 0x44, 0x9f, 0x0c, 0x98, 0xc9, 0x0c, 0x87, 0xb6, 0x4a, 0xf2,
+	0xcb, 0x2c, 0x6f, 0xd6, 0xcf, 0x17, 0x2a, 0x44, 0x7b, 0xfb, 0xe3, 0xaf, 0x7f, 0x5f, 0xab, 0x1b,
+	0xc8, 0x76, 0xb3, 0x0c, 0x77, 0xf8, 0x93, 0xa5, 0x84, 0xbb, 0xef, 0xa9, 0xff, 0x01, 0x7d, 0x36,
+	0x00, 0xfa, 0xe6, 0x40, 0x37, 0xc7, 0x35, 0x19, 0xb1, 0xa9, 0xb9, 0x3d, 0x89, 0x54, 0x13, 0x6d,
+	0x49, 0xa2, 0x75, 0xb4, 0x5a, 0x42, 0xa4, 0xbc, 0xab, 0x71, 0xbe, 0x19, 0x30, 0x57, 0xb4, 0x0c,
+	0xba, 0x35, 0xae, 0x4f, 0xa9, 0x69, 0x4d, 0x67, 0x52, 0xf9, 0x04, 0xcb, 0x1a, 0xb2, 0x28, 0x7a,
+	0x07, 0xd3, 0xea, 0x69, 0xd1, 0x8d, 0x71, 0x5d, 0x0a, 0x1e, 0x32, 0x37, 0xcf, 0x93, 0x69, 0x88,
+	0x75, 0x09, 0xb1, 0x8c, 0x96, 0x4a, 0x20, 0x94, 0x7d, 0x76, 0xf6, 0x4e, 0xba, 0x96, 0x71, 0xda,
+	0xb5, 0x8c, 0xbf, 0x5d, 0xcb, 0xf8, 0xd2, 0xb3, 0x2a, 0xa7, 0x3d, 0xab, 0xf2, 0xbb, 0x67, 0x55,
+	0x5e, 0xdf, 0x0b, 0xa8, 0x68, 0xa7, 0x87, 0x8e, 0xc7, 0x42, 0x17, 0x63, 0xbf, 0x4d, 0x6f, 0xdf,
+	0xbf, 0xd3, 0xe8, 0x17, 0x0a, 0x99, 0x9f, 0x1e, 0x11, 0x3e, 0x30, 0x55, 0x27, 0x26, 0xfc, 0x70,
+	0x5a, 0xfe, 0x60, 0xef, 0xfe, 0x1f, 0x00, 0x06, 0x1c, 0x2b, 0xd3, 0xf7, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -161,6 +467,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Identity queries the identity by the given id
 	Identity(ctx context.Context, in *QueryIdentityRequest, opts ...grpc.CallOption) (*QueryIdentityResponse, error)
+	// DataSchema queries the data schema by the given id
+	DataSchema(ctx context.Context, in *QueryDataSchemaRequest, opts ...grpc.CallOption) (*QueryDataSchemaResponse, error)
+	// TrustedIssuers queries the trusted issuer CAs of the identity certificates
+	TrustedIssuers(ctx context.Context, in *QueryTrustedIssuersRequest, opts ...grpc.CallOption) (*QueryTrustedIssuersResponse, error)
+	// Params queries the parameters of the identity module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -180,10 +492,43 @@ func (c *queryClient) Identity(ctx context.Context, in *QueryIdentityRequest, op
 	return out, nil
 }
 
+func (c *queryClient) DataSchema(ctx context.Context, in *QueryDataSchemaRequest, opts ...grpc.CallOption) (*QueryDataSchemaResponse, error) {
+	out := new(QueryDataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Query/DataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TrustedIssuers(ctx context.Context, in *QueryTrustedIssuersRequest, opts ...grpc.CallOption) (*QueryTrustedIssuersResponse, error) {
+	out := new(QueryTrustedIssuersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Query/TrustedIssuers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Identity queries the identity by the given id
 	Identity(context.Context, *QueryIdentityRequest) (*QueryIdentityResponse, error)
+	// DataSchema queries the data schema by the given id
+	DataSchema(context.Context, *QueryDataSchemaRequest) (*QueryDataSchemaResponse, error)
+	// TrustedIssuers queries the trusted issuer CAs of the identity certificates
+	TrustedIssuers(context.Context, *QueryTrustedIssuersRequest) (*QueryTrustedIssuersResponse, error)
+	// Params queries the parameters of the identity module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -193,6 +538,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Identity(ctx context.Context, req *QueryIdentityRequest) (*QueryIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Identity not implemented")
 }
+func (*UnimplementedQueryServer) DataSchema(ctx context.Context, req *QueryDataSchemaRequest) (*QueryDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataSchema not implemented")
+}
+func (*UnimplementedQueryServer) TrustedIssuers(ctx context.Context, req *QueryTrustedIssuersRequest) (*QueryTrustedIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrustedIssuers not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -216,6 +570,60 @@ func _Query_Identity_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Query/DataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataSchema(ctx, req.(*QueryDataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TrustedIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrustedIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TrustedIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Query/TrustedIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TrustedIssuers(ctx, req.(*QueryTrustedIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "iritamod.identity.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -224,6 +632,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Identity",
 			Handler:    _Query_Identity_Handler,
 		},
+		{
+			MethodName: "DataSchema",
+			Handler:    _Query_DataSchema_Handler,
+		},
+		{
+			MethodName: "TrustedIssuers",
+			Handler:    _Query_TrustedIssuers_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "identity/query.proto",
@@ -279,6 +699,20 @@ func (m *QueryIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CertificateStatuses) > 0 {
+		for iNdEx := len(m.CertificateStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CertificateStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.Identity != nil {
 		{
 			size, err := m.Identity.MarshalToSizedBuffer(dAtA[:i])
@@ -294,26 +728,231 @@ func (m *QueryIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDataSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIdentityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryDataSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataSchema != nil {
+		{
+			size, err := m.DataSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustedIssuersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustedIssuersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustedIssuersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrustedIssuersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrustedIssuersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrustedIssuersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TrustedIssuers) > 0 {
+		for iNdEx := len(m.TrustedIssuers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedIssuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIdentityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -328,6 +967,90 @@ func (m *QueryIdentityResponse) Size() (n int) {
 		l = m.Identity.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.CertificateStatuses) > 0 {
+		for _, e := range m.CertificateStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDataSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataSchema != nil {
+		l = m.DataSchema.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrustedIssuersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrustedIssuersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrustedIssuers) > 0 {
+		for _, e := range m.TrustedIssuers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -484,6 +1207,547 @@ func (m *QueryIdentityResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificateStatuses = append(m.CertificateStatuses, CertificateStatus{})
+			if err := m.CertificateStatuses[len(m.CertificateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataSchema == nil {
+				m.DataSchema = &DataSchema{}
+			}
+			if err := m.DataSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustedIssuersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustedIssuersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustedIssuersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrustedIssuersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrustedIssuersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrustedIssuersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedIssuers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedIssuers = append(m.TrustedIssuers, TrustedIssuer{})
+			if err := m.TrustedIssuers[len(m.TrustedIssuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,11 @@
+package types
+
+// IdentityRequirement defines the identity which the signers of a message type are required to own
+type IdentityRequirement struct {
+	// TrustedCertificate requires the identity to carry an unexpired certificate issued by a trusted issuer
+	TrustedCertificate bool
+}
+
+// IdentityRequirements maps the message type URLs, e.g. "/iritamod.side_chain.v1.MsgCreateSpace",
+// to the identity requirements on the message signers
+type IdentityRequirements map[string]IdentityRequirement
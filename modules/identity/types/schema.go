@@ -0,0 +1,303 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DataSchemaField is the field of the identity data which references the registered data schema
+	DataSchemaField = "$schema"
+
+	MaxDataSchemaIDLength = 64 // maximum size of the data schema ID
+)
+
+var (
+	// the data schema ID must start with a letter, followed by letters, digits, '.', '-', '_' or '/'
+	regexpDataSchemaID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9./_-]*$`)
+
+	// supported JSON schema types
+	jsonSchemaTypes = map[string]bool{
+		"object":  true,
+		"array":   true,
+		"string":  true,
+		"number":  true,
+		"integer": true,
+		"boolean": true,
+		"null":    true,
+	}
+)
+
+// jsonSchema defines the subset of the JSON schema keywords supported for the identity data.
+// Unsupported keywords, such as the annotations, are ignored
+type jsonSchema struct {
+	Type                 string                 `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Enum                 []interface{}          `json:"enum"`
+	MinLength            *uint64                `json:"minLength"`
+	MaxLength            *uint64                `json:"maxLength"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	MinItems             *uint64                `json:"minItems"`
+	MaxItems             *uint64                `json:"maxItems"`
+}
+
+// ValidateDataSchemaID validates the data schema ID
+func ValidateDataSchemaID(id string) error {
+	if len(id) == 0 || len(id) > MaxDataSchemaIDLength {
+		return sdkerrors.Wrapf(ErrInvalidDataSchema, "size of the schema ID must be 1 ~ %d in bytes", MaxDataSchemaIDLength)
+	}
+
+	if !regexpDataSchemaID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidDataSchema, "invalid schema ID %s", id)
+	}
+
+	return nil
+}
+
+// ValidateDataSchema validates the given JSON schema
+func ValidateDataSchema(schema string) error {
+	if _, err := parseJSONSchema(schema); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDataSchema, err.Error())
+	}
+
+	return nil
+}
+
+// GetDataSchemaID returns the ID of the data schema referenced by the "$schema" field
+// of the given identity data. False is returned if the data is not a JSON object
+// referencing a schema
+func GetDataSchemaID(data string) (string, bool) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data), &obj); err != nil {
+		return "", false
+	}
+
+	raw, ok := obj[DataSchemaField]
+	if !ok {
+		return "", false
+	}
+
+	var id string
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return "", false
+	}
+
+	return id, true
+}
+
+// ValidateDataWithSchema validates the identity data against the given JSON schema.
+// The "$schema" field of the data is not subject to the schema
+func ValidateDataWithSchema(data string, schema string) error {
+	s, err := parseJSONSchema(schema)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidDataSchema, err.Error())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidData, "data is not valid JSON: %s", err)
+	}
+
+	if obj, ok := value.(map[string]interface{}); ok {
+		delete(obj, DataSchemaField)
+	}
+
+	if err := s.validate("data", value); err != nil {
+		return sdkerrors.Wrap(ErrInvalidData, err.Error())
+	}
+
+	return nil
+}
+
+// parseJSONSchema parses and checks the given JSON schema
+func parseJSONSchema(schema string) (*jsonSchema, error) {
+	var s jsonSchema
+	if err := json.Unmarshal([]byte(schema), &s); err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %s", err)
+	}
+
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// check checks the keywords of the schema recursively
+func (s *jsonSchema) check() error {
+	if len(s.Type) > 0 && !jsonSchemaTypes[s.Type] {
+		return fmt.Errorf("unsupported type %s", s.Type)
+	}
+
+	for _, field := range s.Required {
+		if s.Properties == nil || s.Properties[field] == nil {
+			return fmt.Errorf("required property %s is not defined", field)
+		}
+	}
+
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("property %s must be a schema", name)
+		}
+
+		if err := property.check(); err != nil {
+			return err
+		}
+	}
+
+	if s.Items != nil {
+		return s.Items.check()
+	}
+
+	return nil
+}
+
+// validate validates the value against the schema
+func (s *jsonSchema) validate(path string, value interface{}) error {
+	if len(s.Type) > 0 && !matchJSONType(s.Type, value) {
+		return fmt.Errorf("%s must be of type %s", path, s.Type)
+	}
+
+	if len(s.Enum) > 0 && !matchEnum(s.Enum, value) {
+		return fmt.Errorf("%s must be one of the enumerated values", path)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for _, field := range s.Required {
+			if _, ok := v[field]; !ok {
+				return fmt.Errorf("%s.%s is required", path, field)
+			}
+		}
+
+		for field, fieldValue := range v {
+			property, ok := s.Properties[field]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%s.%s is not allowed", path, field)
+				}
+				continue
+			}
+
+			if err := property.validate(path+"."+field, fieldValue); err != nil {
+				return err
+			}
+		}
+
+	case []interface{}:
+		if s.MinItems != nil && uint64(len(v)) < *s.MinItems {
+			return fmt.Errorf("%s must have at least %d items", path, *s.MinItems)
+		}
+
+		if s.MaxItems != nil && uint64(len(v)) > *s.MaxItems {
+			return fmt.Errorf("%s must have at most %d items", path, *s.MaxItems)
+		}
+
+		if s.Items != nil {
+			for i, item := range v {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+
+		if s.MinLength != nil && length < *s.MinLength {
+			return fmt.Errorf("%s must be at least %d characters long", path, *s.MinLength)
+		}
+
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return fmt.Errorf("%s must be at most %d characters long", path, *s.MaxLength)
+		}
+
+	case json.Number:
+		n, err := v.Float64()
+		if err != nil {
+			return fmt.Errorf("%s is not a valid number", path)
+		}
+
+		if s.Minimum != nil && n < *s.Minimum {
+			return fmt.Errorf("%s must be greater than or equal to %v", path, *s.Minimum)
+		}
+
+		if s.Maximum != nil && n > *s.Maximum {
+			return fmt.Errorf("%s must be less than or equal to %v", path, *s.Maximum)
+		}
+	}
+
+	return nil
+}
+
+// matchJSONType returns true if the value is of the given JSON schema type, false otherwise
+func matchJSONType(typ string, value interface{}) bool {
+	switch typ {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+
+	case "string":
+		_, ok := value.(string)
+		return ok
+
+	case "number":
+		_, ok := value.(json.Number)
+		return ok
+
+	case "integer":
+		n, ok := value.(json.Number)
+		if !ok {
+			return false
+		}
+
+		f, err := n.Float64()
+		return err == nil && f == math.Trunc(f)
+
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+
+	case "null":
+		return value == nil
+	}
+
+	return false
+}
+
+// matchEnum returns true if the value equals to one of the enumerated values, false otherwise
+func matchEnum(enum []interface{}, value interface{}) bool {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		if err != nil {
+			return false
+		}
+		value = f
+	}
+
+	for _, e := range enum {
+		if reflect.DeepEqual(e, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/hex"
+
+	"github.com/gogo/protobuf/proto"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// ExtensionOptionIdentitySignerTypeURL is the type url of ExtensionOptionIdentitySigner
+const ExtensionOptionIdentitySignerTypeURL = "/iritamod.identity.ExtensionOptionIdentitySigner"
+
+// ExtensionOptionI defines the interface of the tx extension options
+type ExtensionOptionI interface {
+	proto.Message
+}
+
+// NewIdentitySigner constructs a new IdentitySigner instance
+func NewIdentitySigner(signer sdk.AccAddress, id tmbytes.HexBytes, algorithm PubKeyAlgorithm) IdentitySigner {
+	return IdentitySigner{
+		Signer:    signer.String(),
+		Id:        id.String(),
+		Algorithm: algorithm,
+	}
+}
+
+// Validate validates the identity signer
+func (s IdentitySigner) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if len(s.Id) > IdLengthMax*2 || len(s.Id) < IdLengthMin*2 {
+		return sdkerrors.Wrapf(ErrInvalidID, "size of the ID must be %d ~ %d in bytes", IdLengthMin, IdLengthMax)
+	}
+
+	if _, err := hex.DecodeString(s.Id); err != nil {
+		return sdkerrors.Wrap(ErrInvalidID, "id not hex encoding")
+	}
+
+	if _, ok := PubKeyAlgorithm_name[int32(s.Algorithm)]; !ok || s.Algorithm == UnknownPubKeyAlgorithm {
+		return sdkerrors.Wrap(ErrUnsupportedPubKeyAlgorithm, s.Algorithm.String())
+	}
+
+	return nil
+}
+
+// NewExtensionOptionIdentitySigner constructs a new ExtensionOptionIdentitySigner instance
+func NewExtensionOptionIdentitySigner(signers ...IdentitySigner) *ExtensionOptionIdentitySigner {
+	return &ExtensionOptionIdentitySigner{
+		Signers: signers,
+	}
+}
+
+// Validate validates the extension option
+func (e ExtensionOptionIdentitySigner) Validate() error {
+	if len(e.Signers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidIdentitySigner, "identity signers can not be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, signer := range e.Signers {
+		if err := signer.Validate(); err != nil {
+			return err
+		}
+
+		if seen[signer.Signer] {
+			return sdkerrors.Wrapf(ErrInvalidIdentitySigner, "duplicate identity signer %s", signer.Signer)
+		}
+		seen[signer.Signer] = true
+	}
+
+	return nil
+}
+
+// GetSigner returns the identity signer bound to the given address
+func (e ExtensionOptionIdentitySigner) GetSigner(addr sdk.AccAddress) (IdentitySigner, bool) {
+	for _, signer := range e.Signers {
+		if signer.Signer == addr.String() {
+			return signer, true
+		}
+	}
+
+	return IdentitySigner{}, false
+}
+
+// NewIdentitySignerInfo returns the signer info for an identity signer. It carries no
+// public key since the signature is verified with the public keys of the identity.
+// The identity signer signs the SIGN_MODE_DIRECT sign doc of the tx
+func NewIdentitySignerInfo(sequence uint64) *txtypes.SignerInfo {
+	return &txtypes.SignerInfo{
+		ModeInfo: &txtypes.ModeInfo{
+			Sum: &txtypes.ModeInfo_Single_{
+				Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+			},
+		},
+		Sequence: sequence,
+	}
+}
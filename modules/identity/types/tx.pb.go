@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -188,42 +189,377 @@ func (m *MsgUpdateIdentityResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateIdentityResponse proto.InternalMessageInfo
 
+// MsgVerifySignature defines a message to verify a signature with the public keys of an identity
+type MsgVerifySignature struct {
+	Id        string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Algorithm PubKeyAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=iritamod.identity.PubKeyAlgorithm" json:"algorithm,omitempty"`
+	Data      []byte          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte          `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Sender    string          `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgVerifySignature) Reset()         { *m = MsgVerifySignature{} }
+func (m *MsgVerifySignature) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifySignature) ProtoMessage()    {}
+func (*MsgVerifySignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{4}
+}
+func (m *MsgVerifySignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifySignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifySignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifySignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifySignature.Merge(m, src)
+}
+func (m *MsgVerifySignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifySignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifySignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifySignature proto.InternalMessageInfo
+
+// MsgVerifySignatureResponse defines the Msg/VerifySignature response type.
+type MsgVerifySignatureResponse struct {
+	PubKey *PubKeyInfo `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pubkey" yaml:"pubkey"`
+}
+
+func (m *MsgVerifySignatureResponse) Reset()         { *m = MsgVerifySignatureResponse{} }
+func (m *MsgVerifySignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVerifySignatureResponse) ProtoMessage()    {}
+func (*MsgVerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{5}
+}
+func (m *MsgVerifySignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVerifySignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVerifySignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVerifySignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVerifySignatureResponse.Merge(m, src)
+}
+func (m *MsgVerifySignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVerifySignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVerifySignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVerifySignatureResponse proto.InternalMessageInfo
+
+// MsgRegisterDataSchema defines a message to register a JSON schema for the identity data
+type MsgRegisterDataSchema struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Owner  string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRegisterDataSchema) Reset()         { *m = MsgRegisterDataSchema{} }
+func (m *MsgRegisterDataSchema) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDataSchema) ProtoMessage()    {}
+func (*MsgRegisterDataSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{6}
+}
+func (m *MsgRegisterDataSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDataSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDataSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDataSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDataSchema.Merge(m, src)
+}
+func (m *MsgRegisterDataSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDataSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDataSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDataSchema proto.InternalMessageInfo
+
+// MsgRegisterDataSchemaResponse defines the Msg/RegisterDataSchema response type.
+type MsgRegisterDataSchemaResponse struct {
+}
+
+func (m *MsgRegisterDataSchemaResponse) Reset()         { *m = MsgRegisterDataSchemaResponse{} }
+func (m *MsgRegisterDataSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDataSchemaResponse) ProtoMessage()    {}
+func (*MsgRegisterDataSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{7}
+}
+func (m *MsgRegisterDataSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDataSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDataSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDataSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDataSchemaResponse.Merge(m, src)
+}
+func (m *MsgRegisterDataSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDataSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDataSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDataSchemaResponse proto.InternalMessageInfo
+
+// MsgAddTrustedIssuer defines a message to add a trusted issuer CA of the identity certificates
+type MsgAddTrustedIssuer struct {
+	Certificate string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	Operator    string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgAddTrustedIssuer) Reset()         { *m = MsgAddTrustedIssuer{} }
+func (m *MsgAddTrustedIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTrustedIssuer) ProtoMessage()    {}
+func (*MsgAddTrustedIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{8}
+}
+func (m *MsgAddTrustedIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTrustedIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTrustedIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTrustedIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTrustedIssuer.Merge(m, src)
+}
+func (m *MsgAddTrustedIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTrustedIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTrustedIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTrustedIssuer proto.InternalMessageInfo
+
+// MsgAddTrustedIssuerResponse defines the Msg/AddTrustedIssuer response type.
+type MsgAddTrustedIssuerResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgAddTrustedIssuerResponse) Reset()         { *m = MsgAddTrustedIssuerResponse{} }
+func (m *MsgAddTrustedIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTrustedIssuerResponse) ProtoMessage()    {}
+func (*MsgAddTrustedIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{9}
+}
+func (m *MsgAddTrustedIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTrustedIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTrustedIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTrustedIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTrustedIssuerResponse.Merge(m, src)
+}
+func (m *MsgAddTrustedIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTrustedIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTrustedIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTrustedIssuerResponse proto.InternalMessageInfo
+
+// MsgRemoveTrustedIssuer defines a message to remove a trusted issuer CA of the identity certificates
+type MsgRemoveTrustedIssuer struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *MsgRemoveTrustedIssuer) Reset()         { *m = MsgRemoveTrustedIssuer{} }
+func (m *MsgRemoveTrustedIssuer) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTrustedIssuer) ProtoMessage()    {}
+func (*MsgRemoveTrustedIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{10}
+}
+func (m *MsgRemoveTrustedIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTrustedIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTrustedIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTrustedIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTrustedIssuer.Merge(m, src)
+}
+func (m *MsgRemoveTrustedIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTrustedIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTrustedIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTrustedIssuer proto.InternalMessageInfo
+
+// MsgRemoveTrustedIssuerResponse defines the Msg/RemoveTrustedIssuer response type.
+type MsgRemoveTrustedIssuerResponse struct {
+}
+
+func (m *MsgRemoveTrustedIssuerResponse) Reset()         { *m = MsgRemoveTrustedIssuerResponse{} }
+func (m *MsgRemoveTrustedIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTrustedIssuerResponse) ProtoMessage()    {}
+func (*MsgRemoveTrustedIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a49ec0beed01e79, []int{11}
+}
+func (m *MsgRemoveTrustedIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveTrustedIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveTrustedIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveTrustedIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTrustedIssuerResponse.Merge(m, src)
+}
+func (m *MsgRemoveTrustedIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveTrustedIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTrustedIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveTrustedIssuerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateIdentity)(nil), "iritamod.identity.MsgCreateIdentity")
 	proto.RegisterType((*MsgCreateIdentityResponse)(nil), "iritamod.identity.MsgCreateIdentityResponse")
 	proto.RegisterType((*MsgUpdateIdentity)(nil), "iritamod.identity.MsgUpdateIdentity")
 	proto.RegisterType((*MsgUpdateIdentityResponse)(nil), "iritamod.identity.MsgUpdateIdentityResponse")
+	proto.RegisterType((*MsgVerifySignature)(nil), "iritamod.identity.MsgVerifySignature")
+	proto.RegisterType((*MsgVerifySignatureResponse)(nil), "iritamod.identity.MsgVerifySignatureResponse")
+	proto.RegisterType((*MsgRegisterDataSchema)(nil), "iritamod.identity.MsgRegisterDataSchema")
+	proto.RegisterType((*MsgRegisterDataSchemaResponse)(nil), "iritamod.identity.MsgRegisterDataSchemaResponse")
+	proto.RegisterType((*MsgAddTrustedIssuer)(nil), "iritamod.identity.MsgAddTrustedIssuer")
+	proto.RegisterType((*MsgAddTrustedIssuerResponse)(nil), "iritamod.identity.MsgAddTrustedIssuerResponse")
+	proto.RegisterType((*MsgRemoveTrustedIssuer)(nil), "iritamod.identity.MsgRemoveTrustedIssuer")
+	proto.RegisterType((*MsgRemoveTrustedIssuerResponse)(nil), "iritamod.identity.MsgRemoveTrustedIssuerResponse")
 }
 
 func init() { proto.RegisterFile("identity/tx.proto", fileDescriptor_4a49ec0beed01e79) }
 
 var fileDescriptor_4a49ec0beed01e79 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x53, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0xcd, 0xf8, 0x57, 0x3a, 0x52, 0xc1, 0x41, 0x68, 0xaa, 0x34, 0x4a, 0xe8, 0xc2, 0x45, 0x49,
-	0xc0, 0x76, 0xe5, 0xd2, 0xae, 0x44, 0x84, 0x92, 0xd2, 0x4d, 0x37, 0x65, 0xe2, 0x7c, 0xa6, 0x53,
-	0x4d, 0x26, 0x24, 0x13, 0xda, 0xbc, 0x45, 0x1f, 0xa1, 0x8f, 0xe3, 0xd2, 0xee, 0xba, 0x92, 0x56,
-	0x37, 0x97, 0xcb, 0x5d, 0xdd, 0x27, 0xb8, 0x38, 0x31, 0xd7, 0xeb, 0x1f, 0xdc, 0xfd, 0xdd, 0x9d,
-	0x39, 0xdf, 0xc9, 0x77, 0x38, 0x87, 0x7c, 0xb8, 0xce, 0x19, 0x04, 0x92, 0xcb, 0xd4, 0x96, 0x3f,
-	0xad, 0x30, 0x12, 0x52, 0x90, 0x3a, 0x8f, 0xb8, 0xa4, 0xbe, 0x60, 0x56, 0x3e, 0x6b, 0xbe, 0xbc,
-	0x57, 0xe5, 0x20, 0xd3, 0x36, 0x1b, 0x9e, 0xf0, 0x84, 0x82, 0xf6, 0x16, 0x65, 0xac, 0x79, 0x83,
-	0x70, 0x7d, 0x1c, 0x7b, 0x1f, 0x22, 0xa0, 0x12, 0x86, 0xbb, 0x2f, 0x48, 0x0d, 0x17, 0x38, 0xd3,
-	0x51, 0x07, 0x75, 0x9f, 0x3b, 0x05, 0xce, 0xc8, 0x27, 0xfc, 0x2c, 0x4c, 0xdc, 0xaf, 0x33, 0x48,
-	0xf5, 0x42, 0x07, 0x75, 0xab, 0xbd, 0xd7, 0xd6, 0x89, 0xb3, 0xf5, 0x31, 0x71, 0x47, 0x90, 0x0e,
-	0x83, 0xa9, 0x18, 0xb4, 0xae, 0x57, 0xed, 0x4a, 0x98, 0xb8, 0x33, 0x48, 0x6f, 0x57, 0xed, 0x17,
-	0x29, 0xf5, 0xe7, 0x7d, 0x33, 0x7b, 0x9b, 0xce, 0x76, 0x30, 0x82, 0x94, 0x74, 0x70, 0x75, 0x02,
-	0x91, 0xe4, 0x53, 0x3e, 0xa1, 0x12, 0xf4, 0xa2, 0x72, 0x7b, 0x48, 0x29, 0x45, 0x04, 0x6a, 0x3f,
-	0x9d, 0xc7, 0x7a, 0x69, 0xa7, 0xd8, 0x53, 0xa4, 0x81, 0xcb, 0xe2, 0x47, 0x00, 0x91, 0x5e, 0x56,
-	0xb3, 0xec, 0x41, 0x08, 0x2e, 0x31, 0x2a, 0xa9, 0x5e, 0x51, 0xa4, 0xc2, 0xfd, 0xd2, 0xd5, 0xef,
-	0x36, 0x32, 0x5b, 0xf8, 0xd5, 0x49, 0x5a, 0x07, 0xe2, 0x50, 0x04, 0x31, 0xe4, 0x5d, 0x7c, 0x0e,
-	0xd9, 0x13, 0xea, 0xe2, 0x30, 0x6d, 0xde, 0x45, 0xef, 0x0f, 0xc2, 0xc5, 0x71, 0xec, 0x11, 0x86,
-	0x6b, 0x47, 0xff, 0xc6, 0x9b, 0x33, 0x71, 0x4f, 0x3a, 0x6d, 0xbe, 0x7d, 0x8c, 0x2a, 0x77, 0xdb,
-	0xba, 0x1c, 0xb5, 0x7e, 0xc1, 0xe5, 0x50, 0x75, 0xc9, 0xe5, 0x7c, 0xa6, 0x81, 0xb3, 0xf8, 0x6f,
-	0x68, 0x8b, 0xb5, 0x81, 0x96, 0x6b, 0x03, 0xfd, 0x5b, 0x1b, 0xe8, 0xd7, 0xc6, 0xd0, 0x96, 0x1b,
-	0x43, 0xfb, 0xbb, 0x31, 0xb4, 0x2f, 0xef, 0x3d, 0x2e, 0xbf, 0x25, 0xae, 0x35, 0x11, 0xbe, 0xed,
-	0x72, 0x1a, 0x7c, 0xe7, 0x40, 0xb9, 0x9d, 0xef, 0xb7, 0x7d, 0xc1, 0x92, 0x39, 0xc4, 0xf6, 0xfe,
-	0x08, 0xd3, 0x10, 0x62, 0xb7, 0xa2, 0xce, 0xe8, 0xdd, 0x5d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37,
-	0x28, 0xae, 0x2a, 0x9d, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0xd3, 0x36, 0xd0, 0xd7, 0x12, 0xa8, 0x5b, 0x8a, 0x71, 0xa9, 0x13, 0x59, 0x80, 0x8a,
+	0xd4, 0x26, 0x6d, 0x40, 0x2c, 0xba, 0xa2, 0x85, 0x4d, 0xa9, 0x22, 0x21, 0x17, 0x90, 0x80, 0x05,
+	0x9a, 0x64, 0xa6, 0xce, 0xd0, 0x38, 0x63, 0x66, 0xc6, 0x80, 0x0f, 0x81, 0xc4, 0x11, 0x38, 0x05,
+	0x67, 0xe8, 0xb2, 0x4b, 0x56, 0x15, 0xb4, 0x1b, 0x84, 0x58, 0x71, 0x02, 0x14, 0xc7, 0x9e, 0xb4,
+	0xb6, 0x23, 0xb2, 0x60, 0xc7, 0x6e, 0x7e, 0x3e, 0xbf, 0xef, 0xe7, 0x3d, 0xdb, 0x30, 0x47, 0x31,
+	0xe9, 0x49, 0x2a, 0xc3, 0xba, 0xfc, 0x50, 0xf3, 0x39, 0x93, 0x4c, 0x9f, 0xa3, 0x9c, 0x4a, 0xe4,
+	0x31, 0x5c, 0x4b, 0xee, 0xcc, 0x6b, 0x0a, 0x95, 0x2c, 0x06, 0x58, 0x73, 0xc1, 0x65, 0x2e, 0x8b,
+	0x96, 0xf5, 0xfe, 0x6a, 0x70, 0x6a, 0xff, 0xd2, 0x60, 0xae, 0x29, 0xdc, 0x87, 0x9c, 0x20, 0x49,
+	0x76, 0xe2, 0x27, 0xf4, 0x32, 0x14, 0x29, 0x36, 0xb4, 0xaa, 0xb6, 0x32, 0xed, 0x14, 0x29, 0xd6,
+	0xf7, 0xe0, 0x82, 0x1f, 0xb4, 0x5e, 0x1f, 0x90, 0xd0, 0x28, 0x56, 0xb5, 0x95, 0x99, 0xc6, 0x72,
+	0x2d, 0xc3, 0x5c, 0x7b, 0x12, 0xb4, 0x76, 0x49, 0xb8, 0xd3, 0xdb, 0x67, 0xdb, 0x4b, 0x3f, 0x8f,
+	0x2b, 0x25, 0x3f, 0x68, 0x1d, 0x90, 0xf0, 0xf7, 0x71, 0xe5, 0x52, 0x88, 0xbc, 0xee, 0xa6, 0x3d,
+	0xd8, 0xdb, 0x4e, 0xff, 0x62, 0x97, 0x84, 0x7a, 0x15, 0x66, 0xda, 0x84, 0x4b, 0xba, 0x4f, 0xdb,
+	0x48, 0x12, 0x63, 0x22, 0x62, 0x3b, 0x7b, 0x14, 0x21, 0x38, 0x89, 0xea, 0xa3, 0xae, 0x30, 0x26,
+	0x63, 0xc4, 0xf0, 0x48, 0x5f, 0x80, 0x29, 0xf6, 0xbe, 0x47, 0xb8, 0x31, 0x15, 0xdd, 0x0d, 0x36,
+	0xba, 0x0e, 0x93, 0x18, 0x49, 0x64, 0x94, 0xa2, 0xc3, 0x68, 0xbd, 0x39, 0xf9, 0xe3, 0x73, 0x45,
+	0xb3, 0x97, 0xe0, 0x7a, 0xc6, 0xad, 0x43, 0x84, 0xcf, 0x7a, 0x82, 0x24, 0x59, 0x3c, 0xf3, 0xf1,
+	0x7f, 0x94, 0xc5, 0x79, 0xb7, 0x2a, 0x8b, 0x2f, 0x1a, 0xe8, 0x4d, 0xe1, 0x3e, 0x27, 0x9c, 0xee,
+	0x87, 0x7b, 0xd4, 0xed, 0x21, 0x19, 0x70, 0x92, 0x09, 0xe3, 0x01, 0x4c, 0xa3, 0xae, 0xcb, 0x38,
+	0x95, 0x1d, 0x2f, 0x8a, 0xa3, 0xdc, 0xb0, 0x47, 0xc6, 0xb1, 0x95, 0x20, 0x9d, 0xe1, 0x43, 0x4a,
+	0x5f, 0xdf, 0xf2, 0xec, 0x40, 0x9f, 0x7e, 0x03, 0xa6, 0x45, 0x42, 0x19, 0x39, 0x9d, 0x75, 0x86,
+	0x07, 0xfa, 0x22, 0x94, 0x04, 0xe9, 0x61, 0x65, 0x34, 0xde, 0xc5, 0xae, 0xde, 0x82, 0x99, 0xd5,
+	0x9d, 0xd8, 0x3a, 0xdb, 0x3c, 0xed, 0x5f, 0x35, 0xcf, 0x7e, 0x05, 0x57, 0x9b, 0xc2, 0x75, 0x88,
+	0x4b, 0x85, 0x24, 0xfc, 0x11, 0x92, 0x68, 0xaf, 0xdd, 0x21, 0x1e, 0xca, 0xa4, 0xd5, 0x57, 0x1e,
+	0xdd, 0x18, 0xc5, 0x58, 0xf9, 0x00, 0xa7, 0x3a, 0x37, 0x71, 0xa6, 0x73, 0xb1, 0x9f, 0x0a, 0x2c,
+	0xe7, 0x16, 0x57, 0x9d, 0x7a, 0x01, 0xf3, 0x4d, 0xe1, 0x6e, 0x61, 0xfc, 0x94, 0x07, 0x42, 0x12,
+	0xbc, 0x23, 0x44, 0x40, 0x78, 0x7a, 0xa2, 0xb4, 0xec, 0x44, 0x99, 0x70, 0x91, 0xf9, 0x84, 0x23,
+	0xc9, 0x78, 0xac, 0x47, 0xed, 0x63, 0xee, 0x35, 0x58, 0xca, 0x29, 0xad, 0xc2, 0x4c, 0xd9, 0xb3,
+	0x1f, 0xc3, 0x62, 0x24, 0xd5, 0x63, 0xef, 0xc8, 0x79, 0x31, 0xe9, 0x20, 0xfe, 0x4e, 0x5d, 0x05,
+	0x2b, 0xbf, 0x56, 0xc2, 0xde, 0xf8, 0x38, 0x05, 0x13, 0x4d, 0xe1, 0xea, 0x18, 0xca, 0xa9, 0xaf,
+	0xd7, 0xcd, 0x9c, 0x9e, 0x66, 0xde, 0x7a, 0x73, 0x75, 0x1c, 0x94, 0xf2, 0x8a, 0xa1, 0x9c, 0xfa,
+	0x2e, 0x8c, 0x60, 0x39, 0x8f, 0x32, 0x57, 0xc7, 0x41, 0x29, 0x16, 0x17, 0x2e, 0xa7, 0xdf, 0xb8,
+	0x5b, 0xf9, 0x05, 0x52, 0x30, 0x73, 0x6d, 0x2c, 0x98, 0x22, 0xf2, 0x41, 0xcf, 0x99, 0xd7, 0x95,
+	0xfc, 0x22, 0x59, 0xa4, 0xb9, 0x3e, 0x2e, 0x52, 0x31, 0xbe, 0x81, 0x2b, 0x99, 0x19, 0xbd, 0x9d,
+	0x5f, 0x25, 0x8d, 0x33, 0x6b, 0xe3, 0xe1, 0x14, 0x97, 0x80, 0xf9, 0xbc, 0x29, 0xbc, 0x33, 0x4a,
+	0x74, 0x06, 0x6a, 0x6e, 0x8c, 0x0d, 0x4d, 0x48, 0xb7, 0x9d, 0xc3, 0xef, 0x56, 0xe1, 0xf0, 0xc4,
+	0xd2, 0x8e, 0x4e, 0x2c, 0xed, 0xdb, 0x89, 0xa5, 0x7d, 0x3a, 0xb5, 0x0a, 0x47, 0xa7, 0x56, 0xe1,
+	0xeb, 0xa9, 0x55, 0x78, 0x79, 0xcf, 0xa5, 0xb2, 0x13, 0xb4, 0x6a, 0x6d, 0xe6, 0xd5, 0x11, 0xc2,
+	0x1d, 0xba, 0x7e, 0x7f, 0xa3, 0x51, 0x4f, 0x48, 0xea, 0x1e, 0xc3, 0x41, 0x97, 0x88, 0xfa, 0xf0,
+	0x17, 0x1f, 0xfa, 0x44, 0xb4, 0x4a, 0xd1, 0x4f, 0xfa, 0xee, 0x9f, 0x01, 0x00, 0x25, 0xf5, 0x91,
+	0xe7, 0xfb, 0x07, 0x00, 0x00,
 }
 
 func (this *MsgCreateIdentity) Equal(that interface{}) bool {
@@ -304,57 +640,229 @@ func (this *MsgUpdateIdentity) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVerifySignature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateIdentity defines a method for creating a new identity.
-	CreateIdentity(ctx context.Context, in *MsgCreateIdentity, opts ...grpc.CallOption) (*MsgCreateIdentityResponse, error)
-	// UpdateIdentity defines a method for Updating a identity.
-	UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) CreateIdentity(ctx context.Context, in *MsgCreateIdentity, opts ...grpc.CallOption) (*MsgCreateIdentityResponse, error) {
-	out := new(MsgCreateIdentityResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/CreateIdentity", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgVerifySignature)
+	if !ok {
+		that2, ok := that.(MsgVerifySignature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
 }
+func (this *MsgRegisterDataSchema) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (c *msgClient) UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error) {
-	out := new(MsgUpdateIdentityResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/UpdateIdentity", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgRegisterDataSchema)
+	if !ok {
+		that2, ok := that.(MsgRegisterDataSchema)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Schema != that1.Schema {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	return true
 }
-
+func (this *MsgAddTrustedIssuer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgAddTrustedIssuer)
+	if !ok {
+		that2, ok := that.(MsgAddTrustedIssuer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Certificate != that1.Certificate {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+func (this *MsgRemoveTrustedIssuer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRemoveTrustedIssuer)
+	if !ok {
+		that2, ok := that.(MsgRemoveTrustedIssuer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateIdentity defines a method for creating a new identity.
+	CreateIdentity(ctx context.Context, in *MsgCreateIdentity, opts ...grpc.CallOption) (*MsgCreateIdentityResponse, error)
+	// UpdateIdentity defines a method for Updating a identity.
+	UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error)
+	// VerifySignature defines a method for verifying a signature with the public keys of an identity.
+	VerifySignature(ctx context.Context, in *MsgVerifySignature, opts ...grpc.CallOption) (*MsgVerifySignatureResponse, error)
+	// RegisterDataSchema defines a method for registering a JSON schema for the identity data.
+	RegisterDataSchema(ctx context.Context, in *MsgRegisterDataSchema, opts ...grpc.CallOption) (*MsgRegisterDataSchemaResponse, error)
+	// AddTrustedIssuer defines a method for adding a trusted issuer CA of the identity certificates.
+	AddTrustedIssuer(ctx context.Context, in *MsgAddTrustedIssuer, opts ...grpc.CallOption) (*MsgAddTrustedIssuerResponse, error)
+	// RemoveTrustedIssuer defines a method for removing a trusted issuer CA of the identity certificates.
+	RemoveTrustedIssuer(ctx context.Context, in *MsgRemoveTrustedIssuer, opts ...grpc.CallOption) (*MsgRemoveTrustedIssuerResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateIdentity(ctx context.Context, in *MsgCreateIdentity, opts ...grpc.CallOption) (*MsgCreateIdentityResponse, error) {
+	out := new(MsgCreateIdentityResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/CreateIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateIdentity(ctx context.Context, in *MsgUpdateIdentity, opts ...grpc.CallOption) (*MsgUpdateIdentityResponse, error) {
+	out := new(MsgUpdateIdentityResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/UpdateIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VerifySignature(ctx context.Context, in *MsgVerifySignature, opts ...grpc.CallOption) (*MsgVerifySignatureResponse, error) {
+	out := new(MsgVerifySignatureResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/VerifySignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDataSchema(ctx context.Context, in *MsgRegisterDataSchema, opts ...grpc.CallOption) (*MsgRegisterDataSchemaResponse, error) {
+	out := new(MsgRegisterDataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/RegisterDataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddTrustedIssuer(ctx context.Context, in *MsgAddTrustedIssuer, opts ...grpc.CallOption) (*MsgAddTrustedIssuerResponse, error) {
+	out := new(MsgAddTrustedIssuerResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/AddTrustedIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTrustedIssuer(ctx context.Context, in *MsgRemoveTrustedIssuer, opts ...grpc.CallOption) (*MsgRemoveTrustedIssuerResponse, error) {
+	out := new(MsgRemoveTrustedIssuerResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.identity.Msg/RemoveTrustedIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateIdentity defines a method for creating a new identity.
 	CreateIdentity(context.Context, *MsgCreateIdentity) (*MsgCreateIdentityResponse, error)
 	// UpdateIdentity defines a method for Updating a identity.
 	UpdateIdentity(context.Context, *MsgUpdateIdentity) (*MsgUpdateIdentityResponse, error)
+	// VerifySignature defines a method for verifying a signature with the public keys of an identity.
+	VerifySignature(context.Context, *MsgVerifySignature) (*MsgVerifySignatureResponse, error)
+	// RegisterDataSchema defines a method for registering a JSON schema for the identity data.
+	RegisterDataSchema(context.Context, *MsgRegisterDataSchema) (*MsgRegisterDataSchemaResponse, error)
+	// AddTrustedIssuer defines a method for adding a trusted issuer CA of the identity certificates.
+	AddTrustedIssuer(context.Context, *MsgAddTrustedIssuer) (*MsgAddTrustedIssuerResponse, error)
+	// RemoveTrustedIssuer defines a method for removing a trusted issuer CA of the identity certificates.
+	RemoveTrustedIssuer(context.Context, *MsgRemoveTrustedIssuer) (*MsgRemoveTrustedIssuerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -367,6 +875,18 @@ func (*UnimplementedMsgServer) CreateIdentity(ctx context.Context, req *MsgCreat
 func (*UnimplementedMsgServer) UpdateIdentity(ctx context.Context, req *MsgUpdateIdentity) (*MsgUpdateIdentityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdentity not implemented")
 }
+func (*UnimplementedMsgServer) VerifySignature(ctx context.Context, req *MsgVerifySignature) (*MsgVerifySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDataSchema(ctx context.Context, req *MsgRegisterDataSchema) (*MsgRegisterDataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDataSchema not implemented")
+}
+func (*UnimplementedMsgServer) AddTrustedIssuer(ctx context.Context, req *MsgAddTrustedIssuer) (*MsgAddTrustedIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedIssuer not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTrustedIssuer(ctx context.Context, req *MsgRemoveTrustedIssuer) (*MsgRemoveTrustedIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedIssuer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -408,6 +928,78 @@ func _Msg_UpdateIdentity_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVerifySignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Msg/VerifySignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VerifySignature(ctx, req.(*MsgVerifySignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDataSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Msg/RegisterDataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDataSchema(ctx, req.(*MsgRegisterDataSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddTrustedIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddTrustedIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTrustedIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Msg/AddTrustedIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTrustedIssuer(ctx, req.(*MsgAddTrustedIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTrustedIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTrustedIssuer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTrustedIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.identity.Msg/RemoveTrustedIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTrustedIssuer(ctx, req.(*MsgRemoveTrustedIssuer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "iritamod.identity.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -420,6 +1012,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateIdentity",
 			Handler:    _Msg_UpdateIdentity_Handler,
 		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _Msg_VerifySignature_Handler,
+		},
+		{
+			MethodName: "RegisterDataSchema",
+			Handler:    _Msg_RegisterDataSchema_Handler,
+		},
+		{
+			MethodName: "AddTrustedIssuer",
+			Handler:    _Msg_AddTrustedIssuer_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedIssuer",
+			Handler:    _Msg_RemoveTrustedIssuer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "identity/tx.proto",
@@ -611,60 +1219,345 @@ func (m *MsgUpdateIdentityResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgVerifySignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateIdentity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgVerifySignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifySignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Certificate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Credentials)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Algorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateIdentityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVerifySignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateIdentity) Size() (n int) {
+func (m *MsgVerifySignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVerifySignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDataSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDataSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDataSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDataSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDataSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDataSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTrustedIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTrustedIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTrustedIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTrustedIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTrustedIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTrustedIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTrustedIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTrustedIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTrustedIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTrustedIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTrustedIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTrustedIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Credentials)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateIdentity) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -694,25 +1587,744 @@ func (m *MsgUpdateIdentity) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUpdateIdentityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVerifySignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Algorithm != 0 {
+		n += 1 + sovTx(uint64(m.Algorithm))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVerifySignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDataSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDataSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddTrustedIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddTrustedIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTrustedIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTrustedIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &PubKeyInfo{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &PubKeyInfo{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateIdentityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateIdentityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIdentityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
+func (m *MsgVerifySignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,10 +2347,10 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVerifySignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVerifySignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -771,9 +2383,178 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= PubKeyAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVerifySignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVerifySignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVerifySignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
@@ -809,41 +2590,59 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDataSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDataSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDataSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -871,11 +2670,11 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credentials = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -903,11 +2702,11 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -935,7 +2734,7 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -958,7 +2757,7 @@ func (m *MsgCreateIdentity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIdentityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterDataSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -981,10 +2780,10 @@ func (m *MsgCreateIdentityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIdentityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterDataSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterDataSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1008,7 +2807,7 @@ func (m *MsgCreateIdentityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
+func (m *MsgAddTrustedIssuer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,15 +2830,15 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIdentity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddTrustedIssuer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddTrustedIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1067,13 +2866,13 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Certificate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1083,31 +2882,77 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &PubKeyInfo{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTrustedIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTrustedIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTrustedIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1135,43 +2980,61 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificate = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTrustedIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credentials = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTrustedIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTrustedIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1199,11 +3062,11 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1231,7 +3094,7 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1254,7 +3117,7 @@ func (m *MsgUpdateIdentity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIdentityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveTrustedIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1277,10 +3140,10 @@ func (m *MsgUpdateIdentityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIdentityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveTrustedIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIdentityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveTrustedIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2,15 +2,22 @@ package types
 
 import (
 	bytes "bytes"
+	"crypto"
+	"crypto/dsa"
+	"crypto/ecdsa"
 	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/pem"
 	"errors"
 	"math/big"
+	"strings"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tjfoc/gmsm/sm2"
 	sm2x509 "github.com/tjfoc/gmsm/x509"
@@ -75,6 +82,106 @@ func GetPubKeyFromCertificate(cert []byte) *PubKeyInfo {
 	return &pki
 }
 
+// GetCertificateHash returns the hash of the given certificate, by which the
+// certificate is indexed in the store
+func GetCertificateHash(cert string) tmbytes.HexBytes {
+	return tmhash.Sum([]byte(strings.TrimSpace(cert)))
+}
+
+// CheckIssuerCertificate checks if the given certificate is a PEM-encoded X.509 CA certificate
+func CheckIssuerCertificate(cert []byte) error {
+	if err := CheckCertificate(cert); err != nil {
+		return err
+	}
+
+	x509Cert, err := parseX509Certificate(cert)
+	if err != nil {
+		return err
+	}
+
+	if !x509Cert.BasicConstraintsValid || !x509Cert.IsCA {
+		return sdkerrors.Wrap(ErrInvalidCertificate, "the issuer certificate must be a CA certificate")
+	}
+
+	return nil
+}
+
+// CheckCertificateExpiry checks if the given certificate has expired at the specified time
+func CheckCertificateExpiry(cert []byte, t time.Time) error {
+	x509Cert, err := parseX509Certificate(cert)
+	if err != nil {
+		return err
+	}
+
+	if t.After(x509Cert.NotAfter) {
+		return sdkerrors.Wrapf(ErrCertificateExpired, "the certificate expired at %s", x509Cert.NotAfter.UTC())
+	}
+
+	return nil
+}
+
+// VerifyCertificateIssuer verifies that the given certificate is signed by the issuer certificate
+func VerifyCertificateIssuer(cert []byte, issuerCert []byte) error {
+	x509Cert, err := parseX509Certificate(cert)
+	if err != nil {
+		return err
+	}
+
+	x509IssuerCert, err := parseX509Certificate(issuerCert)
+	if err != nil {
+		return err
+	}
+
+	if err := x509Cert.CheckSignatureFrom(x509IssuerCert); err != nil {
+		return sdkerrors.Wrap(ErrUntrustedCertificate, err.Error())
+	}
+
+	return nil
+}
+
+// GetCertificateStatus returns the validity status of the given certificate at the specified time
+// against the trusted issuers
+func GetCertificateStatus(cert string, trustedIssuers []TrustedIssuer, t time.Time) CertificateStatus {
+	status := CertificateStatus{
+		CertificateHash: GetCertificateHash(cert).String(),
+	}
+
+	x509Cert, err := parseX509Certificate([]byte(cert))
+	if err != nil {
+		return status
+	}
+
+	status.Issuer = x509Cert.Issuer.String()
+	status.NotAfter = x509Cert.NotAfter.UTC()
+	status.Expired = t.After(x509Cert.NotAfter)
+
+	for _, issuer := range trustedIssuers {
+		if err := VerifyCertificateIssuer([]byte(cert), []byte(issuer.Certificate)); err == nil {
+			status.Trusted = true
+			status.TrustedIssuerId = issuer.Id
+			break
+		}
+	}
+
+	return status
+}
+
+// parseX509Certificate parses the PEM-encoded X.509 certificate.
+// The certificates with all the supported public key algorithms including SM2 can be parsed
+func parseX509Certificate(cert []byte) (*sm2x509.Certificate, error) {
+	certDERBlock, _ := pem.Decode([]byte(strings.TrimSpace(string(cert))))
+	if certDERBlock == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidCertificate, "DER block missing")
+	}
+
+	x509Cert, err := sm2x509.ParseCertificate(certDERBlock.Bytes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidCertificate, err.Error())
+	}
+
+	return x509Cert, nil
+}
+
 // parseASN1Certificate parses the ASN.1 structured certificate
 func parseASN1Certificate(asn1Data []byte) (*certificate, error) {
 	var asn1Cert certificate
@@ -188,7 +295,7 @@ func getPubKey(asn1Cert *certificate, pkAlgo PubKeyAlgorithm) []byte {
 		x := new(big.Int).SetBytes(pubKeyASN1[1 : 1+byteLen])
 		y := new(big.Int).SetBytes(pubKeyASN1[1+byteLen:])
 
-		return compressECPubKey(x, y)
+		return compressECPubKey(byteLen, x, y)
 
 	default:
 		return nil
@@ -213,8 +320,9 @@ func namedCurveFromOID(oid asn1.ObjectIdentifier) elliptic.Curve {
 	return nil
 }
 
-// compressECPubKey compresses the specified elliptic curve public key
-func compressECPubKey(x, y *big.Int) []byte {
+// compressECPubKey compresses the specified elliptic curve public key,
+// padding the x coordinate to the byte length of the curve
+func compressECPubKey(byteLen int, x, y *big.Int) []byte {
 	b := make([]byte, 0)
 	prefix := byte(0x2)
 
@@ -223,7 +331,7 @@ func compressECPubKey(x, y *big.Int) []byte {
 	}
 
 	b = append(b, prefix)
-	return paddedAppend(32, b, x.Bytes())
+	return paddedAppend(uint(byteLen), b, x.Bytes())
 }
 
 // isOdd returns true if the given number is odd, and false otherwise
@@ -311,6 +419,77 @@ func ValidateDSAPubKey(pubKey []byte) error {
 	return nil
 }
 
+// verifyRSASignature verifies the PKCS #1 v1.5 signature of the SHA-256 digest of msg
+// with the given DER-encoded RSA public key
+func verifyRSASignature(pubKey, msg, sig []byte) bool {
+	pk, err := x509.ParsePKIXPublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	rsaPubKey, ok := pk.(*rsa.PublicKey)
+	if !ok {
+		return false
+	}
+
+	digest := sha256.Sum256(msg)
+	return rsa.VerifyPKCS1v15(rsaPubKey, crypto.SHA256, digest[:], sig) == nil
+}
+
+// verifyDSASignature verifies the ASN.1 encoded signature of the SHA-256 digest of msg
+// with the given DER-encoded DSA public key
+func verifyDSASignature(pubKey, msg, sig []byte) bool {
+	pk, err := x509.ParsePKIXPublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+
+	dsaPubKey, ok := pk.(*dsa.PublicKey)
+	if !ok {
+		return false
+	}
+
+	var dsaSig dsaSignature
+	rest, err := asn1.Unmarshal(sig, &dsaSig)
+	if err != nil || len(rest) != 0 {
+		return false
+	}
+
+	digest := sha256.Sum256(msg)
+	return dsa.Verify(dsaPubKey, digest[:], dsaSig.R, dsaSig.S)
+}
+
+// ecdsaCurveFromPubKey gets the named curve of the given compressed ECDSA public key,
+// which is determined by the key size as the x coordinate is padded to the curve size
+func ecdsaCurveFromPubKey(pubKey []byte) elliptic.Curve {
+	for _, curve := range []elliptic.Curve{elliptic.P224(), elliptic.P256(), elliptic.P384(), elliptic.P521()} {
+		if len(pubKey) == 1+(curve.Params().BitSize+7)>>3 {
+			return curve
+		}
+	}
+
+	return nil
+}
+
+// verifyECDSASignature verifies the ASN.1 encoded signature of the SHA-256 digest of msg
+// with the given compressed P-224, P-256, P-384 or P-521 public key
+func verifyECDSASignature(pubKey, msg, sig []byte) bool {
+	curve := ecdsaCurveFromPubKey(pubKey)
+	if curve == nil {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey)
+	if x == nil {
+		return false
+	}
+
+	ecdsaPubKey := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+
+	digest := sha256.Sum256(msg)
+	return ecdsa.VerifyASN1(ecdsaPubKey, digest[:], sig)
+}
+
 // These structures reflect the ASN.1 structure of X.509 certificates.:
 
 type certificate struct {
@@ -360,3 +539,8 @@ type publicKeyInfoNoRaw struct {
 type dsaAlgorithmParameters struct {
 	P, Q, G *big.Int
 }
+
+// dsaSignature is the ASN.1 structure of the DSA signature
+type dsaSignature struct {
+	R, S *big.Int
+}
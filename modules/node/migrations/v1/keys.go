@@ -0,0 +1,15 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ValidatorsByConsAddrKey = []byte{0x04} // prefix for each key to a validator index, by consensus addr
+)
+
+// GetValidatorConsAddrKey gets the key for the validator with cons address
+// NOTE: the address is not length-prefixed in v1
+func GetValidatorConsAddrKey(addr sdk.ConsAddress) []byte {
+	return append(ValidatorsByConsAddrKey, addr...)
+}
@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	v1 "github.com/aadhi0612/iritamod/modules/node/migrations/v1"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+//   - Length-prefix the consensus addresses of the validator index:
+//     0x04 | consAddr => 0x04 | len(consAddr) | consAddr
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	oldStore := prefix.NewStore(store, v1.ValidatorsByConsAddrKey)
+
+	// the old and new keys share the prefix, so the entries are collected before rewritten
+	var addrs, ids [][]byte
+
+	iterator := oldStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, append([]byte{}, iterator.Key()...))
+		ids = append(ids, append([]byte{}, iterator.Value()...))
+	}
+	iterator.Close()
+
+	for i, addr := range addrs {
+		oldStore.Delete(addr)
+		oldStore.Set(address.MustLengthPrefix(addr), ids[i])
+	}
+
+	return nil
+}
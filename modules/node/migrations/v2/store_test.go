@@ -0,0 +1,60 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/aadhi0612/iritamod/modules/node/migrations/v1"
+	v2 "github.com/aadhi0612/iritamod/modules/node/migrations/v2"
+	"github.com/aadhi0612/iritamod/modules/node/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	nodeKey := sdk.NewKVStoreKey("node")
+	ctx := testutil.DefaultContext(nodeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(nodeKey)
+
+	consAddr1 := sdk.ConsAddress(tmhash.SumTruncated([]byte("validator1")))
+	consAddr2 := sdk.ConsAddress(tmhash.SumTruncated([]byte("validator2")))
+
+	id1, err := (&gogotypes.BytesValue{Value: tmhash.Sum([]byte("id1"))}).Marshal()
+	require.NoError(t, err)
+	id2, err := (&gogotypes.BytesValue{Value: tmhash.Sum([]byte("id2"))}).Marshal()
+	require.NoError(t, err)
+
+	validatorKey := types.GetValidatorIDKey(tmhash.Sum([]byte("id1")))
+
+	// the v1 store
+	store.Set(v1.GetValidatorConsAddrKey(consAddr1), id1)
+	store.Set(v1.GetValidatorConsAddrKey(consAddr2), id2)
+	store.Set(validatorKey, []byte("validator"))
+
+	require.NoError(t, v2.MigrateStore(ctx, nodeKey))
+
+	testCases := []struct {
+		name     string
+		consAddr sdk.ConsAddress
+		id       []byte
+	}{
+		{"validator1", consAddr1, id1},
+		{"validator2", consAddr2, id2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, store.Has(v1.GetValidatorConsAddrKey(tc.consAddr)))
+			require.Equal(t, tc.id, store.Get(types.GetValidatorConsAddrKey(tc.consAddr)))
+		})
+	}
+
+	// the other entries are left untouched
+	require.Equal(t, []byte("validator"), store.Get(validatorKey))
+}
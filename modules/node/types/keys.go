@@ -6,6 +6,7 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -44,8 +45,9 @@ func GetValidatorNameKey(name string) []byte {
 }
 
 // GetValidatorConsAddrKey gets the key for the validator with cons address
+// VALUE: validator id
 func GetValidatorConsAddrKey(addr sdk.ConsAddress) []byte {
-	return append(ValidatorsByConsAddrKey, addr...)
+	return append(ValidatorsByConsAddrKey, address.MustLengthPrefix(addr)...)
 }
 
 // GetValidatorUpdateQueueKey gets the key for the validator update queue
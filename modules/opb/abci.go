@@ -0,0 +1,86 @@
+package opb
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// EndBlocker executes the due reclaim schedules, refunds the expired escrows
+// and prunes the transfer usages of the elapsed days
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	executeReclaimSchedules(ctx, k)
+	refundExpiredEscrows(ctx, k)
+	k.PruneTransferUsages(ctx, types.TransferDay(ctx.BlockTime()))
+}
+
+// executeReclaimSchedules executes the due reclaim schedules
+func executeReclaimSchedules(ctx sdk.Context, k Keeper) {
+	var schedules []types.ReclaimSchedule
+	k.IterateDueReclaimSchedules(ctx, ctx.BlockHeight(), func(schedule types.ReclaimSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	for _, schedule := range schedules {
+		schedule, err := k.ExecuteReclaimSchedule(ctx, schedule)
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(types.AttributeKeyDenom, schedule.Denom),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+		}
+
+		if err != nil {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+			k.Logger(ctx).Debug("scheduled reclaim skipped", "schedule", schedule.Id, "err", err)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduledReclaim, attributes...))
+	}
+}
+
+// refundExpiredEscrows refunds the locked escrows expiring at or before the current height
+func refundExpiredEscrows(ctx sdk.Context, k Keeper) {
+	var escrows []types.Escrow
+	k.IterateExpiredEscrows(ctx, ctx.BlockHeight(), func(escrow types.Escrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+
+	for _, escrow := range escrows {
+		// refund in a cached context to discard the partial transfer upon failure
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		escrow, err := k.RefundEscrow(cacheCtx, escrow)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund the expired escrow", "escrow", escrow.Id, "err", err)
+
+			// dequeue the escrow rather than retrying the refund in every block
+			escrow = k.FailEscrowRefund(ctx, escrow)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRefundEscrowFailed,
+					sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.Id)),
+					sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundEscrow,
+				sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.Id)),
+				sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer),
+				sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+			),
+		)
+	}
+}
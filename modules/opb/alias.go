@@ -6,14 +6,39 @@ import (
 )
 
 const (
-	ModuleName             = types.ModuleName
-	StoreKey               = types.StoreKey
-	QuerierRoute           = types.QuerierRoute
-	RouterKey              = types.RouterKey
-	EventTypeMint          = types.EventTypeMint
-	EventTypeReclaim       = types.EventTypeReclaim
-	AttributeKeyRecipient  = types.AttributeKeyRecipient
-	AttributeValueCategory = types.AttributeValueCategory
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	QuerierRoute                     = types.QuerierRoute
+	RouterKey                        = types.RouterKey
+	EventTypeMint                    = types.EventTypeMint
+	EventTypeReclaim                 = types.EventTypeReclaim
+	EventTypeSetMintAllowance        = types.EventTypeSetMintAllowance
+	EventTypeRedeem                  = types.EventTypeRedeem
+	EventTypeSettleRedemption        = types.EventTypeSettleRedemption
+	EventTypeRejectRedemption        = types.EventTypeRejectRedemption
+	EventTypeScheduledReclaim        = types.EventTypeScheduledReclaim
+	EventTypeSetTransferMode         = types.EventTypeSetTransferMode
+	EventTypeUpdateTransferAllowlist = types.EventTypeUpdateTransferAllowlist
+	EventTypeSetTransferLimit        = types.EventTypeSetTransferLimit
+	EventTypeTransferLimitExceeded   = types.EventTypeTransferLimitExceeded
+	EventTypeCreateEscrow            = types.EventTypeCreateEscrow
+	EventTypeReleaseEscrow           = types.EventTypeReleaseEscrow
+	EventTypeRefundEscrow            = types.EventTypeRefundEscrow
+	EventTypeUpdateParams            = types.EventTypeUpdateParams
+	RedemptionEscrowName             = types.RedemptionEscrowName
+	EscrowAccountName                = types.EscrowAccountName
+	TransferModeUnspecified          = types.TransferModeUnspecified
+	TransferModeUnrestricted         = types.TransferModeUnrestricted
+	TransferModeOwnerOnly            = types.TransferModeOwnerOnly
+	TransferModeAllowlist            = types.TransferModeAllowlist
+	LimitActionReject                = types.LimitActionReject
+	LimitActionTag                   = types.LimitActionTag
+	EscrowStatusLocked               = types.EscrowStatusLocked
+	EscrowStatusReleased             = types.EscrowStatusReleased
+	EscrowStatusRefunded             = types.EscrowStatusRefunded
+	EscrowStatusRefundFailed         = types.EscrowStatusRefundFailed
+	AttributeKeyRecipient            = types.AttributeKeyRecipient
+	AttributeValueCategory           = types.AttributeValueCategory
 )
 
 var (
@@ -24,8 +49,37 @@ var (
 )
 
 type (
-	MsgMint      = types.MsgMint
-	MsgReclaim   = types.MsgReclaim
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
+	MsgMint                    = types.MsgMint
+	MsgReclaim                 = types.MsgReclaim
+	MsgSetMintAllowance        = types.MsgSetMintAllowance
+	MsgRedeem                  = types.MsgRedeem
+	MsgSettleRedemption        = types.MsgSettleRedemption
+	MsgRejectRedemption        = types.MsgRejectRedemption
+	Redemption                 = types.Redemption
+	MsgCreateReclaimSchedule   = types.MsgCreateReclaimSchedule
+	MsgDeleteReclaimSchedule   = types.MsgDeleteReclaimSchedule
+	ReclaimSplit               = types.ReclaimSplit
+	ReclaimSchedule            = types.ReclaimSchedule
+	MsgSetTransferMode         = types.MsgSetTransferMode
+	MsgUpdateTransferAllowlist = types.MsgUpdateTransferAllowlist
+	TransferMode               = types.TransferMode
+	TokenTransferMode          = types.TokenTransferMode
+	TransferAllowlist          = types.TransferAllowlist
+	MsgSetTransferLimit        = types.MsgSetTransferLimit
+	TransferLimit              = types.TransferLimit
+	TransferUsage              = types.TransferUsage
+	MinterTotal                = types.MinterTotal
+	ReclaimTotal               = types.ReclaimTotal
+	ReclaimRecord              = types.ReclaimRecord
+	MsgCreateEscrow            = types.MsgCreateEscrow
+	MsgReleaseEscrow           = types.MsgReleaseEscrow
+	MsgClaimEscrowRefund       = types.MsgClaimEscrowRefund
+	Escrow                     = types.Escrow
+	MsgUpdateParams            = types.MsgUpdateParams
+	Params                     = types.Params
+	EscrowStatus               = types.EscrowStatus
+	MintAllowance              = types.MintAllowance
+	MintRecord                 = types.MintRecord
+	Keeper                     = keeper.Keeper
+	GenesisState               = types.GenesisState
 )
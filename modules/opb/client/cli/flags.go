@@ -0,0 +1,65 @@
+package cli
+
+import (
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagReason = "reason"
+	FlagHolder = "holder"
+	FlagStatus = "status"
+	FlagAmount = "amount"
+	FlagSplits = "splits"
+	FlagAdd    = "add"
+	FlagRemove = "remove"
+
+	FlagMaxAmountPerTransfer = "max-amount-per-transfer"
+	FlagDailyVolumeLimit     = "daily-volume-limit"
+	FlagDailyCountLimit      = "daily-count-limit"
+	FlagAction               = "action"
+
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+
+	FlagHashLock = "hash-lock"
+	FlagPreimage = "preimage"
+)
+
+var (
+	FsRejectRedemption = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryRedemptions = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReclaim          = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsUpdateTransferAllowlist = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSetTransferLimit        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryHistory            = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsCreateEscrow  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsReleaseEscrow = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryEscrows  = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsRejectRedemption.String(FlagReason, "", "the reason why the redemption is rejected")
+
+	FsQueryRedemptions.String(FlagHolder, "", "the holder of the redemptions, all holders if empty")
+	FsReclaim.String(FlagAmount, "", "the amount to reclaim, the whole balance if not specified")
+	FsReclaim.String(FlagSplits, "", "the recipients along with the weights summing up to 1, in the form of <recipient>:<weight>,...")
+
+	FsUpdateTransferAllowlist.StringSlice(FlagAdd, []string{}, "the addresses to add to the transfer allowlist")
+	FsUpdateTransferAllowlist.StringSlice(FlagRemove, []string{}, "the addresses to remove from the transfer allowlist")
+
+	FsSetTransferLimit.String(FlagMaxAmountPerTransfer, "", "the max amount per transfer, no limit if not specified")
+	FsSetTransferLimit.String(FlagDailyVolumeLimit, "", "the max volume transferred by an account per day, no limit if not specified")
+	FsSetTransferLimit.Uint64(FlagDailyCountLimit, 0, "the max number of the transfers by an account per day, no limit if zero")
+	FsSetTransferLimit.String(FlagAction, "reject", "the action on the transfer over the limits (reject|tag)")
+
+	FsQueryHistory.Int64(FlagStartHeight, 0, "the start height of the history, inclusive")
+	FsQueryHistory.Int64(FlagEndHeight, 0, "the end height of the history, inclusive, the latest if zero")
+
+	FsQueryRedemptions.String(FlagStatus, "", "the status of the redemptions (pending|settled|rejected), all statuses if empty")
+
+	FsCreateEscrow.String(FlagHashLock, "", "the hex-encoded SHA-256 hash of the preimage releasing the escrow, optional")
+	FsReleaseEscrow.String(FlagPreimage, "", "the hex-encoded preimage of the hash lock, not required for the arbiter")
+	FsQueryEscrows.String(FlagStatus, "", "the status of the escrows (locked|released|refunded|refund_failed), all statuses if empty")
+}
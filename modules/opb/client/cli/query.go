@@ -3,11 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/aadhi0612/iritamod/modules/opb/types"
@@ -25,6 +27,24 @@ func GetQueryCmd() *cobra.Command {
 
 	opbQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryMintAllowance(),
+		GetCmdQueryMintRecords(),
+		GetCmdQueryMintSupply(),
+		GetCmdQueryRedemption(),
+		GetCmdQueryRedemptions(),
+		GetCmdQueryReclaimSchedule(),
+		GetCmdQueryReclaimSchedules(),
+		GetCmdQueryTransferMode(),
+		GetCmdQueryTransferAllowlist(),
+		GetCmdQueryTransferLimit(),
+		GetCmdQueryRemainingTransferAllowance(),
+		GetCmdQueryMinterTotals(),
+		GetCmdQueryReclaimTotals(),
+		GetCmdQueryFeeCollectorBalances(),
+		GetCmdQueryMintHistory(),
+		GetCmdQueryReclaimHistory(),
+		GetCmdQueryEscrow(),
+		GetCmdQueryEscrows(),
 	)
 
 	return opbQueryCmd
@@ -59,3 +79,714 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryMintAllowance implements the query mint allowance command.
+func GetCmdQueryMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-allowance [minter]",
+		Short:   "Query the mint allowance of a minter",
+		Long:    "Query the remaining amount of the base native token the minter is allowed to mint",
+		Example: fmt.Sprintf("$ %s query %s mint-allowance <minter>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintAllowance(context.Background(), &types.QueryMintAllowanceRequest{
+				Minter: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Allowance)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintRecords implements the query mint records command.
+func GetCmdQueryMintRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-records [minter]",
+		Short:   "Query the mint records",
+		Long:    "Query the mint records, optionally filtered by the minter",
+		Example: fmt.Sprintf("$ %s query %s mint-records [minter]", version.AppName, types.ModuleName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var minter string
+			if len(args) > 0 {
+				minter = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintRecords(context.Background(), &types.QueryMintRecordsRequest{
+				Minter:     minter,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint records")
+
+	return cmd
+}
+
+// GetCmdQueryMintSupply implements the query mint supply command.
+func GetCmdQueryMintSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-supply",
+		Short:   "Query the minted amount of the base native token",
+		Long:    "Query the total minted amount and the amount minted in the current epoch of the base native token",
+		Example: fmt.Sprintf("$ %s query %s mint-supply", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintSupply(context.Background(), &types.QueryMintSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRedemption implements the query redemption command.
+func GetCmdQueryRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redemption [id]",
+		Short:   "Query a redemption",
+		Long:    "Query the redemption of the given id",
+		Example: fmt.Sprintf("$ %s query %s redemption <id>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Redemption(context.Background(), &types.QueryRedemptionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Redemption)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRedemptions implements the query redemptions command.
+func GetCmdQueryRedemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redemptions",
+		Short:   "Query the redemptions",
+		Long:    "Query the redemptions, optionally filtered by the holder and the status",
+		Example: fmt.Sprintf("$ %s query %s redemptions --holder=<holder> --status=pending", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			holder, err := cmd.Flags().GetString(FlagHolder)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRedemptionsRequest{
+				Holder:     holder,
+				Pagination: pageReq,
+			}
+
+			if len(statusStr) > 0 {
+				status, err := types.RedemptionStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+
+				req.FilterStatus = true
+				req.Status = status
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Redemptions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryRedemptions)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redemptions")
+
+	return cmd
+}
+
+// GetCmdQueryReclaimSchedule implements the query reclaim schedule command.
+func GetCmdQueryReclaimSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-schedule [id]",
+		Short:   "Query a reclaim schedule",
+		Long:    "Query the reclaim schedule of the given id",
+		Example: fmt.Sprintf("$ %s query %s reclaim-schedule <id>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReclaimSchedule(context.Background(), &types.QueryReclaimScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReclaimSchedules implements the query reclaim schedules command.
+func GetCmdQueryReclaimSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-schedules",
+		Short:   "Query the reclaim schedules",
+		Long:    "Query all the reclaim schedules",
+		Example: fmt.Sprintf("$ %s query %s reclaim-schedules", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReclaimSchedules(context.Background(), &types.QueryReclaimSchedulesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reclaim schedules")
+
+	return cmd
+}
+
+// GetCmdQueryTransferMode implements the query transfer mode command.
+func GetCmdQueryTransferMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-mode [denom]",
+		Short:   "Query the transfer mode of a token",
+		Long:    "Query the effective transfer mode of the token and whether it is explicitly set",
+		Example: fmt.Sprintf("$ %s query %s transfer-mode <denom>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferMode(context.Background(), &types.QueryTransferModeRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTransferAllowlist implements the query transfer allowlist command.
+func GetCmdQueryTransferAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-allowlist [denom]",
+		Short:   "Query the transfer allowlist of a token",
+		Long:    "Query the addresses in the transfer allowlist of the token",
+		Example: fmt.Sprintf("$ %s query %s transfer-allowlist <denom>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferAllowlist(context.Background(), &types.QueryTransferAllowlistRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer allowlist")
+
+	return cmd
+}
+
+// GetCmdQueryTransferLimit implements the query transfer limit command.
+func GetCmdQueryTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-limit [denom]",
+		Short:   "Query the transfer limits of a token",
+		Long:    "Query the transfer limits of the token",
+		Example: fmt.Sprintf("$ %s query %s transfer-limit <denom>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferLimit(context.Background(), &types.QueryTransferLimitRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Limit)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemainingTransferAllowance implements the query remaining transfer allowance command.
+func GetCmdQueryRemainingTransferAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remaining-transfer-allowance [denom] [address]",
+		Short:   "Query the remaining daily transfer allowance of an account",
+		Long:    "Query the transfer usage of the token by the account in the current day and the remaining volume and number of the transfers",
+		Example: fmt.Sprintf("$ %s query %s remaining-transfer-allowance <denom> <address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemainingTransferAllowance(context.Background(), &types.QueryRemainingTransferAllowanceRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMinterTotals implements the query minter totals command.
+func GetCmdQueryMinterTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "minter-totals",
+		Short:   "Query the total amount minted by each minter",
+		Long:    "Query the total amount of the base token minted by each minter",
+		Example: fmt.Sprintf("$ %s query %s minter-totals", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinterTotals(context.Background(), &types.QueryMinterTotalsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "minter totals")
+
+	return cmd
+}
+
+// GetCmdQueryReclaimTotals implements the query reclaim totals command.
+func GetCmdQueryReclaimTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-totals",
+		Short:   "Query the total amount reclaimed by denom",
+		Long:    "Query the total amount reclaimed of each denom",
+		Example: fmt.Sprintf("$ %s query %s reclaim-totals", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReclaimTotals(context.Background(), &types.QueryReclaimTotalsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reclaim totals")
+
+	return cmd
+}
+
+// GetCmdQueryFeeCollectorBalances implements the query fee collector balances command.
+func GetCmdQueryFeeCollectorBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-collector-balances",
+		Short:   "Query the balances of the fee collectors",
+		Long:    "Query the base token balance of the fee collector and the point token balance of the point token fee collector",
+		Example: fmt.Sprintf("$ %s query %s fee-collector-balances", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeCollectorBalances(context.Background(), &types.QueryFeeCollectorBalancesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintHistory implements the query mint history command.
+func GetCmdQueryMintHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-history",
+		Short:   "Query the mint history",
+		Long:    "Query the mint records within the height range",
+		Example: fmt.Sprintf("$ %s query %s mint-history --start-height=100 --end-height=200", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, endHeight, err := parseHeightRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintHistory(context.Background(), &types.QueryMintHistoryRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryHistory)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint history")
+
+	return cmd
+}
+
+// GetCmdQueryReclaimHistory implements the query reclaim history command.
+func GetCmdQueryReclaimHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-history",
+		Short:   "Query the reclaim history",
+		Long:    "Query the reclaim records within the height range",
+		Example: fmt.Sprintf("$ %s query %s reclaim-history --start-height=100 --end-height=200", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, endHeight, err := parseHeightRangeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReclaimHistory(context.Background(), &types.QueryReclaimHistoryRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryHistory)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reclaim history")
+
+	return cmd
+}
+
+// parseHeightRangeFlags parses the start and end heights from the flags
+func parseHeightRangeFlags(cmd *cobra.Command) (startHeight int64, endHeight int64, err error) {
+	if startHeight, err = cmd.Flags().GetInt64(FlagStartHeight); err != nil {
+		return
+	}
+
+	endHeight, err = cmd.Flags().GetInt64(FlagEndHeight)
+	return
+}
+
+// GetCmdQueryEscrow implements the query escrow command.
+func GetCmdQueryEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow [id]",
+		Short:   "Query an escrow",
+		Long:    "Query the escrow of the given id",
+		Example: fmt.Sprintf("$ %s query %s escrow <id>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Escrow(context.Background(), &types.QueryEscrowRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Escrow)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEscrows implements the query escrows command.
+func GetCmdQueryEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrows",
+		Short:   "Query the escrows",
+		Long:    "Query the escrows, optionally filtered by the status",
+		Example: fmt.Sprintf("$ %s query %s escrows --status=locked", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEscrowsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(statusStr) > 0 {
+				status, err := types.EscrowStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+
+				req.FilterStatus = true
+				req.Status = status
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Escrows(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryEscrows)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrows")
+
+	return cmd
+}
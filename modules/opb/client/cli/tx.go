@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -28,6 +29,19 @@ func NewTxCmd() *cobra.Command {
 	opbTxCmd.AddCommand(
 		NewMintCmd(),
 		NewReclaimCmd(),
+		NewSetMintAllowanceCmd(),
+		NewRedeemCmd(),
+		NewSettleRedemptionCmd(),
+		NewRejectRedemptionCmd(),
+		NewCreateReclaimScheduleCmd(),
+		NewDeleteReclaimScheduleCmd(),
+		NewSetTransferModeCmd(),
+		NewUpdateTransferAllowlistCmd(),
+		NewSetTransferLimitCmd(),
+		NewCreateEscrowCmd(),
+		NewReleaseEscrowCmd(),
+		NewClaimEscrowRefundCmd(),
+		NewUpdateParamsCmd(),
 	)
 
 	return opbTxCmd
@@ -87,10 +101,15 @@ func NewReclaimCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reclaim [denom] [to]",
 		Short: "Reclaim the native token of the specified denom",
-		Long:  strings.TrimSpace("Reclaim the native token of the specified denom from the corresponding escrow account"),
+		Long: strings.TrimSpace(
+			`Reclaim the native token of the specified denom from the corresponding escrow account.
+The whole balance is reclaimed unless the amount is specified, and it can be split across
+several recipients by weight instead of sent to a single recipient.`,
+		),
 		Example: fmt.Sprintf(
-			"$ %s tx %s reclaim <denom> <to> --from mykey",
-			version.AppName, types.ModuleName,
+			"$ %s tx %s reclaim <denom> <to> --from mykey\n"+
+				"$ %s tx %s reclaim <denom> --amount=1000 --splits=<addr1>:0.6,<addr2>:0.4 --from mykey",
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 		),
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -101,18 +120,657 @@ func NewReclaimCmd() *cobra.Command {
 
 			operator := clientCtx.GetFromAddress()
 
-			var recipient sdk.AccAddress
+			amount, splits, err := parseReclaimFlags(cmd)
+			if err != nil {
+				return err
+			}
 
-			if len(args) > 1 {
-				recipient, err = sdk.AccAddressFromBech32(args[1])
-				if err != nil {
-					return err
+			var msg *types.MsgReclaim
+
+			if len(splits) > 0 {
+				if len(args) > 1 {
+					return fmt.Errorf("recipient and splits can not be both specified")
 				}
+
+				msg = types.NewMsgReclaimSplits(args[0], amount, splits, operator)
 			} else {
-				recipient = operator
+				var recipient sdk.AccAddress
+
+				if len(args) > 1 {
+					recipient, err = sdk.AccAddressFromBech32(args[1])
+					if err != nil {
+						return err
+					}
+				} else {
+					recipient = operator
+				}
+
+				msg = types.NewMsgReclaim(args[0], recipient, operator)
+				msg.Amount = amount
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsReclaim)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetMintAllowanceCmd implements the set-mint-allowance command.
+func NewSetMintAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-allowance [minter] [amount]",
+		Short: "Set the mint allowance of a minter",
+		Long:  strings.TrimSpace("Set the remaining amount of the base native token in main unit the minter is allowed to mint, 0 to revoke"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s set-mint-allowance <minter> <amount> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintAllowance(minter, amount, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedeemCmd implements the redeem command.
+func NewRedeemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [amount] [settlement-ref]",
+		Short: "Redeem the base or point token",
+		Long:  strings.TrimSpace("Redeem the base or point token for an off-chain settlement. The tokens are escrowed and burned once the redemption is settled by the base token manager"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s redeem <amount> <settlement-ref> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeem(amount, args[1], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSettleRedemptionCmd implements the settle redemption command.
+func NewSettleRedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-redemption [id]",
+		Short: "Mark a redemption as settled",
+		Long:  strings.TrimSpace("Mark a pending redemption as settled and burn the escrowed tokens, only by the base token manager"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s settle-redemption <id> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleRedemption(id, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRejectRedemptionCmd implements the reject redemption command.
+func NewRejectRedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-redemption [id]",
+		Short: "Reject a redemption",
+		Long:  strings.TrimSpace("Reject a pending redemption and return the escrowed tokens to the holder, only by the base token manager"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s reject-redemption <id> --reason=<reason> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRejectRedemption(id, reason, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsRejectRedemption)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateReclaimScheduleCmd implements the create reclaim schedule command.
+func NewCreateReclaimScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-reclaim-schedule [denom] [interval]",
+		Short: "Create a reclaim schedule",
+		Long:  strings.TrimSpace("Create a standing reclaim of the native token of the specified denom, executed every interval blocks"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s create-reclaim-schedule <denom> <interval> --splits=<addr1>:0.6,<addr2>:0.4 --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, splits, err := parseReclaimFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateReclaimSchedule(args[0], amount, splits, interval, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsReclaim)
+	_ = cmd.MarkFlagRequired(FlagSplits)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteReclaimScheduleCmd implements the delete reclaim schedule command.
+func NewDeleteReclaimScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-reclaim-schedule [id]",
+		Short: "Delete a reclaim schedule",
+		Long:  strings.TrimSpace("Delete a reclaim schedule, only by the creator or the root admin"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s delete-reclaim-schedule <id> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteReclaimSchedule(id, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetTransferModeCmd implements the set transfer mode command.
+func NewSetTransferModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-mode [denom] [mode]",
+		Short: "Set the transfer mode of a token",
+		Long: strings.TrimSpace(
+			"Set the transfer mode (unrestricted|owner-only|allowlist) of the token, only by the token owner. " +
+				"The unspecified mode makes the token follow the global transfer restriction",
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx %s set-transfer-mode <denom> allowlist --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mode, err := types.TransferModeFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferMode(args[0], mode, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateTransferAllowlistCmd implements the update transfer allowlist command.
+func NewUpdateTransferAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-transfer-allowlist [denom]",
+		Short: "Update the transfer allowlist of a token",
+		Long:  strings.TrimSpace("Add and remove the addresses to and from the transfer allowlist of the token, only by the token owner"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s update-transfer-allowlist <denom> --add=<addr1>,<addr2> --remove=<addr3> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetStringSlice(FlagAdd)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateTransferAllowlist(args[0], add, remove, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsUpdateTransferAllowlist)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetTransferLimitCmd implements the set transfer limit command.
+func NewSetTransferLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-limit [denom]",
+		Short: "Set the transfer limits of a token",
+		Long: strings.TrimSpace(
+			"Set the limits on the amount per transfer, the daily volume and the daily number of the transfers " +
+				"sent by an account, only by the token owner. The limit not specified is disabled, and all the limits are removed if none is specified",
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx %s set-transfer-limit <denom> --max-amount-per-transfer=1000 --daily-volume-limit=10000 --daily-count-limit=10 --action=tag --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxAmountPerTransfer, err := parseIntFlag(cmd, FlagMaxAmountPerTransfer)
+			if err != nil {
+				return err
+			}
+
+			dailyVolumeLimit, err := parseIntFlag(cmd, FlagDailyVolumeLimit)
+			if err != nil {
+				return err
+			}
+
+			dailyCountLimit, err := cmd.Flags().GetUint64(FlagDailyCountLimit)
+			if err != nil {
+				return err
+			}
+
+			actionStr, err := cmd.Flags().GetString(FlagAction)
+			if err != nil {
+				return err
+			}
+
+			action, err := types.LimitActionFromString(actionStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferLimit(args[0], maxAmountPerTransfer, dailyVolumeLimit, dailyCountLimit, action, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSetTransferLimit)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseIntFlag parses the integer from the given flag, zero if not specified
+func parseIntFlag(cmd *cobra.Command, flag string) (sdk.Int, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if len(str) == 0 {
+		return sdk.ZeroInt(), nil
+	}
+
+	amount, ok := sdk.NewIntFromString(str)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid %s %s", flag, str)
+	}
+
+	return amount, nil
+}
+
+// parseReclaimFlags parses the reclaim amount and splits from the flags,
+// where the amount is nil if not specified and the splits are in the form of <recipient>:<weight>,...
+func parseReclaimFlags(cmd *cobra.Command) (sdk.Int, []types.ReclaimSplit, error) {
+	var amount sdk.Int
+
+	amountStr, err := cmd.Flags().GetString(FlagAmount)
+	if err != nil {
+		return amount, nil, err
+	}
+
+	if len(amountStr) > 0 {
+		var ok bool
+		if amount, ok = sdk.NewIntFromString(amountStr); !ok {
+			return amount, nil, fmt.Errorf("invalid amount %s", amountStr)
+		}
+	}
+
+	splitsStr, err := cmd.Flags().GetString(FlagSplits)
+	if err != nil {
+		return amount, nil, err
+	}
+
+	if len(splitsStr) == 0 {
+		return amount, nil, nil
+	}
+
+	var splits []types.ReclaimSplit
+	for _, splitStr := range strings.Split(splitsStr, ",") {
+		parts := strings.Split(strings.TrimSpace(splitStr), ":")
+		if len(parts) != 2 {
+			return amount, nil, fmt.Errorf("invalid split %s, expected <recipient>:<weight>", splitStr)
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return amount, nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return amount, nil, err
+		}
+
+		splits = append(splits, types.NewReclaimSplit(recipient, weight))
+	}
+
+	return amount, splits, nil
+}
+
+// NewCreateEscrowCmd implements the create escrow command.
+func NewCreateEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow [beneficiary] [arbiter] [amount] [expiry-height]",
+		Short: "Lock the base token in an escrow",
+		Long: strings.TrimSpace(
+			"Lock the base token in an escrow for the beneficiary. The escrow is released by the arbiter or the preimage of the optional hash lock, " +
+				"and refunded to the payer at the end of the expiry height",
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx %s create-escrow <beneficiary> <arbiter> <amount> <expiry-height> --hash-lock=<hash-lock> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			beneficiary, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			arbiter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			hashLock, err := cmd.Flags().GetString(FlagHashLock)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateEscrow(clientCtx.GetFromAddress(), beneficiary, arbiter, amount, expiryHeight, hashLock)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCreateEscrow)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewReleaseEscrowCmd implements the release escrow command.
+func NewReleaseEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrow [id]",
+		Short: "Release an escrow to the beneficiary",
+		Long:  strings.TrimSpace("Release an escrow to the beneficiary, either approved by the arbiter or unlocked by the preimage of the hash lock"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s release-escrow <id> --preimage=<preimage> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			preimage, err := cmd.Flags().GetString(FlagPreimage)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseEscrow(id, preimage, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsReleaseEscrow)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimEscrowRefundCmd implements the claim escrow refund command.
+func NewClaimEscrowRefundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-escrow-refund [id]",
+		Short: "Claim the refund of an escrow failing the refund upon expiry",
+		Example: fmt.Sprintf(
+			"$ %s tx %s claim-escrow-refund <id> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimEscrowRefund(id, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateParamsCmd implements the update params command.
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-file]",
+		Short: "Update the module params",
+		Long:  strings.TrimSpace("Update the module params to the ones in the given JSON file, which replace all the current params"),
+		Example: fmt.Sprintf(
+			"$ %s tx %s update-params <path/to/params.json> --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return err
 			}
 
-			msg := types.NewMsgReclaim(args[0], recipient, operator)
+			msg := types.NewMsgUpdateParams(params, clientCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -30,6 +30,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) (res []abci.Valid
 
 	k.SetParams(ctx, data.Params)
 
+	k.InitMintState(ctx, data.MintAllowances, data.MintRecords, data.TotalMinted, data.EpochMint)
+	k.InitRedemptions(ctx, data.Redemptions)
+	k.InitReclaimSchedules(ctx, data.ReclaimSchedules)
+	k.InitTransferRestrictions(ctx, data.TransferModes, data.TransferAllowlists)
+	k.InitTransferLimits(ctx, data.TransferLimits, data.TransferUsages)
+	k.InitAccounting(ctx, data.MinterTotals, data.ReclaimTotals, data.ReclaimRecords)
+	k.InitEscrows(ctx, data.Escrows)
+
 	return nil
 }
 
@@ -37,5 +45,19 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) (res []abci.Valid
 func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
 	return NewGenesisState(
 		k.GetParams(ctx),
+		k.GetMintAllowances(ctx),
+		k.GetMintRecords(ctx),
+		k.GetTotalMinted(ctx),
+		k.GetEpochMint(ctx),
+		k.GetRedemptions(ctx),
+		k.GetReclaimSchedules(ctx),
+		k.GetTransferModes(ctx),
+		k.GetTransferAllowlists(ctx),
+		k.GetTransferLimits(ctx),
+		k.GetTransferUsages(ctx),
+		k.GetMinterTotals(ctx),
+		k.GetReclaimTotals(ctx),
+		k.GetReclaimRecords(ctx),
+		k.GetEscrows(ctx),
 	)
 }
@@ -23,6 +23,58 @@ func NewHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.Reclaim(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *MsgSetMintAllowance:
+			res, err := msgServer.SetMintAllowance(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgRedeem:
+			res, err := msgServer.Redeem(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgSettleRedemption:
+			res, err := msgServer.SettleRedemption(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgRejectRedemption:
+			res, err := msgServer.RejectRedemption(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgCreateReclaimSchedule:
+			res, err := msgServer.CreateReclaimSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgDeleteReclaimSchedule:
+			res, err := msgServer.DeleteReclaimSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgSetTransferMode:
+			res, err := msgServer.SetTransferMode(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgUpdateTransferAllowlist:
+			res, err := msgServer.UpdateTransferAllowlist(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgSetTransferLimit:
+			res, err := msgServer.SetTransferLimit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgCreateEscrow:
+			res, err := msgServer.CreateEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgReleaseEscrow:
+			res, err := msgServer.ReleaseEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgClaimEscrowRefund:
+			res, err := msgServer.ClaimEscrowRefund(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *MsgUpdateParams:
+			res, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
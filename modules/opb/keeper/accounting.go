@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// GetMinterTotal returns the total amount of the base token minted by the minter
+func (k Keeper) GetMinterTotal(ctx sdk.Context, minter sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MinterTotalStoreKey(minter))
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetMinterTotals returns the total amounts minted by all the minters
+func (k Keeper) GetMinterTotals(ctx sdk.Context) []types.MinterTotal {
+	totals := make([]types.MinterTotal, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMinterTotal)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key: <0x11><len><minter>
+		minter := sdk.AccAddress(iterator.Key()[len(types.KeyPrefixMinterTotal)+1:])
+		totals = append(totals, types.NewMinterTotal(minter, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return totals
+}
+
+// GetReclaimTotal returns the total amount of the denom reclaimed
+func (k Keeper) GetReclaimTotal(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ReclaimTotalStoreKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// GetReclaimTotals returns the total amounts reclaimed of all the denoms
+func (k Keeper) GetReclaimTotals(ctx sdk.Context) []types.ReclaimTotal {
+	totals := make([]types.ReclaimTotal, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixReclaimTotal)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		// key: <0x12><denom>
+		denom := string(iterator.Key()[len(types.KeyPrefixReclaimTotal):])
+		totals = append(totals, types.NewReclaimTotal(denom, amount))
+	}
+
+	return totals
+}
+
+// GetReclaimRecord returns the reclaim record of the given id
+func (k Keeper) GetReclaimRecord(ctx sdk.Context, id uint64) (types.ReclaimRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ReclaimRecordStoreKey(id))
+	if bz == nil {
+		return types.ReclaimRecord{}, false
+	}
+
+	var record types.ReclaimRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// GetReclaimRecords returns all the reclaim records
+func (k Keeper) GetReclaimRecords(ctx sdk.Context) []types.ReclaimRecord {
+	records := make([]types.ReclaimRecord, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixReclaimRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ReclaimRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetReclaimRecordSequence returns the id of the latest reclaim record
+func (k Keeper) GetReclaimRecordSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReclaimRecordSequenceStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetFeeCollectorBalances returns the base token balance of the fee collector
+// and the point token balance of the point token fee collector
+func (k Keeper) GetFeeCollectorBalances(ctx sdk.Context) (baseTokenBalance sdk.Coin, pointTokenBalance sdk.Coin) {
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	pointTokenFeeCollector := k.accountKeeper.GetModuleAddress(types.PointTokenFeeCollectorName)
+
+	baseTokenBalance = k.bankKeeper.GetBalance(ctx, feeCollector, k.BaseTokenDenom(ctx))
+	pointTokenBalance = k.bankKeeper.GetBalance(ctx, pointTokenFeeCollector, k.PointTokenDenom(ctx))
+
+	return
+}
+
+// InitAccounting initializes the minter totals, reclaim totals and reclaim records from genesis
+func (k Keeper) InitAccounting(
+	ctx sdk.Context,
+	minterTotals []types.MinterTotal,
+	reclaimTotals []types.ReclaimTotal,
+	reclaimRecords []types.ReclaimRecord,
+) {
+	for _, total := range minterTotals {
+		minter, _ := sdk.AccAddressFromBech32(total.Minter)
+		k.setMinterTotal(ctx, minter, total.Amount)
+	}
+
+	for _, total := range reclaimTotals {
+		k.setReclaimTotal(ctx, total.Denom, total.Amount)
+	}
+
+	var sequence uint64
+	for _, record := range reclaimRecords {
+		k.setReclaimRecord(ctx, record)
+
+		if record.Id > sequence {
+			sequence = record.Id
+		}
+	}
+
+	k.setReclaimRecordSequence(ctx, sequence)
+}
+
+// addMinterTotal accumulates the amount to the total minted by the minter
+func (k Keeper) addMinterTotal(ctx sdk.Context, minter sdk.AccAddress, amount uint64) {
+	k.setMinterTotal(ctx, minter, k.GetMinterTotal(ctx, minter)+amount)
+}
+
+// setMinterTotal sets the total amount minted by the minter
+func (k Keeper) setMinterTotal(ctx sdk.Context, minter sdk.AccAddress, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MinterTotalStoreKey(minter), sdk.Uint64ToBigEndian(amount))
+}
+
+// setReclaimTotal sets the total amount of the denom reclaimed
+func (k Keeper) setReclaimTotal(ctx sdk.Context, denom string, amount sdk.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReclaimTotalStoreKey(denom), bz)
+}
+
+// addReclaimRecord records the reclaimed coin with the next sequence and accumulates it to the reclaim total
+func (k Keeper) addReclaimRecord(ctx sdk.Context, operator, recipient sdk.AccAddress, amount sdk.Coin) types.ReclaimRecord {
+	id := k.GetReclaimRecordSequence(ctx) + 1
+
+	record := types.NewReclaimRecord(id, operator, recipient, amount, ctx.BlockHeight())
+
+	k.setReclaimRecord(ctx, record)
+	k.setReclaimRecordSequence(ctx, id)
+	k.setReclaimTotal(ctx, amount.Denom, k.GetReclaimTotal(ctx, amount.Denom).Add(amount.Amount))
+
+	return record
+}
+
+// setReclaimRecord sets the reclaim record along with the index by height
+func (k Keeper) setReclaimRecord(ctx sdk.Context, record types.ReclaimRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ReclaimRecordStoreKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.ReclaimRecordByHeightStoreKey(record.Height, record.Id), types.Placeholder)
+}
+
+// setReclaimRecordSequence sets the id of the latest reclaim record
+func (k Keeper) setReclaimRecordSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReclaimRecordSequenceStoreKey(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// getMintRecordByHeightStore returns the mint record index store by height
+func (k Keeper) getMintRecordByHeightStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecordByHeight)
+}
+
+// getReclaimRecordByHeightStore returns the reclaim record index store by height
+func (k Keeper) getReclaimRecordByHeightStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReclaimRecordByHeight)
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+func (s *KeeperTestSuite) TestMintHistory() {
+	s.Require().NoError(s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 1000, rootAdmin))
+
+	// mint at the heights from 1 to 5, twice at the height 3
+	for _, height := range []int64{1, 2, 3, 3, 4, 5} {
+		_, err := s.keeper.Mint(s.ctx.WithBlockHeight(height), uint64(height), accBob, baseM1Admin)
+		s.Require().NoErrorf(err, "failed to mint")
+	}
+
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	heights := func(records []types.MintRecord) []int64 {
+		var heights []int64
+		for _, record := range records {
+			heights = append(heights, record.Height)
+		}
+		return heights
+	}
+
+	res, err := s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{StartHeight: 2, EndHeight: 4})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{2, 3, 3, 4}, heights(res.Records))
+	s.Require().Equal(uint64(4), res.Pagination.Total)
+
+	// the end height is unbounded if zero
+	res, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{StartHeight: 4})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{4, 5}, heights(res.Records))
+
+	// paginate within the range
+	res, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{2, 3}, heights(res.Records))
+	s.Require().Equal(uint64(4), res.Pagination.Total)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{3, 4}, heights(res.Records))
+	s.Require().Nil(res.Pagination.NextKey)
+
+	res, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Offset: 1, Limit: 2, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{3, 3}, heights(res.Records))
+
+	// the key out of the range is rejected
+	_, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{
+		StartHeight: 1,
+		EndHeight:   1,
+		Pagination:  &query.PageRequest{Key: types.MintRecordByHeightStoreKey(2, 2)[1:]},
+	})
+	s.Require().Error(err)
+
+	_, err = s.keeper.MintHistory(ctx, &types.QueryMintHistoryRequest{StartHeight: 4, EndHeight: 2})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestReclaimHistory() {
+	s.collect(accAlice, authtypes.FeeCollectorName, sdk.NewInt64Coin(baseDenom, 100))
+
+	splits := []types.ReclaimSplit{types.NewReclaimSplit(accBob, sdk.OneDec())}
+
+	for _, height := range []int64{1, 2, 3} {
+		_, err := s.keeper.Reclaim(s.ctx.WithBlockHeight(height), baseDenom, sdk.NewInt(height), splits, baseM1Admin)
+		s.Require().NoErrorf(err, "failed to reclaim")
+	}
+
+	res, err := s.keeper.ReclaimHistory(sdk.WrapSDKContext(s.ctx), &types.QueryReclaimHistoryRequest{StartHeight: 2, EndHeight: 2})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(int64(2), res.Records[0].Height)
+	s.Require().Equal(sdk.NewInt64Coin(baseDenom, 2), res.Records[0].Amount)
+
+	s.Require().Equal(int64(6), s.keeper.GetReclaimTotal(s.ctx, baseDenom).Int64())
+}
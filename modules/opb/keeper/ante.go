@@ -1,40 +1,37 @@
 package keeper
 
 import (
-	"github.com/aadhi0612/iritamod/modules/opb/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 )
 
 type ValidateFn func(ctx sdk.Context, msg sdk.Msg) error
 
-// ValidateTokenTransferDecorator checks if the token transfer satisfies the underlying constraint
+// ValidateTokenTransferDecorator checks if the token transfer satisfies the underlying constraint.
+// The messages wrapped in authz.MsgExec are checked as well.
+// NOTE: the transfer limits are charged upon the msg execution instead, see TransferLimitBankKeeper
+// and TransferLimitIBCMsgServer
 type ValidateTokenTransferDecorator struct {
-	keeper      Keeper
-	tokenKeeper types.TokenKeeper
-	permKeeper  types.PermKeeper
-	validators  map[string]ValidateFn
+	keeper     Keeper
+	validators map[string]ValidateFn
 }
 
 // NewValidateTokenTransferDecorator constructs a new ValidateTokenTransferDecorator instance
-func NewValidateTokenTransferDecorator(
-	keeper Keeper,
-	tokenKeeper types.TokenKeeper,
-	permKeeper types.PermKeeper,
-) *ValidateTokenTransferDecorator {
+func NewValidateTokenTransferDecorator(keeper Keeper) *ValidateTokenTransferDecorator {
 	return &ValidateTokenTransferDecorator{
-		keeper:      keeper,
-		tokenKeeper: tokenKeeper,
-		permKeeper:  permKeeper,
-		validators:  make(map[string]ValidateFn),
+		keeper:     keeper,
+		validators: make(map[string]ValidateFn),
 	}
 }
 
 func (vtd *ValidateTokenTransferDecorator) DefaultValidateFn() *ValidateTokenTransferDecorator {
 	return vtd.Append(&banktypes.MsgSend{}, vtd.validateMsgSend).
-		Append(&banktypes.MsgMultiSend{}, vtd.validateMsgMultiSend)
+		Append(&banktypes.MsgMultiSend{}, vtd.validateMsgMultiSend).
+		Append(&ibctransfertypes.MsgTransfer{}, vtd.validateMsgTransfer).
+		Append(&vestingtypes.MsgCreateVestingAccount{}, vtd.validateMsgCreateVestingAccount)
 }
 
 func (vtd *ValidateTokenTransferDecorator) Append(m sdk.Msg, fn ValidateFn) *ValidateTokenTransferDecorator {
@@ -44,21 +41,38 @@ func (vtd *ValidateTokenTransferDecorator) Append(m sdk.Msg, fn ValidateFn) *Val
 
 // AnteHandle implements AnteHandler
 func (vtd ValidateTokenTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	restrictionEnabled := !vtd.keeper.UnrestrictedTokenTransfer(ctx)
-
-	// check only if the transfer restriction is enabled
-	if restrictionEnabled {
-		for _, msg := range tx.GetMsgs() {
-			validateFn, ok := vtd.validators[sdk.MsgTypeURL(msg)]
-			if !ok {
-				continue
+	// the transfer restriction is checked per denom according to the transfer mode
+	if err := vtd.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// validateMsgs validates the given msgs, unwrapping the msgs executed on behalf of the granters
+func (vtd ValidateTokenTransferDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
 			}
-			if err := validateFn(ctx, msg); err != nil {
-				return ctx, err
+
+			if err := vtd.validateMsgs(ctx, innerMsgs); err != nil {
+				return err
 			}
+			continue
+		}
+
+		validateFn, ok := vtd.validators[sdk.MsgTypeURL(msg)]
+		if !ok {
+			continue
+		}
+		if err := validateFn(ctx, msg); err != nil {
+			return err
 		}
 	}
-	return next(ctx, tx, simulate)
+
+	return nil
 }
 
 // validateMsgSend validates the MsgSend msg
@@ -68,34 +82,8 @@ func (vtd ValidateTokenTransferDecorator) validateMsgSend(ctx sdk.Context, m sdk
 		return nil
 	}
 	for _, coin := range msg.Amount {
-		owner, err := vtd.getOwner(ctx, coin.Denom)
-		if err != nil {
-			continue
-		}
-		fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
-		if err != nil {
-			continue
-		}
-
-		toAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
-		if err != nil {
-			continue
-		}
-
-		// If sender have platform user permissions, you can transfer token
-		if vtd.hasPlatformUserPerm(ctx, fromAddress) ||
-			vtd.hasPlatformUserPerm(ctx, toAddress) {
-			return nil
-		}
-		// If sender have not platform user permissions,
-		// determine whether the recipient is the owner
-
-		if msg.FromAddress != owner && msg.ToAddress != owner {
-			return sdkerrors.Wrapf(
-				types.ErrUnauthorized,
-				"either the sender or recipient must be the owner %s for token %s",
-				owner, coin.Denom,
-			)
+		if err := vtd.keeper.ValidateTransfer(ctx, coin.Denom, []string{msg.FromAddress}, []string{msg.ToAddress}); err != nil {
+			return err
 		}
 	}
 
@@ -112,74 +100,39 @@ func (vtd ValidateTokenTransferDecorator) validateMsgMultiSend(ctx sdk.Context,
 	outputMap := getOutputMap(msg.Outputs)
 
 	for denom, addresses := range inputMap {
-		owner, err := vtd.getOwner(ctx, denom)
-		if err != nil {
-			continue
-		}
-		// If sender have platform user permissions, you can transfer token
-		if vtd.hasPlatformUserPermFromArr(ctx, addresses) ||
-			vtd.hasPlatformUserPermFromArr(ctx, outputMap[denom]) {
-			return nil
-		}
-
-		if !owned(owner, addresses) && !owned(owner, outputMap[denom]) {
-			return sdkerrors.Wrapf(
-				types.ErrUnauthorized,
-				"either the sender or recipient must be the owner %s for token %s",
-				owner, denom,
-			)
+		if err := vtd.keeper.ValidateTransfer(ctx, denom, addresses, outputMap[denom]); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// getOwner gets the owner of the specified denom
-func (vtd ValidateTokenTransferDecorator) getOwner(ctx sdk.Context, denom string) (owner string, err error) {
-	baseTokenDenom := vtd.keeper.BaseTokenDenom(ctx)
-
-	if denom == baseTokenDenom {
-		owner = vtd.keeper.BaseTokenManager(ctx)
-	} else {
-		ownerAddr, err := vtd.tokenKeeper.GetOwner(ctx, denom)
-		if err == nil {
-			owner = ownerAddr.String()
-		}
+// validateMsgTransfer validates the IBC MsgTransfer msg.
+// The receiver on the counterparty chain is neither the owner nor allowlisted,
+// hence only the owner or a platform user can transfer the restricted token out
+func (vtd ValidateTokenTransferDecorator) validateMsgTransfer(ctx sdk.Context, m sdk.Msg) error {
+	msg, ok := m.(*ibctransfertypes.MsgTransfer)
+	if !ok {
+		return nil
 	}
 
-	return
+	return vtd.keeper.ValidateTransfer(ctx, msg.Token.Denom, []string{msg.Sender}, []string{msg.Receiver})
 }
 
-// hasPlatformUserPermFromArr determine whether the account is a platform user from addresses
-func (vtd ValidateTokenTransferDecorator) hasPlatformUserPermFromArr(ctx sdk.Context, addresses []string) bool {
-	for _, addr := range addresses {
-		fromAddress, err := sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return false
-		}
-		if !vtd.hasPlatformUserPerm(ctx, fromAddress) {
-			return false
-		}
+// validateMsgCreateVestingAccount validates the MsgCreateVestingAccount msg
+func (vtd ValidateTokenTransferDecorator) validateMsgCreateVestingAccount(ctx sdk.Context, m sdk.Msg) error {
+	msg, ok := m.(*vestingtypes.MsgCreateVestingAccount)
+	if !ok {
+		return nil
 	}
-
-	return true
-}
-
-// hasPlatformUserPerm determine whether the account is a platform user
-func (vtd ValidateTokenTransferDecorator) hasPlatformUserPerm(ctx sdk.Context, address sdk.AccAddress) bool {
-	return vtd.permKeeper.IsRootAdmin(ctx, address) || vtd.permKeeper.IsBaseM1Admin(ctx, address) || vtd.permKeeper.IsPlatformUser(ctx, address)
-}
-
-// owned returns false if any address is not the owner of the denom among the given non-empty addresses
-// True otherwise
-func owned(owner string, addresses []string) bool {
-	for _, addr := range addresses {
-		if addr != owner {
-			return false
+	for _, coin := range msg.Amount {
+		if err := vtd.keeper.ValidateTransfer(ctx, coin.Denom, []string{msg.FromAddress}, []string{msg.ToAddress}); err != nil {
+			return err
 		}
 	}
 
-	return true
+	return nil
 }
 
 // getInputMap maps input denoms to addresses
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/keeper"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+func (s *KeeperTestSuite) TestValidateTokenTransferDecorator() {
+	txConfig := simapp.MakeEncodingConfig().TxConfig
+	nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	decorator := keeper.NewValidateTokenTransferDecorator(s.keeper).DefaultValidateFn()
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 100))
+	grantee := accBob
+
+	sendMsg := banktypes.NewMsgSend(accAlice, accBob, coins)
+	execMsg := authz.NewMsgExec(grantee, []sdk.Msg{sendMsg})
+	nestedExecMsg := authz.NewMsgExec(grantee, []sdk.Msg{&execMsg})
+	transferMsg := ibctransfertypes.NewMsgTransfer(
+		"transfer", "channel-0", coins[0], accAlice.String(), "receiver", clienttypes.NewHeight(0, 100), 0,
+	)
+	vestingMsg := vestingtypes.NewMsgCreateVestingAccount(accAlice, accBob, coins, 1000, false)
+
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{"MsgSend", sendMsg},
+		{"MsgExec", &execMsg},
+		{"nested MsgExec", &nestedExecMsg},
+		{"MsgTransfer", transferMsg},
+		{"MsgCreateVestingAccount", vestingMsg},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().NoError(s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeOwnerOnly, pointOwner))
+
+			_, err := decorator.AnteHandle(s.ctx, newTx(tc.msg), false, nextAnte)
+			s.Require().ErrorIs(err, types.ErrUnauthorized, "restricted denom")
+
+			s.Require().NoError(s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeUnrestricted, pointOwner))
+
+			_, err = decorator.AnteHandle(s.ctx, newTx(tc.msg), false, nextAnte)
+			s.Require().NoError(err, "unrestricted denom")
+		})
+	}
+}
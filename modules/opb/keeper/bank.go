@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ bankkeeper.Keeper = TransferLimitBankKeeper{}
+
+// TransferLimitBankKeeper wraps the bank keeper to charge the transfer limits upon the account to account
+// transfers, i.e. SendCoins and InputOutputCoins. It is intended to be handed to the modules whose msgs
+// transfer the tokens on behalf of the users, e.g. the msg servers of the bank and vesting modules, so that
+// the transfer limits are charged upon the msg execution and discarded along with the failed msgs.
+// The IBC transfers are charged by TransferLimitIBCMsgServer instead.
+// NOTE: the transfers from and to the module accounts are not charged
+type TransferLimitBankKeeper struct {
+	bankkeeper.Keeper
+
+	keeper *Keeper
+}
+
+// NewTransferLimitBankKeeper constructs a new TransferLimitBankKeeper instance
+func NewTransferLimitBankKeeper(bankKeeper bankkeeper.Keeper, keeper *Keeper) TransferLimitBankKeeper {
+	return TransferLimitBankKeeper{
+		Keeper: bankKeeper,
+		keeper: keeper,
+	}
+}
+
+// SendCoins charges the transfer limits of the sender before sending the coins
+func (k TransferLimitBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.consumeTransferLimits(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins charges the transfer limits of each input before sending the coins
+func (k TransferLimitBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.consumeTransferLimits(ctx, inAddress, input.Coins); err != nil {
+			return err
+		}
+	}
+
+	return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// consumeTransferLimits accumulates the coins sent by the sender to the daily transfer usages
+// and checks them against the transfer limits
+func (k TransferLimitBankKeeper) consumeTransferLimits(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if err := k.keeper.ConsumeTransferLimit(ctx, sender, coin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// CreateEscrow locks the base native token of the payer in the escrow account for the beneficiary.
+// The escrow is released by the arbiter or the preimage of the hash lock, and refunded at the end of the expiry height
+// NOTE: the transfer from the payer to the beneficiary must satisfy the transfer restriction and limits
+func (k Keeper) CreateEscrow(
+	ctx sdk.Context,
+	payer, beneficiary, arbiter sdk.AccAddress,
+	amount sdk.Coin,
+	expiryHeight int64,
+	hashLock string,
+) (types.Escrow, error) {
+	baseTokenDenom := k.BaseTokenDenom(ctx)
+	if amount.Denom != baseTokenDenom {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom must be %s", baseTokenDenom)
+	}
+
+	if expiryHeight <= ctx.BlockHeight() {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrInvalidEscrow, "expiry height %d must be greater than the current height %d", expiryHeight, ctx.BlockHeight())
+	}
+
+	for _, party := range []sdk.AccAddress{payer, beneficiary, arbiter} {
+		if k.permKeeper.GetBlockAccount(ctx, party) {
+			return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnauthorized, "address %s is blocked", party)
+		}
+	}
+
+	if err := k.ValidateTransfer(ctx, amount.Denom, []string{payer.String()}, []string{beneficiary.String()}); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.ConsumeTransferLimit(ctx, payer, amount); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.EscrowAccountName, sdk.NewCoins(amount)); err != nil {
+		return types.Escrow{}, err
+	}
+
+	id := k.GetEscrowSequence(ctx) + 1
+
+	escrow := types.NewEscrow(id, payer, beneficiary, arbiter, amount, expiryHeight, hashLock, ctx.BlockHeight())
+
+	k.setEscrow(ctx, escrow)
+	k.setEscrowSequence(ctx, id)
+
+	return escrow, nil
+}
+
+// ReleaseEscrow releases the locked escrow to the beneficiary, either approved by the arbiter
+// or unlocked by the preimage of the hash lock, which anyone is allowed to reveal
+// NOTE: the transfer from the payer to the beneficiary must still satisfy the transfer restriction
+func (k Keeper) ReleaseEscrow(ctx sdk.Context, id uint64, preimage string, operator sdk.AccAddress) (types.Escrow, error) {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnknownEscrow, "escrow %d does not exist", id)
+	}
+
+	if escrow.Status != types.EscrowStatusLocked {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrInvalidEscrow, "escrow %d is not locked", id)
+	}
+
+	if ctx.BlockHeight() > escrow.ExpiryHeight {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrInvalidEscrow, "escrow %d has expired", id)
+	}
+
+	if escrow.Arbiter != operator.String() && !escrow.Unlocks(preimage) {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnauthorized, "escrow %d can only be released by the arbiter or the preimage of the hash lock", id)
+	}
+
+	beneficiary, _ := sdk.AccAddressFromBech32(escrow.Beneficiary)
+	if k.permKeeper.GetBlockAccount(ctx, beneficiary) {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnauthorized, "beneficiary %s is blocked", beneficiary)
+	}
+
+	if err := k.ValidateTransfer(ctx, escrow.Amount.Denom, []string{escrow.Payer}, []string{escrow.Beneficiary}); err != nil {
+		return types.Escrow{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, beneficiary, sdk.NewCoins(escrow.Amount)); err != nil {
+		return types.Escrow{}, err
+	}
+
+	escrow.Status = types.EscrowStatusReleased
+	escrow.ResolveHeight = ctx.BlockHeight()
+
+	k.setEscrow(ctx, escrow)
+
+	return escrow, nil
+}
+
+// RefundEscrow refunds the expired escrow to the payer
+func (k Keeper) RefundEscrow(ctx sdk.Context, escrow types.Escrow) (types.Escrow, error) {
+	payer, _ := sdk.AccAddressFromBech32(escrow.Payer)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, payer, sdk.NewCoins(escrow.Amount)); err != nil {
+		return escrow, err
+	}
+
+	escrow.Status = types.EscrowStatusRefunded
+	escrow.ResolveHeight = ctx.BlockHeight()
+
+	k.setEscrow(ctx, escrow)
+
+	return escrow, nil
+}
+
+// FailEscrowRefund marks the expired escrow failed to be refunded, removing it from the expiry queue
+// so that the refund is not retried in every block. The funds stay in the escrow account
+// until the payer claims the refund
+func (k Keeper) FailEscrowRefund(ctx sdk.Context, escrow types.Escrow) types.Escrow {
+	escrow.Status = types.EscrowStatusRefundFailed
+	escrow.ResolveHeight = ctx.BlockHeight()
+
+	k.setEscrow(ctx, escrow)
+
+	return escrow
+}
+
+// ClaimEscrowRefund retries the refund of the escrow failing the refund upon expiry, claimed by the payer
+func (k Keeper) ClaimEscrowRefund(ctx sdk.Context, id uint64, payer sdk.AccAddress) (types.Escrow, error) {
+	escrow, found := k.GetEscrow(ctx, id)
+	if !found {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnknownEscrow, "escrow %d does not exist", id)
+	}
+
+	if escrow.Status != types.EscrowStatusRefundFailed {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrInvalidEscrow, "escrow %d has not failed the refund", id)
+	}
+
+	if escrow.Payer != payer.String() {
+		return types.Escrow{}, sdkerrors.Wrapf(types.ErrUnauthorized, "escrow %d can only be claimed by the payer", id)
+	}
+
+	return k.RefundEscrow(ctx, escrow)
+}
+
+// IterateExpiredEscrows iterates through the locked escrows expiring at or before the given height
+func (k Keeper) IterateExpiredEscrows(
+	ctx sdk.Context,
+	height int64,
+	op func(escrow types.Escrow) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixEscrowQueue, types.EscrowQueueByHeightStoreKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.SplitEscrowQueueStoreKey(iterator.Key())
+
+		escrow, found := k.GetEscrow(ctx, id)
+		if !found {
+			continue
+		}
+
+		if stop := op(escrow); stop {
+			break
+		}
+	}
+}
+
+// GetEscrow returns the escrow of the given id
+func (k Keeper) GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EscrowStoreKey(id))
+	if bz == nil {
+		return types.Escrow{}, false
+	}
+
+	var escrow types.Escrow
+	k.cdc.MustUnmarshal(bz, &escrow)
+
+	return escrow, true
+}
+
+// GetEscrows returns all the escrows
+func (k Keeper) GetEscrows(ctx sdk.Context) []types.Escrow {
+	escrows := make([]types.Escrow, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEscrow)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.Escrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+
+		escrows = append(escrows, escrow)
+	}
+
+	return escrows
+}
+
+// InitEscrows initializes the escrows from genesis
+func (k Keeper) InitEscrows(ctx sdk.Context, escrows []types.Escrow) {
+	var sequence uint64
+	for _, escrow := range escrows {
+		k.setEscrow(ctx, escrow)
+
+		if escrow.Id > sequence {
+			sequence = escrow.Id
+		}
+	}
+
+	k.setEscrowSequence(ctx, sequence)
+}
+
+// GetEscrowSequence returns the id of the latest escrow
+func (k Keeper) GetEscrowSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowSequenceStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setEscrowSequence sets the id of the latest escrow
+func (k Keeper) setEscrowSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EscrowSequenceStoreKey(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// setEscrow sets the escrow, queued by the expiry height while locked
+func (k Keeper) setEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.EscrowStoreKey(escrow.Id), k.cdc.MustMarshal(&escrow))
+
+	queueKey := types.EscrowQueueStoreKey(escrow.ExpiryHeight, escrow.Id)
+	if escrow.Status == types.EscrowStatusLocked {
+		store.Set(queueKey, types.Placeholder)
+	} else {
+		store.Delete(queueKey)
+	}
+}
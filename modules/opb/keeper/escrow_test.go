@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+var (
+	preimage = hex.EncodeToString([]byte("secret"))
+	hashLock = func() string {
+		hash := sha256.Sum256([]byte("secret"))
+		return hex.EncodeToString(hash[:])
+	}()
+)
+
+func (s *KeeperTestSuite) TestCreateEscrow() {
+	escrowAcc := s.app.AccountKeeper.GetModuleAddress(types.EscrowAccountName)
+	amount := sdk.NewInt64Coin(baseDenom, 300)
+
+	_, err := s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, sdk.NewInt64Coin(pointDenom, 300), 10, "")
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 1, "")
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+
+	_, err = s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, sdk.NewInt64Coin(baseDenom, 1001), 10, "")
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	escrow, err := s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 10, hashLock)
+	s.Require().NoErrorf(err, "failed to create escrow")
+	s.Require().Equal(uint64(1), escrow.Id)
+	s.Require().Equal(types.EscrowStatusLocked, escrow.Status)
+
+	s.Require().Equal(int64(700), s.balance(accAlice, baseDenom).Int64())
+	s.Require().Equal(int64(300), s.balance(escrowAcc, baseDenom).Int64())
+}
+
+func (s *KeeperTestSuite) TestReleaseEscrow() {
+	amount := sdk.NewInt64Coin(baseDenom, 300)
+
+	escrow, err := s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 10, hashLock)
+	s.Require().NoErrorf(err, "failed to create escrow")
+
+	_, err = s.keeper.ReleaseEscrow(s.ctx, escrow.Id+1, preimage, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnknownEscrow)
+
+	_, err = s.keeper.ReleaseEscrow(s.ctx, escrow.Id, hex.EncodeToString([]byte("guess")), accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.keeper.ReleaseEscrow(s.ctx.WithBlockHeight(11), escrow.Id, preimage, accAlice)
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+
+	// anyone revealing the preimage is allowed to release
+	escrow, err = s.keeper.ReleaseEscrow(s.ctx.WithBlockHeight(5), escrow.Id, preimage, accAlice)
+	s.Require().NoErrorf(err, "failed to release escrow")
+	s.Require().Equal(types.EscrowStatusReleased, escrow.Status)
+	s.Require().Equal(int64(5), escrow.ResolveHeight)
+	s.Require().Equal(int64(300), s.balance(accBob, baseDenom).Int64())
+
+	_, err = s.keeper.ReleaseEscrow(s.ctx, escrow.Id, preimage, accAlice)
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+
+	// the arbiter is allowed to release without the preimage
+	escrow, err = s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 10, hashLock)
+	s.Require().NoErrorf(err, "failed to create escrow")
+
+	escrow, err = s.keeper.ReleaseEscrow(s.ctx, escrow.Id, "", tokenManager)
+	s.Require().NoErrorf(err, "failed to release escrow")
+	s.Require().Equal(int64(600), s.balance(accBob, baseDenom).Int64())
+	s.Require().Empty(s.expiredEscrows(10))
+}
+
+func (s *KeeperTestSuite) TestRefundExpiredEscrows() {
+	escrowAcc := s.app.AccountKeeper.GetModuleAddress(types.EscrowAccountName)
+	amount := sdk.NewInt64Coin(baseDenom, 300)
+
+	escrow, err := s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 10, "")
+	s.Require().NoErrorf(err, "failed to create escrow")
+
+	s.Require().Empty(s.expiredEscrows(9))
+	s.Require().Len(s.expiredEscrows(10), 1)
+
+	opb.EndBlocker(s.ctx.WithBlockHeight(10), s.keeper)
+
+	escrow, _ = s.keeper.GetEscrow(s.ctx, escrow.Id)
+	s.Require().Equal(types.EscrowStatusRefunded, escrow.Status)
+	s.Require().Equal(int64(10), escrow.ResolveHeight)
+	s.Require().Equal(int64(1000), s.balance(accAlice, baseDenom).Int64())
+	s.Require().True(s.balance(escrowAcc, baseDenom).IsZero())
+	s.Require().Empty(s.expiredEscrows(10))
+}
+
+func (s *KeeperTestSuite) TestRefundExpiredEscrowsFailure() {
+	escrowAcc := s.app.AccountKeeper.GetModuleAddress(types.EscrowAccountName)
+	amount := sdk.NewInt64Coin(baseDenom, 300)
+
+	escrow, err := s.keeper.CreateEscrow(s.ctx, accAlice, accBob, tokenManager, amount, 10, "")
+	s.Require().NoErrorf(err, "failed to create escrow")
+
+	// drain the escrow account to fail the refund
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToAccount(s.ctx, types.EscrowAccountName, accBob, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100))))
+
+	ctx := s.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	opb.EndBlocker(ctx, s.keeper)
+
+	// the failed refund is dequeued rather than retried in every block
+	escrow, _ = s.keeper.GetEscrow(s.ctx, escrow.Id)
+	s.Require().Equal(types.EscrowStatusRefundFailed, escrow.Status)
+	s.Require().Equal(int64(10), escrow.ResolveHeight)
+	s.Require().Empty(s.expiredEscrows(11))
+
+	s.Require().Equal(int64(700), s.balance(accAlice, baseDenom).Int64())
+	s.Require().Equal(int64(200), s.balance(escrowAcc, baseDenom).Int64())
+
+	events := ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeRefundEscrowFailed, events[len(events)-1].Type)
+
+	// the payer claims the refund once the escrow account is replenished
+	_, err = s.keeper.ClaimEscrowRefund(s.ctx, escrow.Id, accAlice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, accBob, types.EscrowAccountName, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100))))
+
+	_, err = s.keeper.ClaimEscrowRefund(s.ctx, escrow.Id+1, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnknownEscrow)
+
+	_, err = s.keeper.ClaimEscrowRefund(s.ctx, escrow.Id, accBob)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	escrow, err = s.keeper.ClaimEscrowRefund(s.ctx.WithBlockHeight(12), escrow.Id, accAlice)
+	s.Require().NoErrorf(err, "failed to claim escrow refund")
+	s.Require().Equal(types.EscrowStatusRefunded, escrow.Status)
+	s.Require().Equal(int64(12), escrow.ResolveHeight)
+	s.Require().Equal(int64(1000), s.balance(accAlice, baseDenom).Int64())
+	s.Require().True(s.balance(escrowAcc, baseDenom).IsZero())
+
+	_, err = s.keeper.ClaimEscrowRefund(s.ctx, escrow.Id, accAlice)
+	s.Require().ErrorIs(err, types.ErrInvalidEscrow)
+}
+
+// expiredEscrows returns the locked escrows expiring at or before the given height
+func (s *KeeperTestSuite) expiredEscrows(height int64) []types.Escrow {
+	var escrows []types.Escrow
+	s.keeper.IterateExpiredEscrows(s.ctx, height, func(escrow types.Escrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+
+	return escrows
+}
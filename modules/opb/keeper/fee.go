@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// PointTokenFeeDecorator accepts the fee paid in the point token at the point token fee rate,
+// which is deducted to the point token fee collector to be reclaimed by the point token owner.
+// The txs with the fee not solely in the point token are handled by the given fallback decorators,
+// i.e. the default mempool fee and deduct fee decorators, which shall be replaced by this decorator
+type PointTokenFeeDecorator struct {
+	keeper         Keeper
+	feegrantKeeper types.FeegrantKeeper
+	fallbacks      []sdk.AnteDecorator
+}
+
+// NewPointTokenFeeDecorator constructs a new PointTokenFeeDecorator instance
+func NewPointTokenFeeDecorator(
+	keeper Keeper,
+	feegrantKeeper types.FeegrantKeeper,
+	fallbacks ...sdk.AnteDecorator,
+) PointTokenFeeDecorator {
+	return PointTokenFeeDecorator{
+		keeper:         keeper,
+		feegrantKeeper: feegrantKeeper,
+		fallbacks:      fallbacks,
+	}
+}
+
+// AnteHandle implements AnteHandler
+func (pfd PointTokenFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	rate := pfd.keeper.PointTokenFeeRate(ctx)
+
+	if !rate.IsPositive() || len(fee) != 1 || fee[0].Denom != pfd.keeper.PointTokenDenom(ctx) {
+		return chainAnteDecorators(ctx, tx, simulate, pfd.fallbacks, next)
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		if err := pfd.checkMinGasPrices(ctx, fee[0], feeTx.GetGas(), rate); err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := pfd.deductFee(ctx, feeTx, fee); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMinGasPrices ensures that the point token fee meets the min gas prices of the validator,
+// where the required point token fee is ceil(minGasPrice * gasLimit * rate) for each min gas price
+func (pfd PointTokenFeeDecorator) checkMinGasPrices(ctx sdk.Context, fee sdk.Coin, gas uint64, rate sdk.Dec) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	glDec := sdk.NewDec(int64(gas))
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewCoin(fee.Denom, gp.Amount.Mul(glDec).Mul(rate).Ceil().RoundInt())
+		if fee.Amount.GTE(requiredFees[i].Amount) {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required any of: %s", fee, requiredFees)
+}
+
+// deductFee deducts the point token fee from the fee payer or granter to the point token fee collector
+func (pfd PointTokenFeeDecorator) deductFee(ctx sdk.Context, feeTx sdk.FeeTx, fee sdk.Coins) error {
+	if !fee.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// deduct the fee from the fee granter if set
+	if feeGranter != nil {
+		if pfd.feegrantKeeper == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		} else if !feeGranter.Equals(feePayer) {
+			if err := pfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, feeTx.GetMsgs()); err != nil {
+				return sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feeGranter, feePayer)
+			}
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	if acc := pfd.keeper.accountKeeper.GetAccount(ctx, deductFeesFrom); acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if err := pfd.keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, deductFeesFrom, types.PointTokenFeeCollectorName, fee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return nil
+}
+
+// chainAnteDecorators runs the given decorators in order before the next AnteHandler
+func chainAnteDecorators(ctx sdk.Context, tx sdk.Tx, simulate bool, decorators []sdk.AnteDecorator, next sdk.AnteHandler) (sdk.Context, error) {
+	if len(decorators) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	return decorators[0].AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return chainAnteDecorators(ctx, tx, simulate, decorators[1:], next)
+	})
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/keeper"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+func (s *KeeperTestSuite) TestPointTokenFeeDecorator() {
+	txConfig := simapp.MakeEncodingConfig().TxConfig
+	nextAnte := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	decorator := keeper.NewPointTokenFeeDecorator(
+		s.keeper,
+		s.app.FeeGrantKeeper,
+		ante.NewMempoolFeeDecorator(),
+		ante.NewDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+	)
+
+	newTx := func(fee sdk.Coin, gas uint64) sdk.Tx {
+		txBuilder := txConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(accAlice, accBob, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)))))
+		txBuilder.SetFeeAmount(sdk.NewCoins(fee))
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	pointFeeCollector := s.app.AccountKeeper.GetModuleAddress(types.PointTokenFeeCollectorName)
+
+	// the min gas price of 0.01 base token per gas
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, sdk.NewDecWithPrec(1, 2)))
+	checkCtx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices)
+
+	s.Run("conversion rounding", func() {
+		s.setParams(func(params *types.Params) {
+			params.PointTokenFeeRate = sdk.NewDecWithPrec(15, 1)
+		})
+
+		// the required point token fee is ceil(0.01 * 101 * 1.5) = 2
+		_, err := decorator.AnteHandle(checkCtx, newTx(sdk.NewInt64Coin(pointDenom, 1), 101), false, nextAnte)
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+		_, err = decorator.AnteHandle(checkCtx, newTx(sdk.NewInt64Coin(pointDenom, 2), 101), false, nextAnte)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(2), s.balance(pointFeeCollector, pointDenom).Int64())
+		s.Require().Equal(int64(998), s.balance(accAlice, pointDenom).Int64())
+	})
+
+	s.Run("insufficient point token balance", func() {
+		_, err := decorator.AnteHandle(s.ctx, newTx(sdk.NewInt64Coin(pointDenom, 1001), 101), false, nextAnte)
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	s.Run("fallback to base token fee", func() {
+		_, err := decorator.AnteHandle(checkCtx, newTx(sdk.NewInt64Coin(baseDenom, 1), 101), false, nextAnte)
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+		_, err = decorator.AnteHandle(checkCtx, newTx(sdk.NewInt64Coin(baseDenom, 2), 101), false, nextAnte)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(2), s.balance(feeCollector, baseDenom).Int64())
+		s.Require().True(s.balance(pointFeeCollector, baseDenom).IsZero())
+	})
+
+	s.Run("zero rate", func() {
+		s.setParams(func(params *types.Params) {
+			params.PointTokenFeeRate = sdk.ZeroDec()
+		})
+
+		// the point token fee is not accepted for the min gas prices
+		_, err := decorator.AnteHandle(checkCtx, newTx(sdk.NewInt64Coin(pointDenom, 1000), 101), false, nextAnte)
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+		// but deducted to the fee collector as any other fee otherwise
+		collected := s.balance(pointFeeCollector, pointDenom)
+
+		_, err = decorator.AnteHandle(s.ctx, newTx(sdk.NewInt64Coin(pointDenom, 10), 101), false, nextAnte)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(10), s.balance(feeCollector, pointDenom).Int64())
+		s.Require().Equal(collected, s.balance(pointFeeCollector, pointDenom))
+	})
+}
@@ -1,9 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/aadhi0612/iritamod/modules/opb/types"
 )
@@ -16,3 +23,523 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+func (k Keeper) MintAllowance(c context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	minter, err := sdk.AccAddressFromBech32(req.Minter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid minter %s: %s", req.Minter, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowance := types.NewMintAllowance(minter, k.GetMintAllowance(ctx, minter))
+
+	return &types.QueryMintAllowanceResponse{Allowance: allowance}, nil
+}
+
+func (k Keeper) MintRecords(c context.Context, req *types.QueryMintRecordsRequest) (*types.QueryMintRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records := make([]types.MintRecord, 0)
+
+	var pageRes *query.PageResponse
+	var err error
+
+	if len(req.Minter) > 0 {
+		minter, err := sdk.AccAddressFromBech32(req.Minter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid minter %s: %s", req.Minter, err)
+		}
+
+		pageRes, err = query.Paginate(k.getMintRecordOfMinterStore(ctx, minter), req.Pagination, func(key []byte, _ []byte) error {
+			record, found := k.GetMintRecord(ctx, sdk.BigEndianToUint64(key))
+			if found {
+				records = append(records, record)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+
+		pageRes, err = query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+			var record types.MintRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return err
+			}
+			records = append(records, record)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.QueryMintRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) MintSupply(c context.Context, req *types.QueryMintSupplyRequest) (*types.QueryMintSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMintSupplyResponse{
+		TotalMinted: k.GetTotalMinted(ctx),
+		EpochMint:   k.GetEpochMint(ctx),
+	}, nil
+}
+
+func (k Keeper) Redemption(c context.Context, req *types.QueryRedemptionRequest) (*types.QueryRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	redemption, found := k.GetRedemption(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownRedemption, "redemption %d does not exist", req.Id)
+	}
+
+	return &types.QueryRedemptionResponse{Redemption: redemption}, nil
+}
+
+func (k Keeper) Redemptions(c context.Context, req *types.QueryRedemptionsRequest) (*types.QueryRedemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var store prefix.Store
+	if len(req.Holder) > 0 {
+		holder, err := sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid holder %s: %s", req.Holder, err)
+		}
+
+		store = k.getRedemptionOfHolderStore(ctx, holder)
+	} else {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemption)
+	}
+
+	redemptions := make([]types.Redemption, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// both stores are keyed by the redemption id
+		redemption, found := k.GetRedemption(ctx, sdk.BigEndianToUint64(key))
+		if !found || (req.FilterStatus && redemption.Status != req.Status) {
+			return false, nil
+		}
+
+		if accumulate {
+			redemptions = append(redemptions, redemption)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedemptionsResponse{Redemptions: redemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ReclaimSchedule(c context.Context, req *types.QueryReclaimScheduleRequest) (*types.QueryReclaimScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetReclaimSchedule(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownSchedule, "reclaim schedule %d does not exist", req.Id)
+	}
+
+	return &types.QueryReclaimScheduleResponse{Schedule: schedule}, nil
+}
+
+func (k Keeper) ReclaimSchedules(c context.Context, req *types.QueryReclaimSchedulesRequest) (*types.QueryReclaimSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReclaimSchedule)
+
+	schedules := make([]types.ReclaimSchedule, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var schedule types.ReclaimSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReclaimSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TransferMode(c context.Context, req *types.QueryTransferModeRequest) (*types.QueryTransferModeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", req.Denom, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	mode, explicit := k.GetTransferMode(ctx, req.Denom)
+
+	return &types.QueryTransferModeResponse{Mode: mode, Explicit: explicit}, nil
+}
+
+func (k Keeper) TransferAllowlist(c context.Context, req *types.QueryTransferAllowlistRequest) (*types.QueryTransferAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", req.Denom, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addresses := make([]string, 0)
+	pageRes, err := query.Paginate(k.getTransferAllowlistStore(ctx, req.Denom), req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferAllowlistResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TransferLimit(c context.Context, req *types.QueryTransferLimitRequest) (*types.QueryTransferLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", req.Denom, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limit, found := k.GetTransferLimit(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no transfer limit for %s", req.Denom)
+	}
+
+	return &types.QueryTransferLimitResponse{Limit: limit}, nil
+}
+
+func (k Keeper) RemainingTransferAllowance(c context.Context, req *types.QueryRemainingTransferAllowanceRequest) (*types.QueryRemainingTransferAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", req.Denom, err)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryRemainingTransferAllowanceResponse{
+		Usage:           k.GetTransferUsage(ctx, req.Denom, addr),
+		RemainingVolume: sdk.ZeroInt(),
+	}
+
+	limit, found := k.GetTransferLimit(ctx, req.Denom)
+	if !found {
+		return res, nil
+	}
+
+	if limit.DailyVolumeLimit.IsPositive() {
+		res.VolumeLimited = true
+		if limit.DailyVolumeLimit.GT(res.Usage.Volume) {
+			res.RemainingVolume = limit.DailyVolumeLimit.Sub(res.Usage.Volume)
+		}
+	}
+
+	if limit.DailyCountLimit > 0 {
+		res.CountLimited = true
+		if limit.DailyCountLimit > res.Usage.Count {
+			res.RemainingCount = limit.DailyCountLimit - res.Usage.Count
+		}
+	}
+
+	return res, nil
+}
+
+func (k Keeper) MinterTotals(c context.Context, req *types.QueryMinterTotalsRequest) (*types.QueryMinterTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinterTotal)
+
+	totals := make([]types.MinterTotal, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		// key: <len><minter>
+		totals = append(totals, types.NewMinterTotal(sdk.AccAddress(key[1:]), sdk.BigEndianToUint64(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMinterTotalsResponse{Totals: totals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ReclaimTotals(c context.Context, req *types.QueryReclaimTotalsRequest) (*types.QueryReclaimTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixReclaimTotal)
+
+	totals := make([]types.ReclaimTotal, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return err
+		}
+		totals = append(totals, types.NewReclaimTotal(string(key), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReclaimTotalsResponse{Totals: totals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FeeCollectorBalances(c context.Context, req *types.QueryFeeCollectorBalancesRequest) (*types.QueryFeeCollectorBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	baseTokenBalance, pointTokenBalance := k.GetFeeCollectorBalances(ctx)
+
+	return &types.QueryFeeCollectorBalancesResponse{
+		BaseTokenBalance:  baseTokenBalance,
+		PointTokenBalance: pointTokenBalance,
+	}, nil
+}
+
+func (k Keeper) MintHistory(c context.Context, req *types.QueryMintHistoryRequest) (*types.QueryMintHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateHeightRange(req.StartHeight, req.EndHeight); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records := make([]types.MintRecord, 0)
+	pageRes, err := paginateHeightRange(k.getMintRecordByHeightStore(ctx), req.StartHeight, req.EndHeight, req.Pagination, func(id uint64) {
+		if record, found := k.GetMintRecord(ctx, id); found {
+			records = append(records, record)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryMintHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ReclaimHistory(c context.Context, req *types.QueryReclaimHistoryRequest) (*types.QueryReclaimHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateHeightRange(req.StartHeight, req.EndHeight); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records := make([]types.ReclaimRecord, 0)
+	pageRes, err := paginateHeightRange(k.getReclaimRecordByHeightStore(ctx), req.StartHeight, req.EndHeight, req.Pagination, func(id uint64) {
+		if record, found := k.GetReclaimRecord(ctx, id); found {
+			records = append(records, record)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReclaimHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// validateHeightRange validates the height range, where the end height is unbounded if zero
+func validateHeightRange(startHeight, endHeight int64) error {
+	if startHeight < 0 || endHeight < 0 {
+		return status.Error(codes.InvalidArgument, "height can not be negative")
+	}
+
+	if endHeight > 0 && endHeight < startHeight {
+		return status.Errorf(codes.InvalidArgument, "end height %d is less than start height %d", endHeight, startHeight)
+	}
+
+	return nil
+}
+
+// paginateHeightRange paginates the record ids of the index by height within the given range, iterating
+// only over the big-endian height keys from the start height to the end height inclusive
+func paginateHeightRange(
+	store prefix.Store,
+	startHeight, endHeight int64,
+	pageRequest *query.PageRequest,
+	onResult func(id uint64),
+) (*query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	key := pageRequest.Key
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+
+	if offset > 0 && key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	start := sdk.Uint64ToBigEndian(uint64(startHeight))
+
+	// the end height is unbounded if zero
+	var end []byte
+	if endHeight > 0 {
+		end = sdk.Uint64ToBigEndian(uint64(endHeight + 1))
+	}
+
+	// resume from the next key, which must be within the range
+	if key != nil {
+		if bytes.Compare(key, start) < 0 || (end != nil && bytes.Compare(key, end) >= 0) {
+			return nil, status.Error(codes.InvalidArgument, "invalid request, key is out of the height range")
+		}
+
+		if pageRequest.Reverse {
+			end = append(append([]byte{}, key...), 0x00)
+		} else {
+			start = key
+		}
+	}
+
+	var iterator sdk.Iterator
+	if pageRequest.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+
+		if count <= offset {
+			continue
+		}
+
+		if count <= offset+limit {
+			_, id := types.SplitRecordByHeightKey(iterator.Key())
+			onResult(id)
+		} else if count == offset+limit+1 {
+			nextKey = iterator.Key()
+
+			if !countTotal || key != nil {
+				break
+			}
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal && key == nil {
+		res.Total = count
+	}
+
+	return res, nil
+}
+
+func (k Keeper) Escrow(c context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	escrow, found := k.GetEscrow(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownEscrow, "escrow %d does not exist", req.Id)
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+func (k Keeper) Escrows(c context.Context, req *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEscrow)
+
+	escrows := make([]types.Escrow, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var escrow types.Escrow
+		if err := k.cdc.Unmarshal(value, &escrow); err != nil {
+			return false, err
+		}
+
+		if req.FilterStatus && escrow.Status != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			escrows = append(escrows, escrow)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowsResponse{Escrows: escrows, Pagination: pageRes}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+)
+
+var _ ibctransfertypes.MsgServer = TransferLimitIBCMsgServer{}
+
+// TransferLimitIBCMsgServer wraps the msg server of the IBC transfer module to charge the transfer limits
+// of the sender upon the execution of MsgTransfer, so that the limits are discarded along with the failed msgs.
+// NOTE: the IBC transfer keeper is not handed a TransferLimitBankKeeper instead, as it also sends the coins
+// from the channel escrow addresses upon receiving and refunding, which must not be limited
+type TransferLimitIBCMsgServer struct {
+	ibctransfertypes.MsgServer
+
+	keeper *Keeper
+}
+
+// NewTransferLimitIBCMsgServer constructs a new TransferLimitIBCMsgServer instance
+func NewTransferLimitIBCMsgServer(msgServer ibctransfertypes.MsgServer, keeper *Keeper) TransferLimitIBCMsgServer {
+	return TransferLimitIBCMsgServer{
+		MsgServer: msgServer,
+		keeper:    keeper,
+	}
+}
+
+// Transfer charges the transfer limits of the sender before transferring the token
+func (m TransferLimitIBCMsgServer) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.ConsumeTransferLimit(sdk.UnwrapSDKContext(goCtx), sender, msg.Token); err != nil {
+		return nil, err
+	}
+
+	return m.MsgServer.Transfer(goCtx, msg)
+}
@@ -10,7 +10,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/aadhi0612/iritamod/modules/opb/types"
 )
@@ -25,7 +24,8 @@ type Keeper struct {
 	tokenKeeper   types.TokenKeeper
 	permKeeper    types.PermKeeper
 
-	paramSpace paramstypes.Subspace
+	// legacyParamsKey is the store key of the legacy param store, only used by the store migrations
+	legacyParamsKey sdk.StoreKey
 }
 
 // NewKeeper creates a new Keeper instance
@@ -36,25 +36,29 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	tokenKeeper types.TokenKeeper,
 	permKeeper types.PermKeeper,
-	paramSpace paramstypes.Subspace,
+	legacyParamsKey sdk.StoreKey,
 ) Keeper {
-	// ensure the OPB module account is set
+	// ensure the OPB module accounts are set
 	if addr := accountKeeper.GetModuleAddress(types.PointTokenFeeCollectorName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.PointTokenFeeCollectorName))
 	}
 
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
+	if addr := accountKeeper.GetModuleAddress(types.RedemptionEscrowName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.RedemptionEscrowName))
+	}
+
+	if addr := accountKeeper.GetModuleAddress(types.EscrowAccountName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EscrowAccountName))
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		tokenKeeper:   tokenKeeper,
-		permKeeper:    permKeeper,
-		paramSpace:    paramSpace,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		tokenKeeper:     tokenKeeper,
+		permKeeper:      permKeeper,
+		legacyParamsKey: legacyParamsKey,
 	}
 }
 
@@ -63,68 +67,143 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("iritamod/%s", types.ModuleName))
 }
 
-// Mint mints the base native token by the specified amount
+// Mint mints the base native token by the specified amount, within the mint allowance of the operator,
+// the mint cap of the current epoch and the max supply
 // NOTE: the operator must possess the BaseM1Admin or RootAdmin permission
-func (k Keeper) Mint(ctx sdk.Context, amount uint64, recipient, operator sdk.AccAddress) error {
+func (k Keeper) Mint(ctx sdk.Context, amount uint64, recipient, operator sdk.AccAddress) (types.MintRecord, error) {
 	// get the base token denom
 	baseTokenDenom := k.BaseTokenDenom(ctx)
 
 	if !k.hasBaseM1Perm(ctx, operator) {
-		return sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to mint %s", operator, baseTokenDenom)
+		return types.MintRecord{}, sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to mint %s", operator, baseTokenDenom)
 	}
 
 	// get the base token
 	baseToken, err := k.tokenKeeper.GetToken(ctx, baseTokenDenom)
 	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidDenom, "token for %s does not exist", baseTokenDenom)
+		return types.MintRecord{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "token for %s does not exist", baseTokenDenom)
+	}
+
+	remainingAllowance, err := k.consumeMintQuota(ctx, operator, amount)
+	if err != nil {
+		return types.MintRecord{}, err
 	}
 
 	// NOTE: empty owner
 	owner := sdk.AccAddress{}
 
-	return k.tokenKeeper.MintToken(ctx, baseToken.GetSymbol(), amount, recipient, owner)
+	if err := k.tokenKeeper.MintToken(ctx, baseToken.GetSymbol(), amount, recipient, owner); err != nil {
+		return types.MintRecord{}, err
+	}
+
+	return k.addMintRecord(ctx, operator, recipient, amount, remainingAllowance), nil
 }
 
-// Reclaim reclaims the native token of the specified denom from the corresponding escrow account
+// Reclaim reclaims the native token of the specified denom from the corresponding escrow account,
+// distributing the given amount, zero for the whole balance, to the splits by weight
 // NOTE: the operator must possess the certain permission
-func (k Keeper) Reclaim(ctx sdk.Context, denom string, recipient, operator sdk.AccAddress) error {
+func (k Keeper) Reclaim(
+	ctx sdk.Context,
+	denom string,
+	amount sdk.Int,
+	splits []types.ReclaimSplit,
+	operator sdk.AccAddress,
+) ([]sdk.Coin, error) {
+	moduleAccName, err := k.authorizeReclaim(ctx, denom, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.reclaim(ctx, moduleAccName, denom, amount, splits, operator)
+}
+
+// authorizeReclaim checks if the operator is allowed to reclaim the denom
+// and returns the name of the corresponding escrow module account
+func (k Keeper) authorizeReclaim(ctx sdk.Context, denom string, operator sdk.AccAddress) (string, error) {
 	baseTokenDenom := k.BaseTokenDenom(ctx)
 	pointTokenDenom := k.PointTokenDenom(ctx)
 
-	var moduleAccName string
-
 	switch denom {
 	case baseTokenDenom, "ugas":
 		if !k.hasBaseM1Perm(ctx, operator) {
-			return sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to reclaim %s", operator, denom)
+			return "", sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to reclaim %s", operator, denom)
 		}
 
-		moduleAccName = authtypes.FeeCollectorName
+		return authtypes.FeeCollectorName, nil
 
 	case pointTokenDenom:
 		owner, err := k.tokenKeeper.GetOwner(ctx, denom)
 		if err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidDenom, "token for %s does not exist", denom)
+			return "", sdkerrors.Wrapf(types.ErrInvalidDenom, "token for %s does not exist", denom)
 		}
 
 		if !bytes.Equal(operator, owner) {
-			return sdkerrors.Wrapf(types.ErrUnauthorized, "only %s is allowed to reclaim %s", owner, denom)
+			return "", sdkerrors.Wrapf(types.ErrUnauthorized, "only %s is allowed to reclaim %s", owner, denom)
 		}
 
-		moduleAccName = types.PointTokenFeeCollectorName
+		return types.PointTokenFeeCollectorName, nil
 
 	default:
-		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denom must be either %s or %s", baseTokenDenom, pointTokenDenom)
+		return "", sdkerrors.Wrapf(types.ErrInvalidDenom, "denom must be either %s or %s", baseTokenDenom, pointTokenDenom)
 	}
+}
 
+// reclaim distributes the amount of the denom from the module account to the splits,
+// returning the reclaimed coin of each split, recording and emitting the reclaim events
+func (k Keeper) reclaim(
+	ctx sdk.Context,
+	moduleAccName string,
+	denom string,
+	amount sdk.Int,
+	splits []types.ReclaimSplit,
+	operator sdk.AccAddress,
+) ([]sdk.Coin, error) {
 	moduleAccAddr := k.accountKeeper.GetModuleAddress(moduleAccName)
 
 	balance := k.bankKeeper.GetBalance(ctx, moduleAccAddr, denom)
 	if balance.IsZero() {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "no balance for %s in the module account", denom)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "no balance for %s in the module account", denom)
+	}
+
+	if amount.IsNil() || amount.IsZero() {
+		amount = balance.Amount
+	}
+
+	if amount.GT(balance.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "insufficient balance for %s in the module account: %s < %s", denom, balance.Amount, amount)
+	}
+
+	shares := types.DistributeReclaim(amount, splits)
+	coins := make([]sdk.Coin, len(splits))
+
+	for i, split := range splits {
+		coins[i] = sdk.NewCoin(denom, shares[i])
+		if !shares[i].IsPositive() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(split.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleAccName, recipient, sdk.NewCoins(coins[i])); err != nil {
+			return nil, err
+		}
+
+		k.addReclaimRecord(ctx, operator, recipient, coins[i])
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReclaim,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyRecipient, split.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, coins[i].String()),
+			),
+		)
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleAccName, recipient, sdk.NewCoins(balance))
+	return coins, nil
 }
 
 // HasToken checks if the given token exists
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/keeper"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+	permkeeper "github.com/aadhi0612/iritamod/modules/perm/keeper"
+	permtypes "github.com/aadhi0612/iritamod/modules/perm/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+var (
+	rootAdmin    = sdk.AccAddress(tmhash.SumTruncated([]byte("rootAdmin")))
+	baseM1Admin  = sdk.AccAddress(tmhash.SumTruncated([]byte("base_m1_admin")))
+	tokenManager = sdk.AccAddress(tmhash.SumTruncated([]byte("token_manager")))
+	pointOwner   = sdk.AccAddress(tmhash.SumTruncated([]byte("point_owner")))
+	accAlice     = sdk.AccAddress(tmhash.SumTruncated([]byte("acc_alice")))
+	accBob       = sdk.AccAddress(tmhash.SumTruncated([]byte("acc_bob")))
+
+	baseDenom  = types.DefaultBaseTokenDenom
+	pointDenom = types.DefaultPointTokenDenom
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx        sdk.Context
+	app        *simapp.SimApp
+	keeper     keeper.Keeper
+	permKeeper permkeeper.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Note: After setting up, we have:
+// 1. baseM1Admin has the BaseM1Admin role
+// 2. tokenManager is the base token manager and pointOwner owns the point token
+// 3. accAlice holds 1000 of both the base and point tokens
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	s.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	s.app = app
+	s.keeper = app.OpbKeeper
+	s.permKeeper = app.PermKeeper
+
+	s.Require().NoError(s.permKeeper.Authorize(s.ctx, baseM1Admin, rootAdmin, permtypes.RoleBaseM1Admin))
+
+	params := s.keeper.GetParams(s.ctx)
+	params.BaseTokenManager = tokenManager.String()
+	s.keeper.SetParams(s.ctx, params)
+
+	s.app.TokenKeeper.AddToken(pointDenom, pointOwner)
+
+	s.fund(accAlice, sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin(pointDenom, 1000))
+}
+
+// fund mints the given coins to the address through the token keeper
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, coins ...sdk.Coin) {
+	for _, coin := range coins {
+		s.Require().NoError(s.app.TokenKeeper.MintToken(s.ctx, coin.Denom, coin.Amount.Uint64(), addr, nil))
+	}
+}
+
+// balance returns the balance of the denom held by the address
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress, denom string) sdk.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, denom).Amount
+}
+
+// setParams updates the params with the given function
+func (s *KeeperTestSuite) setParams(update func(params *types.Params)) {
+	params := s.keeper.GetParams(s.ctx)
+	update(&params)
+	s.keeper.SetParams(s.ctx, params)
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/aadhi0612/iritamod/modules/opb/migrations/v3"
 )
 
 type Migrator struct {
@@ -17,3 +19,8 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3, moving the params from the legacy param store to the module store.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.k.storeKey, m.k.legacyParamsKey, m.k.cdc)
+}
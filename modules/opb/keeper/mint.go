@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// SetMintAllowance sets the remaining amount of the base token the minter is allowed to mint
+// NOTE: the operator must possess the RootAdmin permission
+func (k Keeper) SetMintAllowance(ctx sdk.Context, minter sdk.AccAddress, amount uint64, operator sdk.AccAddress) error {
+	if !k.permKeeper.IsRootAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to set the mint allowance", operator)
+	}
+
+	k.setMintAllowance(ctx, minter, amount)
+
+	return nil
+}
+
+// InitMintState initializes the mint allowances, records and minted amounts from genesis
+func (k Keeper) InitMintState(
+	ctx sdk.Context,
+	allowances []types.MintAllowance,
+	records []types.MintRecord,
+	totalMinted uint64,
+	epochMint types.EpochMint,
+) {
+	for _, allowance := range allowances {
+		minter, _ := sdk.AccAddressFromBech32(allowance.Minter)
+		k.setMintAllowance(ctx, minter, allowance.Amount)
+	}
+
+	var sequence uint64
+	for _, record := range records {
+		k.setMintRecord(ctx, record)
+
+		if record.Id > sequence {
+			sequence = record.Id
+		}
+	}
+
+	k.setMintRecordSequence(ctx, sequence)
+	k.setTotalMinted(ctx, totalMinted)
+	k.setEpochMint(ctx, epochMint)
+}
+
+// GetMintAllowance returns the remaining amount of the base token the minter is allowed to mint
+func (k Keeper) GetMintAllowance(ctx sdk.Context, minter sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.MintAllowanceStoreKey(minter))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetMintAllowances returns all the mint allowances
+func (k Keeper) GetMintAllowances(ctx sdk.Context) []types.MintAllowance {
+	allowances := make([]types.MintAllowance, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMintAllowance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key: <0x01><len><minter>
+		minter := sdk.AccAddress(iterator.Key()[len(types.KeyPrefixMintAllowance)+1:])
+		allowances = append(allowances, types.NewMintAllowance(minter, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return allowances
+}
+
+// setMintAllowance sets the mint allowance, deleting it when exhausted
+func (k Keeper) setMintAllowance(ctx sdk.Context, minter sdk.AccAddress, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.MintAllowanceStoreKey(minter)
+	if amount == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(amount))
+}
+
+// GetMintRecord returns the mint record of the given id
+func (k Keeper) GetMintRecord(ctx sdk.Context, id uint64) (types.MintRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.MintRecordStoreKey(id))
+	if bz == nil {
+		return types.MintRecord{}, false
+	}
+
+	var record types.MintRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// GetMintRecords returns all the mint records
+func (k Keeper) GetMintRecords(ctx sdk.Context) []types.MintRecord {
+	records := make([]types.MintRecord, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMintRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// setMintRecord sets the mint record along with the indexes of the minter and height
+func (k Keeper) setMintRecord(ctx sdk.Context, record types.MintRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	minter, _ := sdk.AccAddressFromBech32(record.Minter)
+
+	store.Set(types.MintRecordStoreKey(record.Id), k.cdc.MustMarshal(&record))
+	store.Set(types.MintRecordOfMinterStoreKey(minter, record.Id), types.Placeholder)
+	store.Set(types.MintRecordByHeightStoreKey(record.Height, record.Id), types.Placeholder)
+}
+
+// GetMintRecordSequence returns the id of the latest mint record
+func (k Keeper) GetMintRecordSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintRecordSequenceStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setMintRecordSequence sets the id of the latest mint record
+func (k Keeper) setMintRecordSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintRecordSequenceStoreKey(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// GetTotalMinted returns the total amount of the base token minted through the module
+func (k Keeper) GetTotalMinted(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalMintedStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setTotalMinted sets the total amount of the base token minted through the module
+func (k Keeper) setTotalMinted(ctx sdk.Context, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TotalMintedStoreKey(), sdk.Uint64ToBigEndian(amount))
+}
+
+// GetEpochMint returns the amount minted in the current mint epoch.
+// The epoch is 0 if the mint epoch blocks are not set, e.g. before the params are migrated
+func (k Keeper) GetEpochMint(ctx sdk.Context) types.EpochMint {
+	var epoch uint64
+	if epochBlocks := k.MintEpochBlocks(ctx); epochBlocks > 0 {
+		epoch = uint64(ctx.BlockHeight()) / epochBlocks
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochMintStoreKey())
+	if bz == nil {
+		return types.EpochMint{Epoch: epoch}
+	}
+
+	var epochMint types.EpochMint
+	k.cdc.MustUnmarshal(bz, &epochMint)
+
+	// the amount is reset once the epoch elapses
+	if epochMint.Epoch != epoch {
+		return types.EpochMint{Epoch: epoch}
+	}
+
+	return epochMint
+}
+
+// setEpochMint sets the amount minted in the mint epoch
+func (k Keeper) setEpochMint(ctx sdk.Context, epochMint types.EpochMint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochMintStoreKey(), k.cdc.MustMarshal(&epochMint))
+}
+
+// getMintRecordOfMinterStore returns the mint record index store of the given minter
+func (k Keeper) getMintRecordOfMinterStore(ctx sdk.Context, minter sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.MintRecordOfMinterPrefixKey(minter))
+}
+
+// consumeMintQuota deducts the amount from the allowance of the minter and accumulates it
+// to the epoch and the total minted amount, returning the remaining allowance of the minter.
+// The max supply caps the supply of the base token in the bank, including the tokens not minted by the module
+func (k Keeper) consumeMintQuota(ctx sdk.Context, minter sdk.AccAddress, amount uint64) (uint64, error) {
+	if k.MintEpochBlocks(ctx) == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidParams, "mint epoch blocks must be greater than 0")
+	}
+
+	allowance := k.GetMintAllowance(ctx, minter)
+	if amount > allowance {
+		return 0, sdkerrors.Wrapf(types.ErrMintLimit, "amount %d exceeds the remaining mint allowance %d of %s", amount, allowance, minter)
+	}
+
+	epochMint := k.GetEpochMint(ctx)
+	if epochCap := k.MintEpochCap(ctx); epochCap > 0 {
+		if epochMint.Amount > epochCap || amount > epochCap-epochMint.Amount {
+			return 0, sdkerrors.Wrapf(types.ErrMintLimit, "amount %d exceeds the mint cap %d of epoch %d, %d already minted", amount, epochCap, epochMint.Epoch, epochMint.Amount)
+		}
+	}
+
+	if maxSupply := k.MaxSupply(ctx); maxSupply > 0 {
+		supply := k.bankKeeper.GetSupply(ctx, k.BaseTokenDenom(ctx)).Amount
+		if supply.Add(sdk.NewIntFromUint64(amount)).GT(sdk.NewIntFromUint64(maxSupply)) {
+			return 0, sdkerrors.Wrapf(types.ErrMintLimit, "amount %d exceeds the max supply %d, current supply %s", amount, maxSupply, supply)
+		}
+	}
+
+	totalMinted := k.GetTotalMinted(ctx)
+	if amount > math.MaxUint64-totalMinted || amount > math.MaxUint64-epochMint.Amount {
+		return 0, sdkerrors.Wrapf(types.ErrMintLimit, "amount %d overflows the minted amount", amount)
+	}
+
+	epochMint.Amount += amount
+
+	k.setMintAllowance(ctx, minter, allowance-amount)
+	k.setEpochMint(ctx, epochMint)
+	k.setTotalMinted(ctx, totalMinted+amount)
+
+	return allowance - amount, nil
+}
+
+// addMintRecord records the minting with the next sequence and accumulates it to the minter total
+func (k Keeper) addMintRecord(
+	ctx sdk.Context,
+	minter sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount uint64,
+	remainingAllowance uint64,
+) types.MintRecord {
+	id := k.GetMintRecordSequence(ctx) + 1
+
+	record := types.NewMintRecord(id, minter, recipient, amount, ctx.BlockHeight(), remainingAllowance)
+
+	k.setMintRecord(ctx, record)
+	k.setMintRecordSequence(ctx, id)
+	k.addMinterTotal(ctx, minter, amount)
+
+	return record
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+func (s *KeeperTestSuite) TestMint() {
+	_, err := s.keeper.Mint(s.ctx, 100, accBob, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the minter is not allowed to mint without the allowance
+	_, err = s.keeper.Mint(s.ctx, 100, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrMintLimit)
+
+	err = s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 1000, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	err = s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 1000, rootAdmin)
+	s.Require().NoErrorf(err, "failed to set mint allowance")
+
+	record, err := s.keeper.Mint(s.ctx, 400, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+	s.Require().Equal(uint64(1), record.Id)
+	s.Require().Equal(uint64(600), record.RemainingAllowance)
+
+	s.Require().Equal(int64(400), s.balance(accBob, baseDenom).Int64())
+	s.Require().Equal(uint64(600), s.keeper.GetMintAllowance(s.ctx, baseM1Admin))
+	s.Require().Equal(uint64(400), s.keeper.GetTotalMinted(s.ctx))
+	s.Require().Equal(uint64(400), s.keeper.GetMinterTotal(s.ctx, baseM1Admin))
+	s.Require().Equal(uint64(400), s.keeper.GetEpochMint(s.ctx).Amount)
+
+	_, err = s.keeper.Mint(s.ctx, 700, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrMintLimit)
+
+	// the exhausted allowance is removed
+	_, err = s.keeper.Mint(s.ctx, 600, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+	s.Require().Empty(s.keeper.GetMintAllowances(s.ctx))
+	s.Require().Len(s.keeper.GetMintRecords(s.ctx), 2)
+}
+
+func (s *KeeperTestSuite) TestMintEpochCap() {
+	s.setParams(func(params *types.Params) {
+		params.MintEpochBlocks = 10
+		params.MintEpochCap = 500
+	})
+
+	err := s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 2000, rootAdmin)
+	s.Require().NoErrorf(err, "failed to set mint allowance")
+
+	_, err = s.keeper.Mint(s.ctx, 400, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+
+	_, err = s.keeper.Mint(s.ctx, 200, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrMintLimit)
+
+	// the epoch mint is reset in the next epoch
+	s.ctx = s.ctx.WithBlockHeight(10)
+	s.Require().Equal(uint64(0), s.keeper.GetEpochMint(s.ctx).Amount)
+	s.Require().Equal(uint64(1), s.keeper.GetEpochMint(s.ctx).Epoch)
+
+	_, err = s.keeper.Mint(s.ctx, 500, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+	s.Require().Equal(uint64(900), s.keeper.GetTotalMinted(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestMintMaxSupply() {
+	err := s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 2000, rootAdmin)
+	s.Require().NoErrorf(err, "failed to set mint allowance")
+
+	// the supply includes the tokens not minted through the module
+	supply := s.app.BankKeeper.GetSupply(s.ctx, baseDenom).Amount
+	s.Require().Equal(int64(1000), supply.Int64())
+
+	s.setParams(func(params *types.Params) {
+		params.MaxSupply = 1500
+	})
+
+	_, err = s.keeper.Mint(s.ctx, 501, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrMintLimit)
+
+	_, err = s.keeper.Mint(s.ctx, 500, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+
+	_, err = s.keeper.Mint(s.ctx, 1, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrMintLimit)
+
+	// the burned tokens free up the supply
+	_, err = s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(baseDenom, 100), "ref", accBob)
+	s.Require().NoErrorf(err, "failed to redeem")
+	_, err = s.keeper.SettleRedemption(s.ctx, 1, tokenManager)
+	s.Require().NoErrorf(err, "failed to settle redemption")
+
+	_, err = s.keeper.Mint(s.ctx, 100, accBob, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to mint")
+}
+
+func (s *KeeperTestSuite) TestMintWithoutEpochBlocks() {
+	err := s.keeper.SetMintAllowance(s.ctx, baseM1Admin, 1000, rootAdmin)
+	s.Require().NoErrorf(err, "failed to set mint allowance")
+
+	// the params are unset before the store migrations
+	s.keeper.SetParams(s.ctx, types.Params{BaseTokenDenom: baseDenom})
+	s.Require().Equal(types.EpochMint{}, s.keeper.GetEpochMint(s.ctx))
+
+	_, err = s.keeper.Mint(s.ctx, 100, accBob, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrInvalidParams)
+	s.Require().Equal(uint64(1000), s.keeper.GetMintAllowance(s.ctx, baseM1Admin))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -34,7 +35,8 @@ func (m msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := m.Keeper.Mint(ctx, msg.Amount, recipient, operator); err != nil {
+	record, err := m.Keeper.Mint(ctx, msg.Amount, recipient, operator)
+	if err != nil {
 		return nil, err
 	}
 
@@ -43,6 +45,9 @@ func (m msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 			types.EventTypeMint,
 			sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprintf("%v", msg.Amount)),
 			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyMinter, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyRecordID, fmt.Sprintf("%d", record.Id)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", record.RemainingAllowance)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -60,22 +65,225 @@ func (m msgServer) Reclaim(goCtx context.Context, msg *types.MsgReclaim) (*types
 		return nil, err
 	}
 
-	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := m.Keeper.Reclaim(ctx, msg.Denom, msg.Amount, msg.GetReclaimSplits(), operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	)
+
+	return &types.MsgReclaimResponse{}, nil
+}
+
+func (m msgServer) SetMintAllowance(goCtx context.Context, msg *types.MsgSetMintAllowance) (*types.MsgSetMintAllowanceResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetMintAllowance(ctx, minter, msg.Amount, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetMintAllowance,
+			sdk.NewAttribute(types.AttributeKeyMinter, msg.Minter),
+			sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprintf("%d", msg.Amount)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetMintAllowanceResponse{}, nil
+}
+
+func (m msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.MsgRedeemResponse, error) {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := m.Keeper.Redeem(ctx, msg.Amount, msg.SettlementRef, holder)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeem,
+			sdk.NewAttribute(types.AttributeKeyRedemptionID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyHolder, msg.Holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySettlementRef, msg.SettlementRef),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Holder),
+		),
+	})
+
+	return &types.MsgRedeemResponse{Id: id}, nil
+}
+
+func (m msgServer) SettleRedemption(goCtx context.Context, msg *types.MsgSettleRedemption) (*types.MsgSettleRedemptionResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := m.Keeper.SettleRedemption(ctx, msg.Id, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSettleRedemption,
+			sdk.NewAttribute(types.AttributeKeyRedemptionID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyHolder, redemption.Holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, redemption.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySettlementRef, redemption.SettlementRef),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgSettleRedemptionResponse{}, nil
+}
+
+func (m msgServer) RejectRedemption(goCtx context.Context, msg *types.MsgRejectRedemption) (*types.MsgRejectRedemptionResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := m.Keeper.RejectRedemption(ctx, msg.Id, msg.Reason, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRejectRedemption,
+			sdk.NewAttribute(types.AttributeKeyRedemptionID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyHolder, redemption.Holder),
+			sdk.NewAttribute(types.AttributeKeyAmount, redemption.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySettlementRef, redemption.SettlementRef),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgRejectRedemptionResponse{}, nil
+}
+
+func (m msgServer) CreateReclaimSchedule(goCtx context.Context, msg *types.MsgCreateReclaimSchedule) (*types.MsgCreateReclaimScheduleResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	schedule, err := m.Keeper.CreateReclaimSchedule(ctx, msg.Denom, msg.Amount, msg.Splits, msg.Interval, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateReclaimSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(types.AttributeKeyDenom, schedule.Denom),
+			sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", schedule.Interval)),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgCreateReclaimScheduleResponse{Id: schedule.Id}, nil
+}
+
+func (m msgServer) DeleteReclaimSchedule(goCtx context.Context, msg *types.MsgDeleteReclaimSchedule) (*types.MsgDeleteReclaimScheduleResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.DeleteReclaimSchedule(ctx, msg.Id, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteReclaimSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", msg.Id)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgDeleteReclaimScheduleResponse{}, nil
+}
+
+func (m msgServer) SetTransferMode(goCtx context.Context, msg *types.MsgSetTransferMode) (*types.MsgSetTransferModeResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
 	if err != nil {
 		return nil, err
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := m.Keeper.Reclaim(ctx, msg.Denom, recipient, operator); err != nil {
+	if err := m.Keeper.SetTransferMode(ctx, msg.Denom, msg.Mode, operator); err != nil {
 		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeReclaim,
+			types.EventTypeSetTransferMode,
 			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
-			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyTransferMode, msg.Mode.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -84,5 +292,216 @@ func (m msgServer) Reclaim(goCtx context.Context, msg *types.MsgReclaim) (*types
 		),
 	})
 
-	return &types.MsgReclaimResponse{}, nil
+	return &types.MsgSetTransferModeResponse{}, nil
+}
+
+func (m msgServer) UpdateTransferAllowlist(goCtx context.Context, msg *types.MsgUpdateTransferAllowlist) (*types.MsgUpdateTransferAllowlistResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	add, err := parseAddresses(msg.Add)
+	if err != nil {
+		return nil, err
+	}
+
+	remove, err := parseAddresses(msg.Remove)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateTransferAllowlist(ctx, msg.Denom, add, remove, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateTransferAllowlist,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdded, strings.Join(msg.Add, ",")),
+			sdk.NewAttribute(types.AttributeKeyRemoved, strings.Join(msg.Remove, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUpdateTransferAllowlistResponse{}, nil
+}
+
+// parseAddresses parses the given bech32 addresses
+func parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, len(addresses))
+	for i, address := range addresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+
+	return addrs, nil
+}
+
+func (m msgServer) SetTransferLimit(goCtx context.Context, msg *types.MsgSetTransferLimit) (*types.MsgSetTransferLimitResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.SetTransferLimit(ctx, msg.GetTransferLimit(), operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTransferLimit,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetTransferLimitResponse{}, nil
+}
+
+func (m msgServer) CreateEscrow(goCtx context.Context, msg *types.MsgCreateEscrow) (*types.MsgCreateEscrowResponse, error) {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		return nil, err
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(msg.Beneficiary)
+	if err != nil {
+		return nil, err
+	}
+
+	arbiter, err := sdk.AccAddressFromBech32(msg.Arbiter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, err := m.Keeper.CreateEscrow(ctx, payer, beneficiary, arbiter, msg.Amount, msg.ExpiryHeight, msg.HashLock)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", escrow.Id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, msg.Payer),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, msg.Beneficiary),
+			sdk.NewAttribute(types.AttributeKeyArbiter, msg.Arbiter),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", msg.ExpiryHeight)),
+			sdk.NewAttribute(types.AttributeKeyHashLock, msg.HashLock),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer),
+		),
+	})
+
+	return &types.MsgCreateEscrowResponse{Id: escrow.Id}, nil
+}
+
+func (m msgServer) ReleaseEscrow(goCtx context.Context, msg *types.MsgReleaseEscrow) (*types.MsgReleaseEscrowResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, err := m.Keeper.ReleaseEscrow(ctx, msg.Id, msg.Preimage, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReleaseEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyBeneficiary, escrow.Beneficiary),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgReleaseEscrowResponse{}, nil
+}
+
+func (m msgServer) ClaimEscrowRefund(goCtx context.Context, msg *types.MsgClaimEscrowRefund) (*types.MsgClaimEscrowRefundResponse, error) {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, err := m.Keeper.ClaimEscrowRefund(ctx, msg.Id, payer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyPayer, escrow.Payer),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Payer),
+		),
+	})
+
+	return &types.MsgClaimEscrowRefundResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateParams(ctx, msg.Params, operator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -2,49 +2,82 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/aadhi0612/iritamod/modules/opb/types"
 )
 
-// ParamKeyTable for the OPB module
-func ParamKeyTable() paramstypes.KeyTable {
-	return paramstypes.NewKeyTable().RegisterParamSet(&types.Params{})
-}
-
 // BaseTokenDenom returns the base token denom
-func (k Keeper) BaseTokenDenom(ctx sdk.Context) (res string) {
-	k.paramSpace.Get(ctx, types.KeyBaseTokenDenom, &res)
-	return
+func (k Keeper) BaseTokenDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BaseTokenDenom
 }
 
 // PointTokenDenom returns the point token denom
-func (k Keeper) PointTokenDenom(ctx sdk.Context) (res string) {
-	k.paramSpace.Get(ctx, types.KeyPointTokenDenom, &res)
-	return
+func (k Keeper) PointTokenDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).PointTokenDenom
 }
 
 // BaseTokenManager returns the base token manager
-func (k Keeper) BaseTokenManager(ctx sdk.Context) (res string) {
-	k.paramSpace.Get(ctx, types.KeyBaseTokenManager, &res)
-	return
+func (k Keeper) BaseTokenManager(ctx sdk.Context) string {
+	return k.GetParams(ctx).BaseTokenManager
+}
+
+// UnrestrictedTokenTransfer returns the boolean value which indicates if the token transfer is restricted.
+// It is only the fallback for the denoms without an explicit transfer mode, which always takes precedence
+func (k Keeper) UnrestrictedTokenTransfer(ctx sdk.Context) bool {
+	return k.GetParams(ctx).UnrestrictedTokenTransfer
+}
+
+// MintEpochBlocks returns the number of blocks of a mint epoch
+func (k Keeper) MintEpochBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MintEpochBlocks
+}
+
+// MintEpochCap returns the max amount of the base token allowed to mint in a mint epoch
+func (k Keeper) MintEpochCap(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MintEpochCap
 }
 
-// UnrestrictedTokenTransfer returns the boolean value which indicates if the token transfer is restricted
-func (k Keeper) UnrestrictedTokenTransfer(ctx sdk.Context) (res bool) {
-	k.paramSpace.Get(ctx, types.KeyUnrestrictedTokenTransfer, &res)
-	return
+// MaxSupply returns the max supply of the base token, beyond which minting is not allowed
+func (k Keeper) MaxSupply(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxSupply
+}
+
+// PointTokenFeeRate returns the amount of the point token equivalent to one unit of the fee denom
+func (k Keeper) PointTokenFeeRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).PointTokenFeeRate
 }
 
 // GetParams gets all parameters
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
 	var p types.Params
-	k.paramSpace.GetParamSet(ctx, &p)
+	if bz := store.Get(types.ParamsStoreKey()); bz != nil {
+		k.cdc.MustUnmarshal(bz, &p)
+	}
 
 	return p
 }
 
 // SetParams sets the params to the store
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsStoreKey(), k.cdc.MustMarshal(&params))
+}
+
+// UpdateParams updates the params
+// NOTE: the operator must possess the RootAdmin or ParamAdmin permission
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params, operator sdk.AccAddress) error {
+	if !k.permKeeper.IsRootAdmin(ctx, operator) && !k.permKeeper.IsParamAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to update the params", operator)
+	}
+
+	if err := params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	k.SetParams(ctx, params)
+
+	return nil
 }
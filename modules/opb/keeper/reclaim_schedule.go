@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// CreateReclaimSchedule creates a standing reclaim of the denom executed every interval blocks
+// NOTE: the operator must be allowed to reclaim the denom
+func (k Keeper) CreateReclaimSchedule(
+	ctx sdk.Context,
+	denom string,
+	amount sdk.Int,
+	splits []types.ReclaimSplit,
+	interval uint64,
+	operator sdk.AccAddress,
+) (types.ReclaimSchedule, error) {
+	if _, err := k.authorizeReclaim(ctx, denom, operator); err != nil {
+		return types.ReclaimSchedule{}, err
+	}
+
+	id := k.GetReclaimScheduleSequence(ctx) + 1
+	nextHeight := ctx.BlockHeight() + int64(interval)
+
+	schedule := types.NewReclaimSchedule(id, denom, amount, splits, interval, nextHeight, operator)
+
+	k.setReclaimSchedule(ctx, schedule)
+	k.setReclaimScheduleSequence(ctx, id)
+
+	return schedule, nil
+}
+
+// DeleteReclaimSchedule deletes the reclaim schedule
+// NOTE: the operator must be either the creator or the root admin
+func (k Keeper) DeleteReclaimSchedule(ctx sdk.Context, id uint64, operator sdk.AccAddress) error {
+	schedule, found := k.GetReclaimSchedule(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownSchedule, "reclaim schedule %d does not exist", id)
+	}
+
+	if schedule.Creator != operator.String() && !k.permKeeper.IsRootAdmin(ctx, operator) {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "address %s has no permission to delete the reclaim schedule %d", operator, id)
+	}
+
+	k.deleteReclaimSchedule(ctx, schedule)
+
+	return nil
+}
+
+// ExecuteReclaimSchedule executes the due reclaim schedule and reschedules it by the interval.
+// The reclaim is skipped if it fails, e.g. the creator is no longer allowed to reclaim the denom
+func (k Keeper) ExecuteReclaimSchedule(ctx sdk.Context, schedule types.ReclaimSchedule) (types.ReclaimSchedule, error) {
+	// reclaim in a cached context to discard the partial transfers upon failure
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	creator, _ := sdk.AccAddressFromBech32(schedule.Creator)
+
+	_, err := k.Reclaim(cacheCtx, schedule.Denom, schedule.Amount, schedule.Splits, creator)
+	if err == nil {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	k.deleteReclaimSchedule(ctx, schedule)
+
+	schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+	k.setReclaimSchedule(ctx, schedule)
+
+	return schedule, err
+}
+
+// IterateDueReclaimSchedules iterates through the reclaim schedules due at or before the given height
+func (k Keeper) IterateDueReclaimSchedules(
+	ctx sdk.Context,
+	height int64,
+	op func(schedule types.ReclaimSchedule) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixReclaimScheduleQueue, types.ReclaimScheduleQueueByHeightStoreKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.SplitReclaimScheduleQueueStoreKey(iterator.Key())
+
+		schedule, found := k.GetReclaimSchedule(ctx, id)
+		if !found {
+			continue
+		}
+
+		if stop := op(schedule); stop {
+			break
+		}
+	}
+}
+
+// GetReclaimSchedule returns the reclaim schedule of the given id
+func (k Keeper) GetReclaimSchedule(ctx sdk.Context, id uint64) (types.ReclaimSchedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ReclaimScheduleStoreKey(id))
+	if bz == nil {
+		return types.ReclaimSchedule{}, false
+	}
+
+	var schedule types.ReclaimSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule, true
+}
+
+// GetReclaimSchedules returns all the reclaim schedules
+func (k Keeper) GetReclaimSchedules(ctx sdk.Context) []types.ReclaimSchedule {
+	schedules := make([]types.ReclaimSchedule, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixReclaimSchedule)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.ReclaimSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules
+}
+
+// InitReclaimSchedules initializes the reclaim schedules from genesis
+func (k Keeper) InitReclaimSchedules(ctx sdk.Context, schedules []types.ReclaimSchedule) {
+	var sequence uint64
+	for _, schedule := range schedules {
+		k.setReclaimSchedule(ctx, schedule)
+
+		if schedule.Id > sequence {
+			sequence = schedule.Id
+		}
+	}
+
+	k.setReclaimScheduleSequence(ctx, sequence)
+}
+
+// GetReclaimScheduleSequence returns the id of the latest reclaim schedule
+func (k Keeper) GetReclaimScheduleSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReclaimScheduleSequenceStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setReclaimScheduleSequence sets the id of the latest reclaim schedule
+func (k Keeper) setReclaimScheduleSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReclaimScheduleSequenceStoreKey(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// setReclaimSchedule sets the reclaim schedule along with the queue entry of the next height
+func (k Keeper) setReclaimSchedule(ctx sdk.Context, schedule types.ReclaimSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.ReclaimScheduleStoreKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+	store.Set(types.ReclaimScheduleQueueStoreKey(schedule.NextHeight, schedule.Id), types.Placeholder)
+}
+
+// deleteReclaimSchedule deletes the reclaim schedule along with the queue entry
+func (k Keeper) deleteReclaimSchedule(ctx sdk.Context, schedule types.ReclaimSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.ReclaimScheduleStoreKey(schedule.Id))
+	store.Delete(types.ReclaimScheduleQueueStoreKey(schedule.NextHeight, schedule.Id))
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// collect sends the coin from the address to the module account as collected fees
+func (s *KeeperTestSuite) collect(from sdk.AccAddress, moduleAccName string, coin sdk.Coin) {
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromAccountToModule(s.ctx, from, moduleAccName, sdk.NewCoins(coin)))
+}
+
+func (s *KeeperTestSuite) TestReclaim() {
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.collect(accAlice, authtypes.FeeCollectorName, sdk.NewInt64Coin(baseDenom, 100))
+
+	splits := []types.ReclaimSplit{
+		types.NewReclaimSplit(accBob, sdk.NewDecWithPrec(3, 1)),
+		types.NewReclaimSplit(tokenManager, sdk.NewDecWithPrec(7, 1)),
+	}
+
+	_, err := s.keeper.Reclaim(s.ctx, baseDenom, sdk.ZeroInt(), splits, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.keeper.Reclaim(s.ctx, "uunknown", sdk.ZeroInt(), splits, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.keeper.Reclaim(s.ctx, baseDenom, sdk.NewInt(101), splits, baseM1Admin)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the truncated remainder goes to the last split
+	coins, err := s.keeper.Reclaim(s.ctx, baseDenom, sdk.NewInt(33), splits, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to reclaim")
+	s.Require().Equal([]sdk.Coin{sdk.NewInt64Coin(baseDenom, 9), sdk.NewInt64Coin(baseDenom, 24)}, coins)
+	s.Require().Equal(int64(9), s.balance(accBob, baseDenom).Int64())
+	s.Require().Equal(int64(24), s.balance(tokenManager, baseDenom).Int64())
+
+	// a zero amount reclaims the whole balance
+	coins, err = s.keeper.Reclaim(s.ctx, baseDenom, sdk.ZeroInt(), splits, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to reclaim")
+	s.Require().Equal([]sdk.Coin{sdk.NewInt64Coin(baseDenom, 20), sdk.NewInt64Coin(baseDenom, 47)}, coins)
+	s.Require().True(s.balance(feeCollector, baseDenom).IsZero())
+
+	_, err = s.keeper.Reclaim(s.ctx, baseDenom, sdk.ZeroInt(), splits, baseM1Admin)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.Require().Equal(int64(100), s.keeper.GetReclaimTotal(s.ctx, baseDenom).Int64())
+	s.Require().Len(s.keeper.GetReclaimRecords(s.ctx), 4)
+}
+
+func (s *KeeperTestSuite) TestReclaimPointToken() {
+	s.collect(accAlice, types.PointTokenFeeCollectorName, sdk.NewInt64Coin(pointDenom, 100))
+
+	splits := []types.ReclaimSplit{types.NewReclaimSplit(accBob, sdk.OneDec())}
+
+	// the point token can only be reclaimed by its owner
+	_, err := s.keeper.Reclaim(s.ctx, pointDenom, sdk.ZeroInt(), splits, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	coins, err := s.keeper.Reclaim(s.ctx, pointDenom, sdk.ZeroInt(), splits, pointOwner)
+	s.Require().NoErrorf(err, "failed to reclaim")
+	s.Require().Equal([]sdk.Coin{sdk.NewInt64Coin(pointDenom, 100)}, coins)
+	s.Require().Equal(int64(100), s.balance(accBob, pointDenom).Int64())
+}
+
+func (s *KeeperTestSuite) TestReclaimSchedule() {
+	splits := []types.ReclaimSplit{types.NewReclaimSplit(accBob, sdk.OneDec())}
+
+	_, err := s.keeper.CreateReclaimSchedule(s.ctx, baseDenom, sdk.NewInt(10), splits, 5, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	schedule, err := s.keeper.CreateReclaimSchedule(s.ctx, baseDenom, sdk.NewInt(10), splits, 5, baseM1Admin)
+	s.Require().NoErrorf(err, "failed to create reclaim schedule")
+	s.Require().Equal(uint64(1), schedule.Id)
+	s.Require().Equal(int64(6), schedule.NextHeight)
+
+	s.Require().Empty(s.dueReclaimSchedules(5))
+	s.Require().Len(s.dueReclaimSchedules(6), 1)
+
+	// the reclaim fails without any balance but the schedule is still rescheduled
+	s.ctx = s.ctx.WithBlockHeight(6)
+	schedule, err = s.keeper.ExecuteReclaimSchedule(s.ctx, schedule)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	s.Require().Equal(int64(11), schedule.NextHeight)
+	s.Require().Empty(s.dueReclaimSchedules(10))
+
+	s.collect(accAlice, authtypes.FeeCollectorName, sdk.NewInt64Coin(baseDenom, 15))
+
+	s.ctx = s.ctx.WithBlockHeight(11)
+	schedule, err = s.keeper.ExecuteReclaimSchedule(s.ctx, schedule)
+	s.Require().NoErrorf(err, "failed to execute reclaim schedule")
+	s.Require().Equal(int64(16), schedule.NextHeight)
+	s.Require().Equal(int64(10), s.balance(accBob, baseDenom).Int64())
+
+	stored, found := s.keeper.GetReclaimSchedule(s.ctx, schedule.Id)
+	s.Require().True(found)
+	s.Require().Equal(schedule, stored)
+
+	err = s.keeper.DeleteReclaimSchedule(s.ctx, schedule.Id, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the root admin is allowed to delete the schedule of others
+	err = s.keeper.DeleteReclaimSchedule(s.ctx, schedule.Id, rootAdmin)
+	s.Require().NoErrorf(err, "failed to delete reclaim schedule")
+
+	_, found = s.keeper.GetReclaimSchedule(s.ctx, schedule.Id)
+	s.Require().False(found)
+	s.Require().Empty(s.dueReclaimSchedules(16))
+
+	err = s.keeper.DeleteReclaimSchedule(s.ctx, schedule.Id, baseM1Admin)
+	s.Require().ErrorIs(err, types.ErrUnknownSchedule)
+}
+
+// dueReclaimSchedules returns the reclaim schedules due at or before the given height
+func (s *KeeperTestSuite) dueReclaimSchedules(height int64) []types.ReclaimSchedule {
+	var schedules []types.ReclaimSchedule
+	s.keeper.IterateDueReclaimSchedules(s.ctx, height, func(schedule types.ReclaimSchedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return schedules
+}
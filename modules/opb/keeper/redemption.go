@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// Redeem requests to redeem the base or point token for an off-chain settlement.
+// The tokens are escrowed until the redemption is settled, upon which they are burned
+func (k Keeper) Redeem(ctx sdk.Context, amount sdk.Coin, settlementRef string, holder sdk.AccAddress) (uint64, error) {
+	baseTokenDenom := k.BaseTokenDenom(ctx)
+	pointTokenDenom := k.PointTokenDenom(ctx)
+
+	if amount.Denom != baseTokenDenom && amount.Denom != pointTokenDenom {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidDenom, "denom must be either %s or %s", baseTokenDenom, pointTokenDenom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.RedemptionEscrowName, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	id := k.GetRedemptionSequence(ctx) + 1
+
+	redemption := types.NewRedemption(id, holder, amount, settlementRef, ctx.BlockHeight())
+
+	k.setRedemption(ctx, redemption)
+	k.setRedemptionSequence(ctx, id)
+
+	return id, nil
+}
+
+// SettleRedemption marks the pending redemption as settled and burns the escrowed tokens
+// NOTE: the operator must be the base token manager
+func (k Keeper) SettleRedemption(ctx sdk.Context, id uint64, operator sdk.AccAddress) (types.Redemption, error) {
+	redemption, err := k.getPendingRedemption(ctx, id, operator)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.RedemptionEscrowName, sdk.NewCoins(redemption.Amount)); err != nil {
+		return types.Redemption{}, err
+	}
+
+	redemption.Status = types.RedemptionStatusSettled
+	redemption.ResolveHeight = ctx.BlockHeight()
+
+	k.setRedemption(ctx, redemption)
+
+	return redemption, nil
+}
+
+// RejectRedemption marks the pending redemption as rejected and returns the escrowed tokens to the holder
+// NOTE: the operator must be the base token manager
+func (k Keeper) RejectRedemption(ctx sdk.Context, id uint64, reason string, operator sdk.AccAddress) (types.Redemption, error) {
+	redemption, err := k.getPendingRedemption(ctx, id, operator)
+	if err != nil {
+		return types.Redemption{}, err
+	}
+
+	holder, _ := sdk.AccAddressFromBech32(redemption.Holder)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RedemptionEscrowName, holder, sdk.NewCoins(redemption.Amount)); err != nil {
+		return types.Redemption{}, err
+	}
+
+	redemption.Status = types.RedemptionStatusRejected
+	redemption.ResolveHeight = ctx.BlockHeight()
+	redemption.Reason = reason
+
+	k.setRedemption(ctx, redemption)
+
+	return redemption, nil
+}
+
+// GetRedemption returns the redemption of the given id
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (types.Redemption, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.RedemptionStoreKey(id))
+	if bz == nil {
+		return types.Redemption{}, false
+	}
+
+	var redemption types.Redemption
+	k.cdc.MustUnmarshal(bz, &redemption)
+
+	return redemption, true
+}
+
+// GetRedemptions returns all the redemptions
+func (k Keeper) GetRedemptions(ctx sdk.Context) []types.Redemption {
+	redemptions := make([]types.Redemption, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRedemption)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+
+		redemptions = append(redemptions, redemption)
+	}
+
+	return redemptions
+}
+
+// InitRedemptions initializes the redemptions from genesis
+func (k Keeper) InitRedemptions(ctx sdk.Context, redemptions []types.Redemption) {
+	var sequence uint64
+	for _, redemption := range redemptions {
+		k.setRedemption(ctx, redemption)
+
+		if redemption.Id > sequence {
+			sequence = redemption.Id
+		}
+	}
+
+	k.setRedemptionSequence(ctx, sequence)
+}
+
+// GetRedemptionSequence returns the id of the latest redemption
+func (k Keeper) GetRedemptionSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RedemptionSequenceStoreKey())
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setRedemptionSequence sets the id of the latest redemption
+func (k Keeper) setRedemptionSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RedemptionSequenceStoreKey(), sdk.Uint64ToBigEndian(sequence))
+}
+
+// setRedemption sets the redemption along with the index of the holder
+func (k Keeper) setRedemption(ctx sdk.Context, redemption types.Redemption) {
+	store := ctx.KVStore(k.storeKey)
+
+	holder, _ := sdk.AccAddressFromBech32(redemption.Holder)
+
+	store.Set(types.RedemptionStoreKey(redemption.Id), k.cdc.MustMarshal(&redemption))
+	store.Set(types.RedemptionOfHolderStoreKey(holder, redemption.Id), types.Placeholder)
+}
+
+// getRedemptionOfHolderStore returns the redemption index store of the given holder
+func (k Keeper) getRedemptionOfHolderStore(ctx sdk.Context, holder sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionOfHolderPrefixKey(holder))
+}
+
+// getPendingRedemption returns the pending redemption to be resolved by the operator
+func (k Keeper) getPendingRedemption(ctx sdk.Context, id uint64, operator sdk.AccAddress) (types.Redemption, error) {
+	if manager := k.BaseTokenManager(ctx); len(manager) == 0 || manager != operator.String() {
+		return types.Redemption{}, sdkerrors.Wrap(types.ErrUnauthorized, "only the base token manager is allowed to resolve the redemption")
+	}
+
+	redemption, found := k.GetRedemption(ctx, id)
+	if !found {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrUnknownRedemption, "redemption %d does not exist", id)
+	}
+
+	if redemption.Status != types.RedemptionStatusPending {
+		return types.Redemption{}, sdkerrors.Wrapf(types.ErrInvalidRedemption, "redemption %d is already %s", id, redemption.Status)
+	}
+
+	return redemption, nil
+}
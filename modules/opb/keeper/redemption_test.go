@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+func (s *KeeperTestSuite) TestRedeem() {
+	escrow := s.app.AccountKeeper.GetModuleAddress(types.RedemptionEscrowName)
+
+	_, err := s.keeper.Redeem(s.ctx, sdk.NewInt64Coin("ugas", 100), "ref-0", accAlice)
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(baseDenom, 1001), "ref-0", accAlice)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	id, err := s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(baseDenom, 300), "ref-1", accAlice)
+	s.Require().NoErrorf(err, "failed to redeem")
+	s.Require().Equal(uint64(1), id)
+
+	// the redeemed tokens are escrowed until the redemption is resolved
+	s.Require().Equal(int64(700), s.balance(accAlice, baseDenom).Int64())
+	s.Require().Equal(int64(300), s.balance(escrow, baseDenom).Int64())
+
+	redemption, found := s.keeper.GetRedemption(s.ctx, id)
+	s.Require().True(found)
+	s.Require().Equal(types.RedemptionStatusPending, redemption.Status)
+	s.Require().Equal(accAlice.String(), redemption.Holder)
+	s.Require().Equal("ref-1", redemption.SettlementRef)
+}
+
+func (s *KeeperTestSuite) TestSettleRedemption() {
+	escrow := s.app.AccountKeeper.GetModuleAddress(types.RedemptionEscrowName)
+
+	id, err := s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(pointDenom, 300), "ref-1", accAlice)
+	s.Require().NoErrorf(err, "failed to redeem")
+
+	_, err = s.keeper.SettleRedemption(s.ctx, id, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.keeper.SettleRedemption(s.ctx, id+1, tokenManager)
+	s.Require().ErrorIs(err, types.ErrUnknownRedemption)
+
+	s.ctx = s.ctx.WithBlockHeight(5)
+	redemption, err := s.keeper.SettleRedemption(s.ctx, id, tokenManager)
+	s.Require().NoErrorf(err, "failed to settle redemption")
+	s.Require().Equal(types.RedemptionStatusSettled, redemption.Status)
+	s.Require().Equal(int64(5), redemption.ResolveHeight)
+
+	// the escrowed tokens are burned
+	s.Require().True(s.balance(escrow, pointDenom).IsZero())
+	s.Require().Equal(int64(700), s.app.BankKeeper.GetSupply(s.ctx, pointDenom).Amount.Int64())
+
+	_, err = s.keeper.SettleRedemption(s.ctx, id, tokenManager)
+	s.Require().ErrorIs(err, types.ErrInvalidRedemption)
+
+	_, err = s.keeper.RejectRedemption(s.ctx, id, "too late", tokenManager)
+	s.Require().ErrorIs(err, types.ErrInvalidRedemption)
+}
+
+func (s *KeeperTestSuite) TestRejectRedemption() {
+	escrow := s.app.AccountKeeper.GetModuleAddress(types.RedemptionEscrowName)
+
+	id, err := s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(baseDenom, 300), "ref-1", accAlice)
+	s.Require().NoErrorf(err, "failed to redeem")
+
+	_, err = s.keeper.RejectRedemption(s.ctx, id, "invalid account", accBob)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	redemption, err := s.keeper.RejectRedemption(s.ctx, id, "invalid account", tokenManager)
+	s.Require().NoErrorf(err, "failed to reject redemption")
+	s.Require().Equal(types.RedemptionStatusRejected, redemption.Status)
+	s.Require().Equal("invalid account", redemption.Reason)
+
+	// the escrowed tokens are returned to the holder
+	s.Require().Equal(int64(1000), s.balance(accAlice, baseDenom).Int64())
+	s.Require().True(s.balance(escrow, baseDenom).IsZero())
+
+	_, err = s.keeper.SettleRedemption(s.ctx, id, tokenManager)
+	s.Require().ErrorIs(err, types.ErrInvalidRedemption)
+
+	// the redemptions can not be resolved without the base token manager
+	id, err = s.keeper.Redeem(s.ctx, sdk.NewInt64Coin(baseDenom, 300), "ref-2", accAlice)
+	s.Require().NoErrorf(err, "failed to redeem")
+
+	s.setParams(func(params *types.Params) {
+		params.BaseTokenManager = ""
+	})
+
+	_, err = s.keeper.RejectRedemption(s.ctx, id, "", tokenManager)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// SetTransferLimit sets the transfer limits of the token, deleting them when none of the limits is set
+// NOTE: the operator must be the owner of the token
+func (k Keeper) SetTransferLimit(ctx sdk.Context, limit types.TransferLimit, operator sdk.AccAddress) error {
+	if err := k.authorizeTokenOwner(ctx, limit.Denom, operator); err != nil {
+		return err
+	}
+
+	k.setTransferLimit(ctx, limit)
+
+	return nil
+}
+
+// GetTransferLimit returns the transfer limits of the given denom
+func (k Keeper) GetTransferLimit(ctx sdk.Context, denom string) (types.TransferLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.TransferLimitStoreKey(denom))
+	if bz == nil {
+		return types.TransferLimit{}, false
+	}
+
+	var limit types.TransferLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+
+	return limit, true
+}
+
+// GetTransferLimits returns all the transfer limits
+func (k Keeper) GetTransferLimits(ctx sdk.Context) []types.TransferLimit {
+	limits := make([]types.TransferLimit, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTransferLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit types.TransferLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+
+		limits = append(limits, limit)
+	}
+
+	return limits
+}
+
+// GetTransferUsage returns the transfer usage of the denom by the address in the current day
+func (k Keeper) GetTransferUsage(ctx sdk.Context, denom string, addr sdk.AccAddress) types.TransferUsage {
+	day := types.TransferDay(ctx.BlockTime())
+
+	store := ctx.KVStore(k.storeKey)
+
+	// the usages are keyed by day, hence reset once the day elapses
+	bz := store.Get(types.TransferUsageStoreKey(day, denom, addr))
+	if bz == nil {
+		return types.NewTransferUsage(denom, addr, day)
+	}
+
+	var usage types.TransferUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	return usage
+}
+
+// GetTransferUsages returns all the stored transfer usages
+func (k Keeper) GetTransferUsages(ctx sdk.Context) []types.TransferUsage {
+	usages := make([]types.TransferUsage, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTransferUsage)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.TransferUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+// InitTransferLimits initializes the transfer limits and usages from genesis
+func (k Keeper) InitTransferLimits(ctx sdk.Context, limits []types.TransferLimit, usages []types.TransferUsage) {
+	for _, limit := range limits {
+		k.setTransferLimit(ctx, limit)
+	}
+
+	for _, usage := range usages {
+		k.setTransferUsage(ctx, usage)
+	}
+}
+
+// PruneTransferUsages deletes the transfer usages of the days before the given day
+func (k Keeper) PruneTransferUsages(ctx sdk.Context, day uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixTransferUsage, types.TransferUsageByDayPrefixKey(day))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ConsumeTransferLimit accumulates the transfer of the coin by the sender to the daily usage and checks it
+// against the transfer limits of the denom. The transfer over the limits is either rejected or tagged with
+// an event according to the limit action. The transfers sent by the token owner are not limited
+// NOTE: it must be called upon the msg execution rather than in the ante handler,
+// so that the usage is discarded along with the failed msg
+func (k Keeper) ConsumeTransferLimit(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
+	limit, found := k.GetTransferLimit(ctx, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	if owner, _ := k.GetTokenOwner(ctx, coin.Denom); owner == sender.String() {
+		return nil
+	}
+
+	usage := k.GetTransferUsage(ctx, coin.Denom, sender)
+	usage.Volume = usage.Volume.Add(coin.Amount)
+	usage.Count++
+
+	var exceeded []string
+
+	if limit.MaxAmountPerTransfer.IsPositive() && coin.Amount.GT(limit.MaxAmountPerTransfer) {
+		exceeded = append(exceeded, "max_amount_per_transfer")
+	}
+
+	if limit.DailyVolumeLimit.IsPositive() && usage.Volume.GT(limit.DailyVolumeLimit) {
+		exceeded = append(exceeded, "daily_volume_limit")
+	}
+
+	if limit.DailyCountLimit > 0 && usage.Count > limit.DailyCountLimit {
+		exceeded = append(exceeded, "daily_count_limit")
+	}
+
+	if len(exceeded) > 0 {
+		if limit.Action == types.LimitActionReject {
+			return sdkerrors.Wrapf(
+				types.ErrTransferLimitExceeded,
+				"transfer of %s by %s exceeds the limits: %s",
+				coin, sender, strings.Join(exceeded, ","),
+			)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferLimitExceeded,
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyAddress, sender.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+				sdk.NewAttribute(types.AttributeKeyLimit, strings.Join(exceeded, ",")),
+			),
+		)
+	}
+
+	k.setTransferUsage(ctx, usage)
+
+	return nil
+}
+
+// setTransferLimit sets the transfer limit, deleting it when empty
+func (k Keeper) setTransferLimit(ctx sdk.Context, limit types.TransferLimit) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.TransferLimitStoreKey(limit.Denom)
+	if limit.IsEmpty() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&limit))
+}
+
+// setTransferUsage sets the transfer usage
+func (k Keeper) setTransferUsage(ctx sdk.Context, usage types.TransferUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	addr, _ := sdk.AccAddressFromBech32(usage.Address)
+	store.Set(types.TransferUsageStoreKey(usage.Day, usage.Denom, addr), k.cdc.MustMarshal(&usage))
+}
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb"
+	"github.com/aadhi0612/iritamod/modules/opb/keeper"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+func (s *KeeperTestSuite) TestSetTransferLimit() {
+	limit := types.NewTransferLimit(pointDenom, sdk.NewInt(100), sdk.NewInt(200), 2, types.LimitActionReject)
+
+	err := s.keeper.SetTransferLimit(s.ctx, limit, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	err = s.keeper.SetTransferLimit(s.ctx, limit, pointOwner)
+	s.Require().NoErrorf(err, "failed to set transfer limit")
+
+	stored, found := s.keeper.GetTransferLimit(s.ctx, pointDenom)
+	s.Require().True(found)
+	s.Require().Equal(limit, stored)
+
+	// the empty limit is deleted
+	err = s.keeper.SetTransferLimit(s.ctx, types.NewTransferLimit(pointDenom, sdk.ZeroInt(), sdk.ZeroInt(), 0, types.LimitActionReject), pointOwner)
+	s.Require().NoErrorf(err, "failed to set transfer limit")
+
+	_, found = s.keeper.GetTransferLimit(s.ctx, pointDenom)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestConsumeTransferLimit() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(100*types.SecondsPerDay, 0).UTC())
+
+	limit := types.NewTransferLimit(pointDenom, sdk.NewInt(100), sdk.NewInt(150), 2, types.LimitActionReject)
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+
+	err := s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 101))
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 100)))
+
+	err = s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 51))
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 50)))
+
+	err = s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 1))
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	usage := s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice)
+	s.Require().Equal(int64(150), usage.Volume.Int64())
+	s.Require().Equal(uint64(2), usage.Count)
+
+	// the token owner is not limited
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, pointOwner, sdk.NewInt64Coin(pointDenom, 1000)))
+
+	// the tagged transfer over the limits is allowed with an event
+	limit.Action = types.LimitActionTag
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 1)))
+	s.Require().Equal(uint64(3), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Count)
+
+	events := s.ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeTransferLimitExceeded, events[len(events)-1].Type)
+
+	// the usage is reset once the day elapses
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.SecondsPerDay * time.Second))
+
+	usage = s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice)
+	s.Require().True(usage.Volume.IsZero())
+	s.Require().Zero(usage.Count)
+	s.Require().Equal(uint64(101), usage.Day)
+}
+
+func (s *KeeperTestSuite) TestPruneTransferUsages() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(100*types.SecondsPerDay, 0).UTC())
+
+	limit := types.NewTransferLimit(pointDenom, sdk.ZeroInt(), sdk.ZeroInt(), 10, types.LimitActionReject)
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, accAlice, sdk.NewInt64Coin(pointDenom, 1)))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(types.SecondsPerDay * time.Second))
+	s.Require().NoError(s.keeper.ConsumeTransferLimit(s.ctx, accBob, sdk.NewInt64Coin(pointDenom, 1)))
+	s.Require().Len(s.keeper.GetTransferUsages(s.ctx), 2)
+
+	// the usages of the elapsed days are pruned at the end of the block
+	opb.EndBlocker(s.ctx, s.keeper)
+
+	usages := s.keeper.GetTransferUsages(s.ctx)
+	s.Require().Len(usages, 1)
+	s.Require().Equal(accBob.String(), usages[0].Address)
+	s.Require().Equal(uint64(101), usages[0].Day)
+}
+
+func (s *KeeperTestSuite) TestTransferLimitBankKeeper() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(100*types.SecondsPerDay, 0).UTC())
+
+	limit := types.NewTransferLimit(pointDenom, sdk.ZeroInt(), sdk.NewInt(500), 0, types.LimitActionReject)
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+
+	msgServer := bankkeeper.NewMsgServerImpl(keeper.NewTransferLimitBankKeeper(s.app.BankKeeper, &s.keeper))
+
+	// execute the msg in a cached context as the msg execution, which is discarded upon failure
+	send := func(msg *banktypes.MsgSend) error {
+		cacheCtx, writeCache := s.ctx.CacheContext()
+
+		_, err := msgServer.Send(sdk.WrapSDKContext(cacheCtx), msg)
+		if err == nil {
+			writeCache()
+		}
+
+		return err
+	}
+
+	err := send(banktypes.NewMsgSend(accAlice, accBob, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 300))))
+	s.Require().NoErrorf(err, "failed to send")
+	s.Require().Equal(int64(300), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.Int64())
+
+	err = send(banktypes.NewMsgSend(accAlice, accBob, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 201))))
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	// the usage of the failed transfer is not charged
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, accAlice, pointOwner, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 650))))
+
+	err = send(banktypes.NewMsgSend(accAlice, accBob, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 100))))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	s.Require().Equal(int64(300), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.Int64())
+
+	// the multi send is charged per input
+	inputs := []banktypes.Input{banktypes.NewInput(accAlice, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 50)))}
+	outputs := []banktypes.Output{banktypes.NewOutput(accBob, sdk.NewCoins(sdk.NewInt64Coin(pointDenom, 50)))}
+
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(s.ctx), banktypes.NewMsgMultiSend(inputs, outputs))
+	s.Require().NoErrorf(err, "failed to multi send")
+	s.Require().Equal(int64(350), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.Int64())
+}
+
+func (s *KeeperTestSuite) TestTransferLimitVestingMsgServer() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(100*types.SecondsPerDay, 0).UTC())
+
+	limit := types.NewTransferLimit(pointDenom, sdk.ZeroInt(), sdk.NewInt(500), 0, types.LimitActionReject)
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+
+	msgServer := vesting.NewMsgServerImpl(s.app.AccountKeeper, keeper.NewTransferLimitBankKeeper(s.app.BankKeeper, &s.keeper))
+
+	createVestingAccount := func(amount int64) error {
+		cacheCtx, writeCache := s.ctx.CacheContext()
+
+		msg := vestingtypes.NewMsgCreateVestingAccount(accAlice, sdk.AccAddress([]byte("vesting-account-addr")), sdk.NewCoins(sdk.NewInt64Coin(pointDenom, amount)), 1000, false)
+
+		_, err := msgServer.CreateVestingAccount(sdk.WrapSDKContext(cacheCtx), msg)
+		if err == nil {
+			writeCache()
+		}
+
+		return err
+	}
+
+	err := createVestingAccount(501)
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+	s.Require().True(s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.IsZero())
+
+	s.Require().NoErrorf(createVestingAccount(500), "failed to create vesting account")
+	s.Require().Equal(int64(500), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.Int64())
+}
+
+func (s *KeeperTestSuite) TestTransferLimitIBCMsgServer() {
+	s.ctx = s.ctx.WithBlockTime(time.Unix(100*types.SecondsPerDay, 0).UTC())
+
+	limit := types.NewTransferLimit(pointDenom, sdk.ZeroInt(), sdk.NewInt(500), 0, types.LimitActionReject)
+	s.Require().NoError(s.keeper.SetTransferLimit(s.ctx, limit, pointOwner))
+
+	inner := &mockIBCMsgServer{}
+	msgServer := keeper.NewTransferLimitIBCMsgServer(inner, &s.keeper)
+
+	transfer := func(amount int64) error {
+		msg := ibctransfertypes.NewMsgTransfer(
+			"transfer", "channel-0", sdk.NewInt64Coin(pointDenom, amount),
+			accAlice.String(), "receiver", clienttypes.NewHeight(0, 100), 0,
+		)
+
+		_, err := msgServer.Transfer(sdk.WrapSDKContext(s.ctx), msg)
+		return err
+	}
+
+	s.Require().NoErrorf(transfer(300), "failed to transfer")
+	s.Require().Equal(int64(300), s.keeper.GetTransferUsage(s.ctx, pointDenom, accAlice).Volume.Int64())
+
+	// the transfer over the limit is rejected before reaching the IBC transfer module
+	err := transfer(201)
+	s.Require().ErrorIs(err, types.ErrTransferLimitExceeded)
+	s.Require().Equal(1, inner.transfers)
+}
+
+// mockIBCMsgServer is a stub of the IBC transfer msg server counting the executed transfers
+type mockIBCMsgServer struct {
+	transfers int
+}
+
+func (m *mockIBCMsgServer) Transfer(context.Context, *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	m.transfers++
+	return &ibctransfertypes.MsgTransferResponse{}, nil
+}
@@ -0,0 +1,268 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// SetTransferMode sets the transfer mode of the given denom. The unspecified mode
+// removes the explicit mode, upon which the denom follows the global transfer restriction
+// NOTE: the operator must be the owner of the token
+func (k Keeper) SetTransferMode(ctx sdk.Context, denom string, mode types.TransferMode, operator sdk.AccAddress) error {
+	if err := k.authorizeTokenOwner(ctx, denom, operator); err != nil {
+		return err
+	}
+
+	k.setTransferMode(ctx, denom, mode)
+
+	return nil
+}
+
+// UpdateTransferAllowlist adds and removes the given addresses to and from the transfer allowlist of the denom
+// NOTE: the operator must be the owner of the token
+func (k Keeper) UpdateTransferAllowlist(ctx sdk.Context, denom string, add, remove []sdk.AccAddress, operator sdk.AccAddress) error {
+	if err := k.authorizeTokenOwner(ctx, denom, operator); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, addr := range add {
+		store.Set(types.TransferAllowlistStoreKey(denom, addr), types.Placeholder)
+	}
+
+	for _, addr := range remove {
+		store.Delete(types.TransferAllowlistStoreKey(denom, addr))
+	}
+
+	return nil
+}
+
+// GetTransferMode returns the effective transfer mode of the given denom and whether it is explicitly set.
+// The explicit mode always wins over the global UnrestrictedTokenTransfer param, which is
+// only the fallback for the denom without an explicit mode
+func (k Keeper) GetTransferMode(ctx sdk.Context, denom string) (types.TransferMode, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.TransferModeStoreKey(denom))
+	if bz != nil {
+		return types.TransferMode(sdk.BigEndianToUint64(bz)), true
+	}
+
+	if k.UnrestrictedTokenTransfer(ctx) {
+		return types.TransferModeUnrestricted, false
+	}
+
+	return types.TransferModeOwnerOnly, false
+}
+
+// GetTransferModes returns all the explicit transfer modes
+func (k Keeper) GetTransferModes(ctx sdk.Context) []types.TokenTransferMode {
+	modes := make([]types.TokenTransferMode, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTransferMode)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key: <0x0d><denom>
+		modes = append(modes, types.TokenTransferMode{
+			Denom: string(iterator.Key()[len(types.KeyPrefixTransferMode):]),
+			Mode:  types.TransferMode(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+
+	return modes
+}
+
+// IsTransferAllowlisted returns true if the address is in the transfer allowlist of the denom
+func (k Keeper) IsTransferAllowlisted(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TransferAllowlistStoreKey(denom, addr))
+}
+
+// GetTransferAllowlists returns all the transfer allowlists
+func (k Keeper) GetTransferAllowlists(ctx sdk.Context) []types.TransferAllowlist {
+	allowlists := make([]types.TransferAllowlist, 0)
+	indexes := make(map[string]int)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTransferAllowlist)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, addr := types.SplitTransferAllowlistStoreKey(iterator.Key())
+
+		i, ok := indexes[denom]
+		if !ok {
+			i = len(allowlists)
+			indexes[denom] = i
+			allowlists = append(allowlists, types.TransferAllowlist{Denom: denom})
+		}
+
+		allowlists[i].Addresses = append(allowlists[i].Addresses, addr.String())
+	}
+
+	return allowlists
+}
+
+// InitTransferRestrictions initializes the transfer modes and allowlists from genesis
+func (k Keeper) InitTransferRestrictions(ctx sdk.Context, modes []types.TokenTransferMode, allowlists []types.TransferAllowlist) {
+	for _, mode := range modes {
+		k.setTransferMode(ctx, mode.Denom, mode.Mode)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	for _, allowlist := range allowlists {
+		for _, address := range allowlist.Addresses {
+			addr, _ := sdk.AccAddressFromBech32(address)
+			store.Set(types.TransferAllowlistStoreKey(allowlist.Denom, addr), types.Placeholder)
+		}
+	}
+}
+
+// GetTokenOwner returns the owner of the given denom, which is the base token manager for the base token
+func (k Keeper) GetTokenOwner(ctx sdk.Context, denom string) (string, error) {
+	if denom == k.BaseTokenDenom(ctx) {
+		return k.BaseTokenManager(ctx), nil
+	}
+
+	owner, err := k.tokenKeeper.GetOwner(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	return owner.String(), nil
+}
+
+// ValidateTransfer validates the transfer of the denom between the given senders and recipients
+// according to the transfer mode of the denom
+func (k Keeper) ValidateTransfer(ctx sdk.Context, denom string, senders, recipients []string) error {
+	mode, _ := k.GetTransferMode(ctx, denom)
+	if mode == types.TransferModeUnrestricted {
+		return nil
+	}
+
+	// empty if the token does not exist
+	owner, _ := k.GetTokenOwner(ctx, denom)
+
+	// If sender have platform user permissions, you can transfer token
+	if k.hasPlatformUserPermFromArr(ctx, senders) ||
+		k.hasPlatformUserPermFromArr(ctx, recipients) {
+		return nil
+	}
+
+	// If sender have not platform user permissions,
+	// determine whether the sender or recipient is the owner
+	if owned(owner, senders) || owned(owner, recipients) {
+		return nil
+	}
+
+	if mode == types.TransferModeAllowlist {
+		// both the sender and recipient must be cleared to hold the token
+		if k.allowlisted(ctx, denom, owner, senders) && k.allowlisted(ctx, denom, owner, recipients) {
+			return nil
+		}
+
+		return sdkerrors.Wrapf(
+			types.ErrUnauthorized,
+			"either the sender or recipient must be the owner %s, or both must be allowlisted for token %s",
+			owner, denom,
+		)
+	}
+
+	return sdkerrors.Wrapf(
+		types.ErrUnauthorized,
+		"either the sender or recipient must be the owner %s for token %s",
+		owner, denom,
+	)
+}
+
+// setTransferMode sets the explicit transfer mode, deleting it when unspecified
+func (k Keeper) setTransferMode(ctx sdk.Context, denom string, mode types.TransferMode) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := types.TransferModeStoreKey(denom)
+	if mode == types.TransferModeUnspecified {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(mode)))
+}
+
+// getTransferAllowlistStore returns the transfer allowlist store of the given denom
+func (k Keeper) getTransferAllowlistStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferAllowlistPrefixKey(denom))
+}
+
+// authorizeTokenOwner checks if the operator is the owner of the denom
+func (k Keeper) authorizeTokenOwner(ctx sdk.Context, denom string, operator sdk.AccAddress) error {
+	owner, err := k.GetTokenOwner(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidDenom, "token for %s does not exist", denom)
+	}
+
+	if len(owner) == 0 || owner != operator.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "only the owner of %s is allowed to manage the transfer restriction", denom)
+	}
+
+	return nil
+}
+
+// allowlisted returns false if any address is neither the owner nor in the transfer allowlist of the denom
+// True otherwise
+func (k Keeper) allowlisted(ctx sdk.Context, denom, owner string, addresses []string) bool {
+	for _, addr := range addresses {
+		if addr == owner {
+			continue
+		}
+
+		address, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return false
+		}
+
+		if !k.IsTransferAllowlisted(ctx, denom, address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasPlatformUserPermFromArr determine whether the account is a platform user from addresses
+func (k Keeper) hasPlatformUserPermFromArr(ctx sdk.Context, addresses []string) bool {
+	for _, addr := range addresses {
+		fromAddress, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return false
+		}
+		if !k.hasPlatformUserPerm(ctx, fromAddress) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasPlatformUserPerm determine whether the account is a platform user
+func (k Keeper) hasPlatformUserPerm(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.permKeeper.IsRootAdmin(ctx, address) || k.permKeeper.IsBaseM1Admin(ctx, address) || k.permKeeper.IsPlatformUser(ctx, address)
+}
+
+// owned returns false if any address is not the owner of the denom among the given non-empty addresses
+// True otherwise
+func owned(owner string, addresses []string) bool {
+	for _, addr := range addresses {
+		if addr != owner {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+	permtypes "github.com/aadhi0612/iritamod/modules/perm/types"
+)
+
+func (s *KeeperTestSuite) TestSetTransferMode() {
+	err := s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeOwnerOnly, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	err = s.keeper.SetTransferMode(s.ctx, "uunknown", types.TransferModeOwnerOnly, accAlice)
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	err = s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeOwnerOnly, pointOwner)
+	s.Require().NoErrorf(err, "failed to set transfer mode")
+
+	// the base token is managed by the base token manager
+	err = s.keeper.SetTransferMode(s.ctx, baseDenom, types.TransferModeAllowlist, tokenManager)
+	s.Require().NoErrorf(err, "failed to set transfer mode")
+
+	s.Require().Equal(
+		[]types.TokenTransferMode{
+			{Denom: baseDenom, Mode: types.TransferModeAllowlist},
+			{Denom: pointDenom, Mode: types.TransferModeOwnerOnly},
+		},
+		s.keeper.GetTransferModes(s.ctx),
+	)
+
+	// the unspecified mode removes the explicit mode
+	err = s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeUnspecified, pointOwner)
+	s.Require().NoErrorf(err, "failed to set transfer mode")
+
+	_, explicit := s.keeper.GetTransferMode(s.ctx, pointDenom)
+	s.Require().False(explicit)
+}
+
+func (s *KeeperTestSuite) TestTransferModePrecedence() {
+	testCases := []struct {
+		name         string
+		unrestricted bool
+		mode         types.TransferMode
+		expMode      types.TransferMode
+	}{
+		{"fallback to unrestricted transfer", true, types.TransferModeUnspecified, types.TransferModeUnrestricted},
+		{"fallback to restricted transfer", false, types.TransferModeUnspecified, types.TransferModeOwnerOnly},
+		{"owner only mode wins over unrestricted transfer", true, types.TransferModeOwnerOnly, types.TransferModeOwnerOnly},
+		{"allowlist mode wins over unrestricted transfer", true, types.TransferModeAllowlist, types.TransferModeAllowlist},
+		{"unrestricted mode wins over restricted transfer", false, types.TransferModeUnrestricted, types.TransferModeUnrestricted},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setParams(func(params *types.Params) {
+				params.UnrestrictedTokenTransfer = tc.unrestricted
+			})
+			s.Require().NoError(s.keeper.SetTransferMode(s.ctx, pointDenom, tc.mode, pointOwner))
+
+			mode, explicit := s.keeper.GetTransferMode(s.ctx, pointDenom)
+			s.Require().Equal(tc.expMode, mode)
+			s.Require().Equal(tc.mode != types.TransferModeUnspecified, explicit)
+
+			err := s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{accAlice.String()}, []string{accBob.String()})
+			if tc.expMode == types.TransferModeUnrestricted {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorIs(err, types.ErrUnauthorized)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestValidateTransfer() {
+	s.setParams(func(params *types.Params) {
+		params.UnrestrictedTokenTransfer = true
+	})
+	s.Require().NoError(s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeOwnerOnly, pointOwner))
+
+	alice, bob, owner := accAlice.String(), accBob.String(), pointOwner.String()
+
+	// the owner is allowed to send and receive
+	s.Require().NoError(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{owner}, []string{bob}))
+	s.Require().NoError(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{owner}))
+	s.Require().ErrorIs(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{bob}), types.ErrUnauthorized)
+
+	// the platform users are allowed to transfer
+	s.Require().NoError(s.permKeeper.Authorize(s.ctx, accAlice, rootAdmin, permtypes.RolePlatformUser))
+	s.Require().NoError(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{bob}))
+	s.Require().NoError(s.permKeeper.Unauthorize(s.ctx, accAlice, rootAdmin, permtypes.RolePlatformUser))
+
+	// both the sender and recipient must be allowlisted
+	s.Require().NoError(s.keeper.SetTransferMode(s.ctx, pointDenom, types.TransferModeAllowlist, pointOwner))
+
+	err := s.keeper.UpdateTransferAllowlist(s.ctx, pointDenom, []sdk.AccAddress{accAlice}, nil, accAlice)
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.Require().NoError(s.keeper.UpdateTransferAllowlist(s.ctx, pointDenom, []sdk.AccAddress{accAlice}, nil, pointOwner))
+	s.Require().ErrorIs(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{bob}), types.ErrUnauthorized)
+
+	s.Require().NoError(s.keeper.UpdateTransferAllowlist(s.ctx, pointDenom, []sdk.AccAddress{accBob}, nil, pointOwner))
+	s.Require().NoError(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{bob}))
+	s.Require().Equal(
+		[]types.TransferAllowlist{{Denom: pointDenom, Addresses: s.sortedAddresses(alice, bob)}},
+		s.keeper.GetTransferAllowlists(s.ctx),
+	)
+
+	s.Require().NoError(s.keeper.UpdateTransferAllowlist(s.ctx, pointDenom, nil, []sdk.AccAddress{accBob}, pointOwner))
+	s.Require().False(s.keeper.IsTransferAllowlisted(s.ctx, pointDenom, accBob))
+	s.Require().ErrorIs(s.keeper.ValidateTransfer(s.ctx, pointDenom, []string{alice}, []string{bob}), types.ErrUnauthorized)
+}
+
+// sortedAddresses returns the addresses in the order of the store keys
+func (s *KeeperTestSuite) sortedAddresses(a, b string) []string {
+	addrA, _ := sdk.AccAddressFromBech32(a)
+	addrB, _ := sdk.AccAddressFromBech32(b)
+
+	if string(addrA) < string(addrB) {
+		return []string{a, b}
+	}
+
+	return []string{b, a}
+}
@@ -0,0 +1,62 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// Parameter store keys of the v2 params, stored in the legacy param store
+var (
+	KeyBaseTokenDenom            = []byte("BaseTokenDenom")
+	KeyPointTokenDenom           = []byte("PointTokenDenom")
+	KeyBaseTokenManager          = []byte("BaseTokenManager")
+	KeyUnrestrictedTokenTransfer = []byte("UnrestrictedTokenTransfer")
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration includes:
+//
+//   - Move the params from the legacy param store to the module store:
+//     0x1a => Params
+//   - Delete the params from the legacy param store.
+//
+// The params absent from the legacy param store, including the ones introduced in v3,
+// are set to the default values.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, legacyParamsKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	legacyAmino := codec.NewLegacyAmino()
+	legacyStore := prefix.NewStore(ctx.KVStore(legacyParamsKey), append([]byte(types.ModuleName), '/'))
+
+	params := types.DefaultParams()
+	legacyPairs := paramstypes.ParamSetPairs{
+		paramstypes.NewParamSetPair(KeyBaseTokenDenom, &params.BaseTokenDenom, nil),
+		paramstypes.NewParamSetPair(KeyPointTokenDenom, &params.PointTokenDenom, nil),
+		paramstypes.NewParamSetPair(KeyBaseTokenManager, &params.BaseTokenManager, nil),
+		paramstypes.NewParamSetPair(KeyUnrestrictedTokenTransfer, &params.UnrestrictedTokenTransfer, nil),
+	}
+
+	for _, pair := range legacyPairs {
+		bz := legacyStore.Get(pair.Key)
+		if bz == nil {
+			continue
+		}
+
+		if err := legacyAmino.UnmarshalJSON(bz, pair.Value); err != nil {
+			return err
+		}
+
+		legacyStore.Delete(pair.Key)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsStoreKey(), cdc.MustMarshal(&params))
+
+	return nil
+}
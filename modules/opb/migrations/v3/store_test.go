@@ -0,0 +1,103 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v3 "github.com/aadhi0612/iritamod/modules/opb/migrations/v3"
+	"github.com/aadhi0612/iritamod/modules/opb/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+func setupContext(t *testing.T) (sdk.Context, sdk.StoreKey, sdk.StoreKey, paramstypes.Subspace) {
+	encCfg := simapp.MakeEncodingConfig()
+
+	opbKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(opbKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	// the v2 legacy param store
+	paramSpace := paramstypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(paramstypes.NewKeyTable(
+			paramstypes.NewParamSetPair(v3.KeyBaseTokenDenom, new(string), validateAny),
+			paramstypes.NewParamSetPair(v3.KeyPointTokenDenom, new(string), validateAny),
+			paramstypes.NewParamSetPair(v3.KeyBaseTokenManager, new(string), validateAny),
+			paramstypes.NewParamSetPair(v3.KeyUnrestrictedTokenTransfer, new(bool), validateAny),
+		))
+
+	return ctx, opbKey, paramsKey, paramSpace
+}
+
+// validateAny skips the validation of the v2 legacy params, which are set as is in the tests
+func validateAny(interface{}) error {
+	return nil
+}
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeEncodingConfig()
+	ctx, opbKey, paramsKey, paramSpace := setupContext(t)
+
+	manager := sdk.AccAddress(tmhash.SumTruncated([]byte("manager")))
+
+	paramSpace.Set(ctx, v3.KeyBaseTokenDenom, "ubase")
+	paramSpace.Set(ctx, v3.KeyPointTokenDenom, "upoint")
+	paramSpace.Set(ctx, v3.KeyBaseTokenManager, manager.String())
+	paramSpace.Set(ctx, v3.KeyUnrestrictedTokenTransfer, false)
+
+	require.NoError(t, v3.MigrateStore(ctx, opbKey, paramsKey, encCfg.Marshaler))
+
+	// the params introduced in v3 are set to the default values
+	expParams := types.DefaultParams()
+	expParams.BaseTokenDenom = "ubase"
+	expParams.PointTokenDenom = "upoint"
+	expParams.BaseTokenManager = manager.String()
+	expParams.UnrestrictedTokenTransfer = false
+
+	bz := ctx.KVStore(opbKey).Get(types.ParamsStoreKey())
+	require.NotNil(t, bz)
+
+	var params types.Params
+	encCfg.Marshaler.MustUnmarshal(bz, &params)
+	require.True(t, expParams.Equal(params), "expected %s, got %s", expParams, params)
+
+	// the legacy params are deleted so that they can no longer be updated
+	for _, key := range [][]byte{v3.KeyBaseTokenDenom, v3.KeyPointTokenDenom, v3.KeyBaseTokenManager, v3.KeyUnrestrictedTokenTransfer} {
+		require.False(t, paramSpace.Has(ctx, key), "legacy param %s not deleted", key)
+	}
+}
+
+func TestStoreMigrationAbsentParams(t *testing.T) {
+	encCfg := simapp.MakeEncodingConfig()
+	ctx, opbKey, paramsKey, paramSpace := setupContext(t)
+
+	// the v2 param store, with the base token denom only
+	paramSpace.Set(ctx, v3.KeyBaseTokenDenom, "ubase")
+
+	require.NoError(t, v3.MigrateStore(ctx, opbKey, paramsKey, encCfg.Marshaler))
+
+	expParams := types.DefaultParams()
+	expParams.BaseTokenDenom = "ubase"
+
+	var params types.Params
+	encCfg.Marshaler.MustUnmarshal(ctx.KVStore(opbKey).Get(types.ParamsStoreKey()), &params)
+	require.True(t, expParams.Equal(params), "expected %s, got %s", expParams, params)
+}
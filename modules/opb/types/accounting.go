@@ -0,0 +1,88 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMinterTotal creates a new MinterTotal instance
+func NewMinterTotal(minter sdk.AccAddress, amount uint64) MinterTotal {
+	return MinterTotal{
+		Minter: minter.String(),
+		Amount: amount,
+	}
+}
+
+// Validate validates the minter total
+func (t MinterTotal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Minter); err != nil {
+		return fmt.Errorf("invalid minter %s: %s", t.Minter, err)
+	}
+
+	return nil
+}
+
+// NewReclaimTotal creates a new ReclaimTotal instance
+func NewReclaimTotal(denom string, amount sdk.Int) ReclaimTotal {
+	return ReclaimTotal{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate validates the reclaim total
+func (t ReclaimTotal) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", t.Denom, err)
+	}
+
+	if t.Amount.IsNil() || t.Amount.IsNegative() {
+		return fmt.Errorf("reclaimed amount of %s can not be negative", t.Denom)
+	}
+
+	return nil
+}
+
+// NewReclaimRecord creates a new ReclaimRecord instance
+func NewReclaimRecord(
+	id uint64,
+	operator sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount sdk.Coin,
+	height int64,
+) ReclaimRecord {
+	return ReclaimRecord{
+		Id:        id,
+		Operator:  operator.String(),
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Height:    height,
+	}
+}
+
+// Validate validates the reclaim record
+func (r ReclaimRecord) Validate() error {
+	if r.Id == 0 {
+		return errors.New("reclaim record id must be greater than 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Operator); err != nil {
+		return fmt.Errorf("invalid operator %s: %s", r.Operator, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient %s: %s", r.Recipient, err)
+	}
+
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return fmt.Errorf("reclaim record %d: invalid amount %s", r.Id, r.Amount)
+	}
+
+	if r.Height < 0 {
+		return fmt.Errorf("reclaim record %d: height can not be negative", r.Id)
+	}
+
+	return nil
+}
@@ -24,12 +24,38 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMint{}, "irita/opb/MsgMint", nil)
 	cdc.RegisterConcrete(&MsgReclaim{}, "irita/opb/MsgReclaim", nil)
+	cdc.RegisterConcrete(&MsgSetMintAllowance{}, "irita/opb/MsgSetMintAllowance", nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, "irita/opb/MsgRedeem", nil)
+	cdc.RegisterConcrete(&MsgSettleRedemption{}, "irita/opb/MsgSettleRedemption", nil)
+	cdc.RegisterConcrete(&MsgRejectRedemption{}, "irita/opb/MsgRejectRedemption", nil)
+	cdc.RegisterConcrete(&MsgCreateReclaimSchedule{}, "irita/opb/MsgCreateReclaimSchedule", nil)
+	cdc.RegisterConcrete(&MsgDeleteReclaimSchedule{}, "irita/opb/MsgDeleteReclaimSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetTransferMode{}, "irita/opb/MsgSetTransferMode", nil)
+	cdc.RegisterConcrete(&MsgUpdateTransferAllowlist{}, "irita/opb/MsgUpdateTransferAllowlist", nil)
+	cdc.RegisterConcrete(&MsgSetTransferLimit{}, "irita/opb/MsgSetTransferLimit", nil)
+	cdc.RegisterConcrete(&MsgCreateEscrow{}, "irita/opb/MsgCreateEscrow", nil)
+	cdc.RegisterConcrete(&MsgReleaseEscrow{}, "irita/opb/MsgReleaseEscrow", nil)
+	cdc.RegisterConcrete(&MsgClaimEscrowRefund{}, "irita/opb/MsgClaimEscrowRefund", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "irita/opb/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMint{},
 		&MsgReclaim{},
+		&MsgSetMintAllowance{},
+		&MsgRedeem{},
+		&MsgSettleRedemption{},
+		&MsgRejectRedemption{},
+		&MsgCreateReclaimSchedule{},
+		&MsgDeleteReclaimSchedule{},
+		&MsgSetTransferMode{},
+		&MsgUpdateTransferAllowlist{},
+		&MsgSetTransferLimit{},
+		&MsgCreateEscrow{},
+		&MsgReleaseEscrow{},
+		&MsgClaimEscrowRefund{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -3,7 +3,19 @@ package types
 import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 var (
-	ErrInvalidAmount = sdkerrors.Register(ModuleName, 2, "invalid amount")
-	ErrInvalidDenom  = sdkerrors.Register(ModuleName, 3, "invalid denom")
-	ErrUnauthorized  = sdkerrors.Register(ModuleName, 4, "unauthorized operation")
+	ErrInvalidAmount         = sdkerrors.Register(ModuleName, 2, "invalid amount")
+	ErrInvalidDenom          = sdkerrors.Register(ModuleName, 3, "invalid denom")
+	ErrUnauthorized          = sdkerrors.Register(ModuleName, 4, "unauthorized operation")
+	ErrMintLimit             = sdkerrors.Register(ModuleName, 5, "mint limit exceeded")
+	ErrInvalidRedemption     = sdkerrors.Register(ModuleName, 6, "invalid redemption")
+	ErrUnknownRedemption     = sdkerrors.Register(ModuleName, 7, "unknown redemption")
+	ErrInvalidReclaim        = sdkerrors.Register(ModuleName, 8, "invalid reclaim")
+	ErrUnknownSchedule       = sdkerrors.Register(ModuleName, 9, "unknown reclaim schedule")
+	ErrInvalidTransferMode   = sdkerrors.Register(ModuleName, 10, "invalid transfer mode")
+	ErrInvalidAllowlist      = sdkerrors.Register(ModuleName, 11, "invalid transfer allowlist")
+	ErrInvalidTransferLimit  = sdkerrors.Register(ModuleName, 12, "invalid transfer limit")
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 13, "transfer limit exceeded")
+	ErrInvalidEscrow         = sdkerrors.Register(ModuleName, 14, "invalid escrow")
+	ErrUnknownEscrow         = sdkerrors.Register(ModuleName, 15, "unknown escrow")
+	ErrInvalidParams         = sdkerrors.Register(ModuleName, 16, "invalid params")
 )
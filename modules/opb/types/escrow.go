@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEscrow creates a new locked Escrow instance
+func NewEscrow(
+	id uint64,
+	payer, beneficiary, arbiter sdk.AccAddress,
+	amount sdk.Coin,
+	expiryHeight int64,
+	hashLock string,
+	createHeight int64,
+) Escrow {
+	return Escrow{
+		Id:           id,
+		Payer:        payer.String(),
+		Beneficiary:  beneficiary.String(),
+		Arbiter:      arbiter.String(),
+		Amount:       amount,
+		ExpiryHeight: expiryHeight,
+		HashLock:     hashLock,
+		Status:       EscrowStatusLocked,
+		CreateHeight: createHeight,
+	}
+}
+
+// Validate validates the escrow
+func (e Escrow) Validate() error {
+	if e.Id == 0 {
+		return errors.New("escrow id must be greater than 0")
+	}
+
+	if err := ValidateEscrowParties(e.Payer, e.Beneficiary, e.Arbiter); err != nil {
+		return fmt.Errorf("escrow %d: %s", e.Id, err)
+	}
+
+	if !e.Amount.IsValid() || e.Amount.IsZero() {
+		return fmt.Errorf("escrow %d: invalid amount %s", e.Id, e.Amount)
+	}
+
+	if err := ValidateHashLock(e.HashLock); err != nil {
+		return fmt.Errorf("escrow %d: %s", e.Id, err)
+	}
+
+	if _, ok := EscrowStatus_name[int32(e.Status)]; !ok {
+		return fmt.Errorf("escrow %d: invalid status (%d)", e.Id, e.Status)
+	}
+
+	if e.CreateHeight < 0 || e.ResolveHeight < 0 {
+		return fmt.Errorf("escrow %d: height can not be negative", e.Id)
+	}
+
+	if e.ExpiryHeight <= e.CreateHeight {
+		return fmt.Errorf("escrow %d: expiry height must be greater than the create height", e.Id)
+	}
+
+	return nil
+}
+
+// ValidateEscrowParties validates the payer, beneficiary and arbiter of an escrow
+func ValidateEscrowParties(payer, beneficiary, arbiter string) error {
+	if _, err := sdk.AccAddressFromBech32(payer); err != nil {
+		return fmt.Errorf("invalid payer %s: %s", payer, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(beneficiary); err != nil {
+		return fmt.Errorf("invalid beneficiary %s: %s", beneficiary, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(arbiter); err != nil {
+		return fmt.Errorf("invalid arbiter %s: %s", arbiter, err)
+	}
+
+	if payer == beneficiary {
+		return errors.New("payer and beneficiary can not be the same")
+	}
+
+	return nil
+}
+
+// ValidateHashLock validates the hash lock, which is either empty or a hex-encoded SHA-256 hash
+func ValidateHashLock(hashLock string) error {
+	if len(hashLock) == 0 {
+		return nil
+	}
+
+	bz, err := hex.DecodeString(hashLock)
+	if err != nil {
+		return fmt.Errorf("invalid hash lock %s: %s", hashLock, err)
+	}
+
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("length of the hash lock must be %d bytes", sha256.Size)
+	}
+
+	return nil
+}
+
+// ValidatePreimage validates the preimage, which is either empty or hex-encoded
+func ValidatePreimage(preimage string) error {
+	if len(preimage) > MaxPreimageLength*2 {
+		return fmt.Errorf("length of the preimage cannot be greater than %d bytes", MaxPreimageLength)
+	}
+
+	if _, err := hex.DecodeString(preimage); err != nil {
+		return fmt.Errorf("invalid preimage: %s", err)
+	}
+
+	return nil
+}
+
+// Unlocks returns true if the hex-encoded preimage hashes to the hash lock of the escrow
+// False otherwise, including the escrow without a hash lock
+func (e Escrow) Unlocks(preimage string) bool {
+	if len(e.HashLock) == 0 || len(preimage) == 0 {
+		return false
+	}
+
+	hashLock, err := hex.DecodeString(e.HashLock)
+	if err != nil {
+		return false
+	}
+
+	bz, err := hex.DecodeString(preimage)
+	if err != nil {
+		return false
+	}
+
+	hash := sha256.Sum256(bz)
+	return bytes.Equal(hash[:], hashLock)
+}
+
+// EscrowStatusFromString parses the escrow status from the given string,
+// which is either the short form (e.g. locked) or the full enum name
+func EscrowStatusFromString(str string) (EscrowStatus, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "ESCROW_STATUS_") {
+		name = "ESCROW_STATUS_" + name
+	}
+
+	status, ok := EscrowStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid escrow status %s", str)
+	}
+
+	return EscrowStatus(status), nil
+}
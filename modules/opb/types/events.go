@@ -2,11 +2,50 @@ package types
 
 // OPB module event types
 const (
-	EventTypeMint    = "mint"
-	EventTypeReclaim = "reclaim"
+	EventTypeMint                    = "mint"
+	EventTypeReclaim                 = "reclaim"
+	EventTypeSetMintAllowance        = "set_mint_allowance"
+	EventTypeRedeem                  = "redeem"
+	EventTypeSettleRedemption        = "settle_redemption"
+	EventTypeRejectRedemption        = "reject_redemption"
+	EventTypeCreateReclaimSchedule   = "create_reclaim_schedule"
+	EventTypeDeleteReclaimSchedule   = "delete_reclaim_schedule"
+	EventTypeScheduledReclaim        = "scheduled_reclaim"
+	EventTypeSetTransferMode         = "set_transfer_mode"
+	EventTypeUpdateTransferAllowlist = "update_transfer_allowlist"
+	EventTypeSetTransferLimit        = "set_transfer_limit"
+	EventTypeTransferLimitExceeded   = "transfer_limit_exceeded"
+	EventTypeCreateEscrow            = "create_escrow"
+	EventTypeReleaseEscrow           = "release_escrow"
+	EventTypeRefundEscrow            = "refund_escrow"
+	EventTypeRefundEscrowFailed      = "refund_escrow_failed"
+	EventTypeUpdateParams            = "update_params"
 
-	AttributeKeyAmount     = "amount"
-	AttributeKeyDenom      = "denom"
-	AttributeKeyRecipient  = "recipient"
-	AttributeValueCategory = ModuleName
+	AttributeKeyAmount        = "amount"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeyMinter        = "minter"
+	AttributeKeyRecordID      = "record_id"
+	AttributeKeyRemaining     = "remaining_allowance"
+	AttributeKeyRedemptionID  = "redemption_id"
+	AttributeKeyHolder        = "holder"
+	AttributeKeySettlementRef = "settlement_ref"
+	AttributeKeyReason        = "reason"
+	AttributeKeyScheduleID    = "schedule_id"
+	AttributeKeyInterval      = "interval"
+	AttributeKeyNextHeight    = "next_height"
+	AttributeKeyError         = "error"
+	AttributeKeyTransferMode  = "transfer_mode"
+	AttributeKeyAdded         = "added"
+	AttributeKeyRemoved       = "removed"
+	AttributeKeyAddress       = "address"
+	AttributeKeyLimit         = "limit"
+	AttributeKeyAction        = "action"
+	AttributeKeyEscrowID      = "escrow_id"
+	AttributeKeyPayer         = "payer"
+	AttributeKeyBeneficiary   = "beneficiary"
+	AttributeKeyArbiter       = "arbiter"
+	AttributeKeyExpiryHeight  = "expiry_height"
+	AttributeKeyHashLock      = "hash_lock"
+	AttributeValueCategory    = ModuleName
 )
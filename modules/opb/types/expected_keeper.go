@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // TokenKeeper defines the expected token keeper (noalias)
@@ -14,19 +15,30 @@ type TokenKeeper interface {
 // BankKeeper defines the expected bank keeper (noalias)
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
 type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// FeegrantKeeper defines the expected feegrant keeper (noalias)
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
 
 // PermKeeper defines the expected perm keeper (noalias)
 type PermKeeper interface {
 	IsRootAdmin(ctx sdk.Context, address sdk.AccAddress) bool
+	IsParamAdmin(ctx sdk.Context, address sdk.AccAddress) bool
 	IsBaseM1Admin(ctx sdk.Context, address sdk.AccAddress) bool
 	IsPlatformUser(ctx sdk.Context, address sdk.AccAddress) bool
+	GetBlockAccount(ctx sdk.Context, address sdk.AccAddress) bool
 }
 
 type Token interface {
@@ -1,9 +1,45 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(
+	params Params,
+	mintAllowances []MintAllowance,
+	mintRecords []MintRecord,
+	totalMinted uint64,
+	epochMint EpochMint,
+	redemptions []Redemption,
+	reclaimSchedules []ReclaimSchedule,
+	transferModes []TokenTransferMode,
+	transferAllowlists []TransferAllowlist,
+	transferLimits []TransferLimit,
+	transferUsages []TransferUsage,
+	minterTotals []MinterTotal,
+	reclaimTotals []ReclaimTotal,
+	reclaimRecords []ReclaimRecord,
+	escrows []Escrow,
+) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:             params,
+		MintAllowances:     mintAllowances,
+		MintRecords:        mintRecords,
+		TotalMinted:        totalMinted,
+		EpochMint:          epochMint,
+		Redemptions:        redemptions,
+		ReclaimSchedules:   reclaimSchedules,
+		TransferModes:      transferModes,
+		TransferAllowlists: transferAllowlists,
+		TransferLimits:     transferLimits,
+		TransferUsages:     transferUsages,
+		MinterTotals:       minterTotals,
+		ReclaimTotals:      reclaimTotals,
+		ReclaimRecords:     reclaimRecords,
+		Escrows:            escrows,
 	}
 }
 
@@ -20,5 +56,151 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+
+	minters := make(map[string]bool)
+	for _, allowance := range data.MintAllowances {
+		if _, err := sdk.AccAddressFromBech32(allowance.Minter); err != nil {
+			return fmt.Errorf("invalid minter %s: %s", allowance.Minter, err)
+		}
+
+		if minters[allowance.Minter] {
+			return fmt.Errorf("duplicate mint allowance for %s", allowance.Minter)
+		}
+		minters[allowance.Minter] = true
+	}
+
+	recordIds := make(map[uint64]bool)
+	for _, record := range data.MintRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if recordIds[record.Id] {
+			return fmt.Errorf("duplicate mint record %d", record.Id)
+		}
+		recordIds[record.Id] = true
+	}
+
+	redemptionIds := make(map[uint64]bool)
+	for _, redemption := range data.Redemptions {
+		if err := redemption.Validate(); err != nil {
+			return err
+		}
+
+		if redemptionIds[redemption.Id] {
+			return fmt.Errorf("duplicate redemption %d", redemption.Id)
+		}
+		redemptionIds[redemption.Id] = true
+	}
+
+	scheduleIds := make(map[uint64]bool)
+	for _, schedule := range data.ReclaimSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if scheduleIds[schedule.Id] {
+			return fmt.Errorf("duplicate reclaim schedule %d", schedule.Id)
+		}
+		scheduleIds[schedule.Id] = true
+	}
+
+	modeDenoms := make(map[string]bool)
+	for _, mode := range data.TransferModes {
+		if err := mode.Validate(); err != nil {
+			return err
+		}
+
+		if modeDenoms[mode.Denom] {
+			return fmt.Errorf("duplicate transfer mode for %s", mode.Denom)
+		}
+		modeDenoms[mode.Denom] = true
+	}
+
+	allowlistDenoms := make(map[string]bool)
+	for _, allowlist := range data.TransferAllowlists {
+		if err := allowlist.Validate(); err != nil {
+			return err
+		}
+
+		if allowlistDenoms[allowlist.Denom] {
+			return fmt.Errorf("duplicate transfer allowlist for %s", allowlist.Denom)
+		}
+		allowlistDenoms[allowlist.Denom] = true
+	}
+
+	limitDenoms := make(map[string]bool)
+	for _, limit := range data.TransferLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		if limitDenoms[limit.Denom] {
+			return fmt.Errorf("duplicate transfer limit for %s", limit.Denom)
+		}
+		limitDenoms[limit.Denom] = true
+	}
+
+	usageKeys := make(map[string]bool)
+	for _, usage := range data.TransferUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+
+		key := usage.Denom + "/" + usage.Address
+		if usageKeys[key] {
+			return fmt.Errorf("duplicate transfer usage of %s for %s", usage.Denom, usage.Address)
+		}
+		usageKeys[key] = true
+	}
+
+	totalMinters := make(map[string]bool)
+	for _, total := range data.MinterTotals {
+		if err := total.Validate(); err != nil {
+			return err
+		}
+
+		if totalMinters[total.Minter] {
+			return fmt.Errorf("duplicate minter total for %s", total.Minter)
+		}
+		totalMinters[total.Minter] = true
+	}
+
+	reclaimDenoms := make(map[string]bool)
+	for _, total := range data.ReclaimTotals {
+		if err := total.Validate(); err != nil {
+			return err
+		}
+
+		if reclaimDenoms[total.Denom] {
+			return fmt.Errorf("duplicate reclaim total for %s", total.Denom)
+		}
+		reclaimDenoms[total.Denom] = true
+	}
+
+	reclaimRecordIds := make(map[uint64]bool)
+	for _, record := range data.ReclaimRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if reclaimRecordIds[record.Id] {
+			return fmt.Errorf("duplicate reclaim record %d", record.Id)
+		}
+		reclaimRecordIds[record.Id] = true
+	}
+
+	escrowIds := make(map[uint64]bool)
+	for _, escrow := range data.Escrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+
+		if escrowIds[escrow.Id] {
+			return fmt.Errorf("duplicate escrow %d", escrow.Id)
+		}
+		escrowIds[escrow.Id] = true
+	}
+
 	return nil
 }
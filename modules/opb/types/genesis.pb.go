@@ -25,7 +25,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the OPB module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params             Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	MintAllowances     []MintAllowance     `protobuf:"bytes,2,rep,name=mint_allowances,json=mintAllowances,proto3" json:"mint_allowances"`
+	MintRecords        []MintRecord        `protobuf:"bytes,3,rep,name=mint_records,json=mintRecords,proto3" json:"mint_records"`
+	TotalMinted        uint64              `protobuf:"varint,4,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted,omitempty"`
+	EpochMint          EpochMint           `protobuf:"bytes,5,opt,name=epoch_mint,json=epochMint,proto3" json:"epoch_mint"`
+	Redemptions        []Redemption        `protobuf:"bytes,6,rep,name=redemptions,proto3" json:"redemptions"`
+	ReclaimSchedules   []ReclaimSchedule   `protobuf:"bytes,7,rep,name=reclaim_schedules,json=reclaimSchedules,proto3" json:"reclaim_schedules"`
+	TransferModes      []TokenTransferMode `protobuf:"bytes,8,rep,name=transfer_modes,json=transferModes,proto3" json:"transfer_modes"`
+	TransferAllowlists []TransferAllowlist `protobuf:"bytes,9,rep,name=transfer_allowlists,json=transferAllowlists,proto3" json:"transfer_allowlists"`
+	TransferLimits     []TransferLimit     `protobuf:"bytes,10,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	TransferUsages     []TransferUsage     `protobuf:"bytes,11,rep,name=transfer_usages,json=transferUsages,proto3" json:"transfer_usages"`
+	MinterTotals       []MinterTotal       `protobuf:"bytes,12,rep,name=minter_totals,json=minterTotals,proto3" json:"minter_totals"`
+	ReclaimTotals      []ReclaimTotal      `protobuf:"bytes,13,rep,name=reclaim_totals,json=reclaimTotals,proto3" json:"reclaim_totals"`
+	ReclaimRecords     []ReclaimRecord     `protobuf:"bytes,14,rep,name=reclaim_records,json=reclaimRecords,proto3" json:"reclaim_records"`
+	Escrows            []Escrow            `protobuf:"bytes,15,rep,name=escrows,proto3" json:"escrows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +82,104 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintAllowances() []MintAllowance {
+	if m != nil {
+		return m.MintAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintRecords() []MintRecord {
+	if m != nil {
+		return m.MintRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalMinted() uint64 {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochMint() EpochMint {
+	if m != nil {
+		return m.EpochMint
+	}
+	return EpochMint{}
+}
+
+func (m *GenesisState) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReclaimSchedules() []ReclaimSchedule {
+	if m != nil {
+		return m.ReclaimSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferModes() []TokenTransferMode {
+	if m != nil {
+		return m.TransferModes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferAllowlists() []TransferAllowlist {
+	if m != nil {
+		return m.TransferAllowlists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferLimits() []TransferLimit {
+	if m != nil {
+		return m.TransferLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferUsages() []TransferUsage {
+	if m != nil {
+		return m.TransferUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterTotals() []MinterTotal {
+	if m != nil {
+		return m.MinterTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReclaimTotals() []ReclaimTotal {
+	if m != nil {
+		return m.ReclaimTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReclaimRecords() []ReclaimRecord {
+	if m != nil {
+		return m.ReclaimRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "iritamod.opb.GenesisState")
 }
@@ -75,20 +187,42 @@ func init() {
 func init() { proto.RegisterFile("opb/genesis.proto", fileDescriptor_f7c56f938f95521f) }
 
 var fileDescriptor_f7c56f938f95521f = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcc, 0x2f, 0x48, 0xd2,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xc9,
-	0x2c, 0xca, 0x2c, 0x49, 0xcc, 0xcd, 0x4f, 0xd1, 0xcb, 0x2f, 0x48, 0x92, 0xe2, 0x05, 0x29, 0xc8,
-	0x2f, 0x48, 0x82, 0x48, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44,
-	0x54, 0xc9, 0x89, 0x8b, 0xc7, 0x1d, 0x62, 0x46, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x11, 0x17,
-	0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e,
-	0xb2, 0x99, 0x7a, 0x01, 0x60, 0x39, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x2a, 0x9d,
-	0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x29, 0x33, 0x31, 0x2f, 0x2b, 0x33, 0x35, 0x31,
-	0x53, 0x1f, 0x66, 0xa2, 0x7e, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0x6a, 0x31, 0xc8, 0x91, 0xfa, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x57, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x30,
-	0xe3, 0x26, 0xf7, 0xdd, 0x00, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x1b, 0x36, 0xf6, 0xc7, 0x49, 0x3b, 0x66, 0x26, 0x61, 0x8a, 0xc8, 0x0a, 0xa7, 0x9e,
+	0x1a, 0x28, 0x88, 0x13, 0x07, 0x3a, 0x31, 0x4d, 0xa0, 0x55, 0x9a, 0xba, 0xc2, 0x81, 0x4b, 0xe5,
+	0x26, 0xa6, 0xb5, 0x88, 0xe3, 0xc8, 0xaf, 0xab, 0x89, 0x6f, 0xc1, 0x85, 0xef, 0xb4, 0xe3, 0x8e,
+	0x9c, 0x10, 0x6a, 0xbf, 0x08, 0x8a, 0x6b, 0x97, 0xa4, 0xad, 0xb8, 0x59, 0xbf, 0xf7, 0xf1, 0x63,
+	0x5b, 0x7e, 0x6d, 0x74, 0x2c, 0xf3, 0x71, 0x34, 0x61, 0x19, 0x03, 0x0e, 0x9d, 0x5c, 0x49, 0x2d,
+	0x71, 0xc0, 0x15, 0xd7, 0x54, 0xc8, 0xa4, 0x23, 0xf3, 0x71, 0xb3, 0x5e, 0x00, 0x32, 0x1f, 0x2f,
+	0x8b, 0xcd, 0x93, 0x89, 0x9c, 0x48, 0x33, 0x8c, 0x8a, 0xd1, 0x32, 0x7d, 0xfe, 0xf3, 0x00, 0x05,
+	0x17, 0x4b, 0xc9, 0xb5, 0xa6, 0x9a, 0xe1, 0x2e, 0xda, 0xcb, 0xa9, 0xa2, 0x02, 0x88, 0xd7, 0xf2,
+	0xda, 0x7e, 0xf7, 0xa4, 0x53, 0x96, 0x76, 0xae, 0x4c, 0xed, 0x6c, 0xf7, 0xf6, 0xf7, 0x69, 0x6d,
+	0x60, 0x49, 0xfc, 0x11, 0x1d, 0x09, 0x9e, 0xe9, 0x11, 0x4d, 0x53, 0x79, 0x43, 0xb3, 0x98, 0x01,
+	0xb9, 0xd7, 0xda, 0x69, 0xfb, 0xdd, 0x27, 0xd5, 0xc9, 0x7d, 0x9e, 0xe9, 0x9e, 0x63, 0xac, 0xa3,
+	0x21, 0xca, 0x21, 0xe0, 0x1e, 0x0a, 0x8c, 0x4b, 0xb1, 0x58, 0xaa, 0x04, 0xc8, 0x8e, 0x11, 0x91,
+	0x4d, 0xd1, 0xc0, 0x00, 0xd6, 0xe2, 0x8b, 0x55, 0x02, 0xf8, 0x19, 0x0a, 0xb4, 0xd4, 0x34, 0x1d,
+	0x15, 0x21, 0x4b, 0xc8, 0x6e, 0xcb, 0x6b, 0xef, 0x0e, 0x7c, 0x93, 0xf5, 0x4d, 0x84, 0xdf, 0x22,
+	0xc4, 0x72, 0x19, 0x4f, 0x0d, 0x42, 0xee, 0x9b, 0x93, 0x3e, 0xaa, 0xae, 0x71, 0x5e, 0xd4, 0x0b,
+	0xdc, 0x2e, 0x71, 0xc8, 0x5c, 0x80, 0xdf, 0x21, 0x5f, 0xb1, 0x84, 0x89, 0x5c, 0x73, 0x99, 0x01,
+	0xd9, 0xdb, 0xb6, 0xc5, 0xc1, 0x0a, 0x70, 0x5b, 0x2c, 0x4d, 0xc1, 0x57, 0xe8, 0x58, 0xb1, 0x38,
+	0xa5, 0x5c, 0x8c, 0x20, 0x9e, 0xb2, 0x64, 0x96, 0x32, 0x20, 0xfb, 0xc6, 0xf3, 0x74, 0xdd, 0x63,
+	0xb0, 0x6b, 0x4b, 0x59, 0xd9, 0x03, 0x55, 0x8d, 0x01, 0x5f, 0xa2, 0x86, 0x56, 0x34, 0x83, 0xaf,
+	0x4c, 0x8d, 0x84, 0x4c, 0x18, 0x90, 0x03, 0xa3, 0x3b, 0xad, 0xea, 0x86, 0xf2, 0x1b, 0xcb, 0x86,
+	0x16, 0xec, 0xcb, 0xc4, 0x09, 0xeb, 0xba, 0x94, 0x01, 0xfe, 0x8c, 0x1e, 0xae, 0x6c, 0xe6, 0x56,
+	0x53, 0x0e, 0x1a, 0xc8, 0xe1, 0x56, 0xa5, 0x05, 0x7b, 0x8e, 0xb3, 0x4a, 0xac, 0xd7, 0x0b, 0xa6,
+	0x53, 0x56, 0xde, 0x94, 0x0b, 0xae, 0x81, 0xa0, 0x6d, 0x9d, 0xe2, 0x9c, 0x97, 0x05, 0xe3, 0x3a,
+	0x45, 0x97, 0xc3, 0xaa, 0x6b, 0x06, 0x74, 0xc2, 0x80, 0xf8, 0xff, 0x73, 0x7d, 0x2a, 0x98, 0x75,
+	0x97, 0x09, 0x01, 0xbf, 0x47, 0x75, 0xd3, 0x2c, 0x6a, 0x64, 0xba, 0x04, 0x48, 0x60, 0x4c, 0x8f,
+	0x37, 0xdb, 0x8e, 0xa9, 0x61, 0x41, 0x58, 0x4f, 0x20, 0xfe, 0x45, 0x80, 0x2f, 0x50, 0xc3, 0xdd,
+	0xaa, 0xd5, 0xd4, 0x8d, 0xa6, 0xb9, 0xf5, 0x4a, 0xcb, 0x9e, 0xba, 0x2a, 0x65, 0xe6, 0x68, 0x4e,
+	0xe4, 0xde, 0x41, 0x63, 0xdb, 0xd1, 0xac, 0xa9, 0xf2, 0x14, 0x1a, 0xaa, 0x1c, 0x02, 0x7e, 0x8d,
+	0xf6, 0x19, 0xc4, 0x4a, 0xde, 0x00, 0x39, 0x6a, 0xed, 0x6c, 0xbe, 0xe8, 0x73, 0x53, 0xb4, 0x93,
+	0x1d, 0x7a, 0xf6, 0xe1, 0x76, 0x1e, 0x7a, 0x77, 0xf3, 0xd0, 0xfb, 0x33, 0x0f, 0xbd, 0x1f, 0x8b,
+	0xb0, 0x76, 0xb7, 0x08, 0x6b, 0xbf, 0x16, 0x61, 0xed, 0x4b, 0x34, 0xe1, 0x7a, 0x3a, 0x1b, 0x77,
+	0x62, 0x29, 0x22, 0x4a, 0x93, 0x29, 0x7f, 0xf1, 0xe6, 0x65, 0x37, 0x72, 0xca, 0x48, 0x48, 0xd3,
+	0x8f, 0xc5, 0xc7, 0x13, 0xe9, 0xef, 0x39, 0x83, 0xf1, 0x9e, 0xf9, 0x69, 0x5e, 0xfd, 0x1d, 0x00,
+	0xf3, 0xbe, 0xd6, 0xfb, 0xb1, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +245,189 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ReclaimRecords) > 0 {
+		for iNdEx := len(m.ReclaimRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReclaimRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ReclaimTotals) > 0 {
+		for iNdEx := len(m.ReclaimTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReclaimTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.MinterTotals) > 0 {
+		for iNdEx := len(m.MinterTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for iNdEx := len(m.TransferUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.TransferAllowlists) > 0 {
+		for iNdEx := len(m.TransferAllowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferAllowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TransferModes) > 0 {
+		for iNdEx := len(m.TransferModes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferModes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReclaimSchedules) > 0 {
+		for iNdEx := len(m.ReclaimSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReclaimSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.EpochMint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalMinted != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalMinted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MintRecords) > 0 {
+		for iNdEx := len(m.MintRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MintAllowances) > 0 {
+		for iNdEx := len(m.MintAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +460,83 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintAllowances) > 0 {
+		for _, e := range m.MintAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintRecords) > 0 {
+		for _, e := range m.MintRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalMinted != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalMinted))
+	}
+	l = m.EpochMint.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReclaimSchedules) > 0 {
+		for _, e := range m.ReclaimSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferModes) > 0 {
+		for _, e := range m.TransferModes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferAllowlists) > 0 {
+		for _, e := range m.TransferAllowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferUsages) > 0 {
+		for _, e := range m.TransferUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterTotals) > 0 {
+		for _, e := range m.MinterTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReclaimTotals) > 0 {
+		for _, e := range m.ReclaimTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReclaimRecords) > 0 {
+		for _, e := range m.ReclaimRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +608,466 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintAllowances = append(m.MintAllowances, MintAllowance{})
+			if err := m.MintAllowances[len(m.MintAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecords = append(m.MintRecords, MintRecord{})
+			if err := m.MintRecords[len(m.MintRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			m.TotalMinted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalMinted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReclaimSchedules = append(m.ReclaimSchedules, ReclaimSchedule{})
+			if err := m.ReclaimSchedules[len(m.ReclaimSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferModes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferModes = append(m.TransferModes, TokenTransferMode{})
+			if err := m.TransferModes[len(m.TransferModes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAllowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferAllowlists = append(m.TransferAllowlists, TransferAllowlist{})
+			if err := m.TransferAllowlists[len(m.TransferAllowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimit{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferUsages = append(m.TransferUsages, TransferUsage{})
+			if err := m.TransferUsages[len(m.TransferUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterTotals = append(m.MinterTotals, MinterTotal{})
+			if err := m.MinterTotals[len(m.MinterTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReclaimTotals = append(m.ReclaimTotals, ReclaimTotal{})
+			if err := m.ReclaimTotals[len(m.ReclaimTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReclaimRecords = append(m.ReclaimRecords, ReclaimRecord{})
+			if err := m.ReclaimRecords[len(m.ReclaimRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the OPB module
 	ModuleName = "opb"
@@ -15,4 +20,293 @@ const (
 
 	// PointTokenFeeCollectorName is the root string for the fee collector account address for the point token
 	PointTokenFeeCollectorName = "opb_point_token_fee_collector"
+
+	// RedemptionEscrowName is the root string for the escrow account address for the redeemed tokens
+	RedemptionEscrowName = "opb_redemption_escrow"
+
+	// EscrowAccountName is the root string for the escrow account address for the conditional transfers
+	EscrowAccountName = "opb_escrow"
+
+	// MaxSettlementRefLength is the max length of the settlement reference of a redemption
+	MaxSettlementRefLength = 128
+
+	// MaxRejectReasonLength is the max length of the reason of a rejected redemption
+	MaxRejectReasonLength = 256
+
+	// MaxReclaimSplits is the max number of the recipients of a reclaim
+	MaxReclaimSplits = 10
+
+	// MaxAllowlistUpdates is the max number of the addresses added or removed by an allowlist update
+	MaxAllowlistUpdates = 100
+
+	// MaxPreimageLength is the max length in bytes of the preimage releasing a hash-locked escrow
+	MaxPreimageLength = 64
+)
+
+var (
+	// Mint storekey prefix
+	KeyPrefixMintAllowance      = []byte{0x01}
+	KeyPrefixMintRecordSequence = []byte{0x02}
+	KeyPrefixMintRecord         = []byte{0x03}
+	KeyPrefixMintRecordOfMinter = []byte{0x04}
+	KeyPrefixTotalMinted        = []byte{0x05}
+	KeyPrefixEpochMint          = []byte{0x06}
+
+	// Redemption storekey prefix
+	KeyPrefixRedemptionSequence = []byte{0x07}
+	KeyPrefixRedemption         = []byte{0x08}
+	KeyPrefixRedemptionOfHolder = []byte{0x09}
+
+	// ReclaimSchedule storekey prefix
+	KeyPrefixReclaimScheduleSequence = []byte{0x0a}
+	KeyPrefixReclaimSchedule         = []byte{0x0b}
+	KeyPrefixReclaimScheduleQueue    = []byte{0x0c}
+
+	// Transfer restriction storekey prefix
+	KeyPrefixTransferMode      = []byte{0x0d}
+	KeyPrefixTransferAllowlist = []byte{0x0e}
+
+	// Transfer limit storekey prefix
+	KeyPrefixTransferLimit = []byte{0x0f}
+	KeyPrefixTransferUsage = []byte{0x10}
+
+	// Accounting storekey prefix
+	KeyPrefixMinterTotal           = []byte{0x11}
+	KeyPrefixReclaimTotal          = []byte{0x12}
+	KeyPrefixReclaimRecordSequence = []byte{0x13}
+	KeyPrefixReclaimRecord         = []byte{0x14}
+	KeyPrefixMintRecordByHeight    = []byte{0x15}
+	KeyPrefixReclaimRecordByHeight = []byte{0x16}
+
+	// Escrow storekey prefix
+	KeyPrefixEscrowSequence = []byte{0x17}
+	KeyPrefixEscrow         = []byte{0x18}
+	KeyPrefixEscrowQueue    = []byte{0x19}
+
+	// Params storekey prefix
+	KeyPrefixParams = []byte{0x1a}
+
+	Placeholder = []byte{0x01}
 )
+
+// MintAllowanceStoreKey returns the byte representation of the mint allowance key
+// Items are stored with the following key: values
+// <0x01><minter>
+func MintAllowanceStoreKey(minter sdk.AccAddress) []byte {
+	return append(KeyPrefixMintAllowance, address.MustLengthPrefix(minter)...)
+}
+
+// MintRecordSequenceStoreKey returns the byte representation of the mint record sequence key
+func MintRecordSequenceStoreKey() []byte {
+	return KeyPrefixMintRecordSequence
+}
+
+// MintRecordStoreKey returns the byte representation of the mint record key
+// Items are stored with the following key: values
+// <0x03><id>
+func MintRecordStoreKey(id uint64) []byte {
+	return append(KeyPrefixMintRecord, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MintRecordOfMinterPrefixKey returns the prefix of the mint records of the given minter
+// Items are stored with the following key: values
+// <0x04><minter><id>
+func MintRecordOfMinterPrefixKey(minter sdk.AccAddress) []byte {
+	return append(KeyPrefixMintRecordOfMinter, address.MustLengthPrefix(minter)...)
+}
+
+// MintRecordOfMinterStoreKey returns the byte representation of the mint record of minter key
+func MintRecordOfMinterStoreKey(minter sdk.AccAddress, id uint64) []byte {
+	return append(MintRecordOfMinterPrefixKey(minter), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TotalMintedStoreKey returns the byte representation of the total minted key
+func TotalMintedStoreKey() []byte {
+	return KeyPrefixTotalMinted
+}
+
+// EpochMintStoreKey returns the byte representation of the epoch mint key
+func EpochMintStoreKey() []byte {
+	return KeyPrefixEpochMint
+}
+
+// RedemptionSequenceStoreKey returns the byte representation of the redemption sequence key
+func RedemptionSequenceStoreKey() []byte {
+	return KeyPrefixRedemptionSequence
+}
+
+// RedemptionStoreKey returns the byte representation of the redemption key
+// Items are stored with the following key: values
+// <0x08><id>
+func RedemptionStoreKey(id uint64) []byte {
+	return append(KeyPrefixRedemption, sdk.Uint64ToBigEndian(id)...)
+}
+
+// RedemptionOfHolderPrefixKey returns the prefix of the redemptions of the given holder
+// Items are stored with the following key: values
+// <0x09><holder><id>
+func RedemptionOfHolderPrefixKey(holder sdk.AccAddress) []byte {
+	return append(KeyPrefixRedemptionOfHolder, address.MustLengthPrefix(holder)...)
+}
+
+// RedemptionOfHolderStoreKey returns the byte representation of the redemption of holder key
+func RedemptionOfHolderStoreKey(holder sdk.AccAddress, id uint64) []byte {
+	return append(RedemptionOfHolderPrefixKey(holder), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ReclaimScheduleSequenceStoreKey returns the byte representation of the reclaim schedule sequence key
+func ReclaimScheduleSequenceStoreKey() []byte {
+	return KeyPrefixReclaimScheduleSequence
+}
+
+// ReclaimScheduleStoreKey returns the byte representation of the reclaim schedule key
+// Items are stored with the following key: values
+// <0x0b><id>
+func ReclaimScheduleStoreKey(id uint64) []byte {
+	return append(KeyPrefixReclaimSchedule, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ReclaimScheduleQueueStoreKey returns the byte representation of the reclaim schedule queue key
+// Items are stored with the following key: values
+// <0x0c><next_height><id>
+func ReclaimScheduleQueueStoreKey(nextHeight int64, id uint64) []byte {
+	return append(ReclaimScheduleQueueByHeightStoreKey(nextHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ReclaimScheduleQueueByHeightStoreKey returns the key prefix of the reclaim schedules due at the height
+// <0x0c><next_height>
+func ReclaimScheduleQueueByHeightStoreKey(nextHeight int64) []byte {
+	return append(append([]byte{}, KeyPrefixReclaimScheduleQueue...), sdk.Uint64ToBigEndian(uint64(nextHeight))...)
+}
+
+// SplitReclaimScheduleQueueStoreKey splits the reclaim schedule queue key into the next height and schedule id
+func SplitReclaimScheduleQueueStoreKey(key []byte) (nextHeight int64, id uint64) {
+	key = key[len(KeyPrefixReclaimScheduleQueue):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
+
+// TransferModeStoreKey returns the byte representation of the transfer mode key
+// Items are stored with the following key: values
+// <0x0d><denom>
+func TransferModeStoreKey(denom string) []byte {
+	return append(KeyPrefixTransferMode, denom...)
+}
+
+// TransferAllowlistPrefixKey returns the prefix of the transfer allowlist of the given denom
+// Items are stored with the following key: values
+// <0x0e><len><denom><address>
+func TransferAllowlistPrefixKey(denom string) []byte {
+	return append(KeyPrefixTransferAllowlist, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// TransferAllowlistStoreKey returns the byte representation of the transfer allowlist key
+func TransferAllowlistStoreKey(denom string, addr sdk.AccAddress) []byte {
+	return append(TransferAllowlistPrefixKey(denom), addr...)
+}
+
+// SplitTransferAllowlistStoreKey splits the transfer allowlist key into the denom and address
+func SplitTransferAllowlistStoreKey(key []byte) (denom string, addr sdk.AccAddress) {
+	key = key[len(KeyPrefixTransferAllowlist):]
+	denomLen := int(key[0])
+	return string(key[1 : 1+denomLen]), key[1+denomLen:]
+}
+
+// TransferLimitStoreKey returns the byte representation of the transfer limit key
+// Items are stored with the following key: values
+// <0x0f><denom>
+func TransferLimitStoreKey(denom string) []byte {
+	return append(KeyPrefixTransferLimit, denom...)
+}
+
+// TransferUsageStoreKey returns the byte representation of the transfer usage key
+// Items are stored with the following key: values
+// <0x10><day><len><denom><address>
+func TransferUsageStoreKey(day uint64, denom string, addr sdk.AccAddress) []byte {
+	return append(append(TransferUsageByDayPrefixKey(day), address.MustLengthPrefix([]byte(denom))...), addr...)
+}
+
+// TransferUsageByDayPrefixKey returns the prefix of the transfer usages of the given day
+func TransferUsageByDayPrefixKey(day uint64) []byte {
+	return append(KeyPrefixTransferUsage, sdk.Uint64ToBigEndian(day)...)
+}
+
+// MinterTotalStoreKey returns the byte representation of the minter total key
+// Items are stored with the following key: values
+// <0x11><len><minter>
+func MinterTotalStoreKey(minter sdk.AccAddress) []byte {
+	return append(KeyPrefixMinterTotal, address.MustLengthPrefix(minter)...)
+}
+
+// ReclaimTotalStoreKey returns the byte representation of the reclaim total key
+// Items are stored with the following key: values
+// <0x12><denom>
+func ReclaimTotalStoreKey(denom string) []byte {
+	return append(KeyPrefixReclaimTotal, denom...)
+}
+
+// ReclaimRecordSequenceStoreKey returns the byte representation of the reclaim record sequence key
+func ReclaimRecordSequenceStoreKey() []byte {
+	return KeyPrefixReclaimRecordSequence
+}
+
+// ReclaimRecordStoreKey returns the byte representation of the reclaim record key
+// Items are stored with the following key: values
+// <0x14><id>
+func ReclaimRecordStoreKey(id uint64) []byte {
+	return append(KeyPrefixReclaimRecord, sdk.Uint64ToBigEndian(id)...)
+}
+
+// MintRecordByHeightStoreKey returns the byte representation of the mint record by height key
+// Items are stored with the following key: values
+// <0x15><height><id>
+func MintRecordByHeightStoreKey(height int64, id uint64) []byte {
+	return append(append(KeyPrefixMintRecordByHeight, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// ReclaimRecordByHeightStoreKey returns the byte representation of the reclaim record by height key
+// Items are stored with the following key: values
+// <0x16><height><id>
+func ReclaimRecordByHeightStoreKey(height int64, id uint64) []byte {
+	return append(append(KeyPrefixReclaimRecordByHeight, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitRecordByHeightKey splits the key of the record by height index, without the prefix, into the height and id
+func SplitRecordByHeightKey(key []byte) (height int64, id uint64) {
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
+
+// EscrowSequenceStoreKey returns the byte representation of the escrow sequence key
+func EscrowSequenceStoreKey() []byte {
+	return KeyPrefixEscrowSequence
+}
+
+// EscrowStoreKey returns the byte representation of the escrow key
+// Items are stored with the following key: values
+// <0x18><id>
+func EscrowStoreKey(id uint64) []byte {
+	return append(KeyPrefixEscrow, sdk.Uint64ToBigEndian(id)...)
+}
+
+// EscrowQueueStoreKey returns the byte representation of the escrow expiry queue key
+// Items are stored with the following key: values
+// <0x19><expiry_height><id>
+func EscrowQueueStoreKey(expiryHeight int64, id uint64) []byte {
+	return append(EscrowQueueByHeightStoreKey(expiryHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// EscrowQueueByHeightStoreKey returns the key prefix of the escrows expiring at the height
+// <0x19><expiry_height>
+func EscrowQueueByHeightStoreKey(expiryHeight int64) []byte {
+	return append(append([]byte{}, KeyPrefixEscrowQueue...), sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// SplitEscrowQueueStoreKey splits the escrow queue key into the expiry height and escrow id
+func SplitEscrowQueueStoreKey(key []byte) (expiryHeight int64, id uint64) {
+	key = key[len(KeyPrefixEscrowQueue):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
+
+// ParamsStoreKey returns the byte representation of the params key
+func ParamsStoreKey() []byte {
+	return KeyPrefixParams
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerDay is the length of a day in seconds, by which the daily transfer usage is reset
+const SecondsPerDay = 24 * 60 * 60
+
+// NewTransferLimit creates a new TransferLimit instance
+func NewTransferLimit(
+	denom string,
+	maxAmountPerTransfer sdk.Int,
+	dailyVolumeLimit sdk.Int,
+	dailyCountLimit uint64,
+	action LimitAction,
+) TransferLimit {
+	return TransferLimit{
+		Denom:                denom,
+		MaxAmountPerTransfer: maxAmountPerTransfer,
+		DailyVolumeLimit:     dailyVolumeLimit,
+		DailyCountLimit:      dailyCountLimit,
+		Action:               action,
+	}
+}
+
+// Validate validates the transfer limit
+func (l TransferLimit) Validate() error {
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", l.Denom, err)
+	}
+
+	if l.MaxAmountPerTransfer.IsNil() || l.MaxAmountPerTransfer.IsNegative() {
+		return fmt.Errorf("max amount per transfer of %s can not be negative", l.Denom)
+	}
+
+	if l.DailyVolumeLimit.IsNil() || l.DailyVolumeLimit.IsNegative() {
+		return fmt.Errorf("daily volume limit of %s can not be negative", l.Denom)
+	}
+
+	if _, ok := LimitAction_name[int32(l.Action)]; !ok {
+		return fmt.Errorf("invalid limit action (%d)", l.Action)
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if none of the limits is set
+func (l TransferLimit) IsEmpty() bool {
+	return l.MaxAmountPerTransfer.IsZero() && l.DailyVolumeLimit.IsZero() && l.DailyCountLimit == 0
+}
+
+// NewTransferUsage creates a new empty TransferUsage instance of the given day
+func NewTransferUsage(denom string, address sdk.AccAddress, day uint64) TransferUsage {
+	return TransferUsage{
+		Denom:   denom,
+		Address: address.String(),
+		Day:     day,
+		Volume:  sdk.ZeroInt(),
+	}
+}
+
+// Validate validates the transfer usage
+func (u TransferUsage) Validate() error {
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", u.Denom, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %s", u.Address, err)
+	}
+
+	if u.Volume.IsNil() || u.Volume.IsNegative() {
+		return fmt.Errorf("transfer volume of %s by %s can not be negative", u.Denom, u.Address)
+	}
+
+	return nil
+}
+
+// TransferDay returns the day of the given time since the Unix epoch
+func TransferDay(t time.Time) uint64 {
+	if t.Unix() < 0 {
+		return 0
+	}
+
+	return uint64(t.Unix() / SecondsPerDay)
+}
+
+// LimitActionFromString parses the limit action from the given string,
+// which is either the short form (e.g. reject) or the full enum name
+func LimitActionFromString(str string) (LimitAction, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "LIMIT_ACTION_") {
+		name = "LIMIT_ACTION_" + name
+	}
+
+	action, ok := LimitAction_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid limit action %s", str)
+	}
+
+	return LimitAction(action), nil
+}
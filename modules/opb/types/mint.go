@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintAllowance creates a new MintAllowance instance
+func NewMintAllowance(minter sdk.AccAddress, amount uint64) MintAllowance {
+	return MintAllowance{
+		Minter: minter.String(),
+		Amount: amount,
+	}
+}
+
+// NewMintRecord creates a new MintRecord instance
+func NewMintRecord(
+	id uint64,
+	minter sdk.AccAddress,
+	recipient sdk.AccAddress,
+	amount uint64,
+	height int64,
+	remainingAllowance uint64,
+) MintRecord {
+	return MintRecord{
+		Id:                 id,
+		Minter:             minter.String(),
+		Recipient:          recipient.String(),
+		Amount:             amount,
+		Height:             height,
+		RemainingAllowance: remainingAllowance,
+	}
+}
+
+// Validate validates the mint record
+func (r MintRecord) Validate() error {
+	if r.Id == 0 {
+		return errors.New("mint record id must be greater than 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Minter); err != nil {
+		return fmt.Errorf("invalid minter %s: %s", r.Minter, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient %s: %s", r.Recipient, err)
+	}
+
+	if r.Amount == 0 {
+		return fmt.Errorf("mint record %d: amount must be greater than 0", r.Id)
+	}
+
+	if r.Height < 0 {
+		return fmt.Errorf("mint record %d: height can not be negative", r.Id)
+	}
+
+	return nil
+}
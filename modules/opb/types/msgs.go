@@ -8,11 +8,42 @@ import (
 const (
 	TypeMsgMint    = "mint"    // type for MsgMint
 	TypeMsgReclaim = "reclaim" // type for MsgReclaim
+
+	TypeMsgSetMintAllowance = "set_mint_allowance" // type for MsgSetMintAllowance
+	TypeMsgRedeem           = "redeem"             // type for MsgRedeem
+	TypeMsgSettleRedemption = "settle_redemption"  // type for MsgSettleRedemption
+	TypeMsgRejectRedemption = "reject_redemption"  // type for MsgRejectRedemption
+
+	TypeMsgCreateReclaimSchedule = "create_reclaim_schedule" // type for MsgCreateReclaimSchedule
+	TypeMsgDeleteReclaimSchedule = "delete_reclaim_schedule" // type for MsgDeleteReclaimSchedule
+
+	TypeMsgSetTransferMode         = "set_transfer_mode"         // type for MsgSetTransferMode
+	TypeMsgUpdateTransferAllowlist = "update_transfer_allowlist" // type for MsgUpdateTransferAllowlist
+	TypeMsgSetTransferLimit        = "set_transfer_limit"        // type for MsgSetTransferLimit
+
+	TypeMsgCreateEscrow      = "create_escrow"       // type for MsgCreateEscrow
+	TypeMsgReleaseEscrow     = "release_escrow"      // type for MsgReleaseEscrow
+	TypeMsgClaimEscrowRefund = "claim_escrow_refund" // type for MsgClaimEscrowRefund
+
+	TypeMsgUpdateParams = "update_params" // type for MsgUpdateParams
 )
 
 var (
 	_ sdk.Msg = &MsgMint{}
 	_ sdk.Msg = &MsgReclaim{}
+	_ sdk.Msg = &MsgSetMintAllowance{}
+	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgSettleRedemption{}
+	_ sdk.Msg = &MsgRejectRedemption{}
+	_ sdk.Msg = &MsgCreateReclaimSchedule{}
+	_ sdk.Msg = &MsgDeleteReclaimSchedule{}
+	_ sdk.Msg = &MsgSetTransferMode{}
+	_ sdk.Msg = &MsgUpdateTransferAllowlist{}
+	_ sdk.Msg = &MsgSetTransferLimit{}
+	_ sdk.Msg = &MsgCreateEscrow{}
+	_ sdk.Msg = &MsgReleaseEscrow{}
+	_ sdk.Msg = &MsgClaimEscrowRefund{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // NewMsgMint creates a new MsgMint instance.
@@ -72,6 +103,17 @@ func NewMsgReclaim(denom string, recipient sdk.AccAddress, operator sdk.AccAddre
 	}
 }
 
+// NewMsgReclaimSplits creates a new MsgReclaim instance which distributes
+// the given amount, zero for the whole balance, to the splits by weight.
+func NewMsgReclaimSplits(denom string, amount sdk.Int, splits []ReclaimSplit, operator sdk.AccAddress) *MsgReclaim {
+	return &MsgReclaim{
+		Denom:    denom,
+		Amount:   amount,
+		Splits:   splits,
+		Operator: operator.String(),
+	}
+}
+
 // Route implements Msg.
 func (m MsgReclaim) Route() string {
 	return RouterKey
@@ -96,6 +138,22 @@ func (m MsgReclaim) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid denom %s: %s", m.Denom, err)
 	}
 
+	if err := ValidateReclaimAmount(m.Amount); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAmount, err.Error())
+	}
+
+	if len(m.Splits) > 0 {
+		if len(m.Recipient) > 0 {
+			return sdkerrors.Wrap(ErrInvalidReclaim, "recipient and splits can not be both specified")
+		}
+
+		if err := ValidateReclaimSplits(m.Splits); err != nil {
+			return sdkerrors.Wrap(ErrInvalidReclaim, err.Error())
+		}
+
+		return nil
+	}
+
 	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient %s: %s", m.Recipient, err)
 	}
@@ -103,6 +161,15 @@ func (m MsgReclaim) ValidateBasic() error {
 	return nil
 }
 
+// GetReclaimSplits returns the splits of the reclaim, where a single recipient takes the whole share
+func (m MsgReclaim) GetReclaimSplits() []ReclaimSplit {
+	if len(m.Splits) > 0 {
+		return m.Splits
+	}
+
+	return []ReclaimSplit{{Recipient: m.Recipient, Weight: sdk.OneDec()}}
+}
+
 // GetSignBytes implements Msg.
 func (m MsgReclaim) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&m)
@@ -114,3 +181,663 @@ func (m MsgReclaim) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Operator)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetMintAllowance creates a new MsgSetMintAllowance instance.
+func NewMsgSetMintAllowance(minter sdk.AccAddress, amount uint64, operator sdk.AccAddress) *MsgSetMintAllowance {
+	return &MsgSetMintAllowance{
+		Minter:   minter.String(),
+		Amount:   amount,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgSetMintAllowance) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgSetMintAllowance) Type() string {
+	return TypeMsgSetMintAllowance
+}
+
+// ValidateBasic implements Msg.
+func (m MsgSetMintAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Minter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter %s: %s", m.Minter, err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSetMintAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSetMintAllowance) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRedeem creates a new MsgRedeem instance.
+func NewMsgRedeem(amount sdk.Coin, settlementRef string, holder sdk.AccAddress) *MsgRedeem {
+	return &MsgRedeem{
+		Amount:        amount,
+		SettlementRef: settlementRef,
+		Holder:        holder.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgRedeem) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgRedeem) Type() string {
+	return TypeMsgRedeem
+}
+
+// ValidateBasic implements Msg.
+func (m MsgRedeem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid holder %s: %s", m.Holder, err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", m.Amount)
+	}
+
+	if err := ValidateSettlementRef(m.SettlementRef); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRedemption, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRedeem) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRedeem) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Holder)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSettleRedemption creates a new MsgSettleRedemption instance.
+func NewMsgSettleRedemption(id uint64, operator sdk.AccAddress) *MsgSettleRedemption {
+	return &MsgSettleRedemption{
+		Id:       id,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgSettleRedemption) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgSettleRedemption) Type() string {
+	return TypeMsgSettleRedemption
+}
+
+// ValidateBasic implements Msg.
+func (m MsgSettleRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidRedemption, "redemption id must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSettleRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSettleRedemption) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRejectRedemption creates a new MsgRejectRedemption instance.
+func NewMsgRejectRedemption(id uint64, reason string, operator sdk.AccAddress) *MsgRejectRedemption {
+	return &MsgRejectRedemption{
+		Id:       id,
+		Reason:   reason,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgRejectRedemption) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgRejectRedemption) Type() string {
+	return TypeMsgRejectRedemption
+}
+
+// ValidateBasic implements Msg.
+func (m MsgRejectRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidRedemption, "redemption id must be greater than 0")
+	}
+
+	if len(m.Reason) > MaxRejectReasonLength {
+		return sdkerrors.Wrapf(ErrInvalidRedemption, "length of the reason cannot be greater than %d", MaxRejectReasonLength)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgRejectRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgRejectRedemption) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCreateReclaimSchedule creates a new MsgCreateReclaimSchedule instance.
+func NewMsgCreateReclaimSchedule(
+	denom string,
+	amount sdk.Int,
+	splits []ReclaimSplit,
+	interval uint64,
+	operator sdk.AccAddress,
+) *MsgCreateReclaimSchedule {
+	return &MsgCreateReclaimSchedule{
+		Denom:    denom,
+		Amount:   amount,
+		Splits:   splits,
+		Interval: interval,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgCreateReclaimSchedule) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgCreateReclaimSchedule) Type() string {
+	return TypeMsgCreateReclaimSchedule
+}
+
+// ValidateBasic implements Msg.
+func (m MsgCreateReclaimSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid denom %s: %s", m.Denom, err)
+	}
+
+	if err := ValidateReclaimAmount(m.Amount); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAmount, err.Error())
+	}
+
+	if err := ValidateReclaimSplits(m.Splits); err != nil {
+		return sdkerrors.Wrap(ErrInvalidReclaim, err.Error())
+	}
+
+	if m.Interval == 0 {
+		return sdkerrors.Wrap(ErrInvalidReclaim, "interval must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgCreateReclaimSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateReclaimSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeleteReclaimSchedule creates a new MsgDeleteReclaimSchedule instance.
+func NewMsgDeleteReclaimSchedule(id uint64, operator sdk.AccAddress) *MsgDeleteReclaimSchedule {
+	return &MsgDeleteReclaimSchedule{
+		Id:       id,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgDeleteReclaimSchedule) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgDeleteReclaimSchedule) Type() string {
+	return TypeMsgDeleteReclaimSchedule
+}
+
+// ValidateBasic implements Msg.
+func (m MsgDeleteReclaimSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidReclaim, "reclaim schedule id must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgDeleteReclaimSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgDeleteReclaimSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTransferMode creates a new MsgSetTransferMode instance.
+func NewMsgSetTransferMode(denom string, mode TransferMode, operator sdk.AccAddress) *MsgSetTransferMode {
+	return &MsgSetTransferMode{
+		Denom:    denom,
+		Mode:     mode,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgSetTransferMode) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgSetTransferMode) Type() string {
+	return TypeMsgSetTransferMode
+}
+
+// ValidateBasic implements Msg.
+func (m MsgSetTransferMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid denom %s: %s", m.Denom, err)
+	}
+
+	if err := ValidateTransferMode(m.Mode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTransferMode, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSetTransferMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSetTransferMode) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateTransferAllowlist creates a new MsgUpdateTransferAllowlist instance.
+func NewMsgUpdateTransferAllowlist(denom string, add, remove []string, operator sdk.AccAddress) *MsgUpdateTransferAllowlist {
+	return &MsgUpdateTransferAllowlist{
+		Denom:    denom,
+		Add:      add,
+		Remove:   remove,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgUpdateTransferAllowlist) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgUpdateTransferAllowlist) Type() string {
+	return TypeMsgUpdateTransferAllowlist
+}
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateTransferAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "invalid denom %s: %s", m.Denom, err)
+	}
+
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAllowlist, "no address to add or remove")
+	}
+
+	if len(m.Add)+len(m.Remove) > MaxAllowlistUpdates {
+		return sdkerrors.Wrapf(ErrInvalidAllowlist, "number of the addresses to add or remove cannot be greater than %d", MaxAllowlistUpdates)
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range append(append([]string{}, m.Add...), m.Remove...) {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", addr, err)
+		}
+
+		if seen[addr] {
+			return sdkerrors.Wrapf(ErrInvalidAllowlist, "duplicate address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUpdateTransferAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateTransferAllowlist) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetTransferLimit creates a new MsgSetTransferLimit instance.
+func NewMsgSetTransferLimit(
+	denom string,
+	maxAmountPerTransfer sdk.Int,
+	dailyVolumeLimit sdk.Int,
+	dailyCountLimit uint64,
+	action LimitAction,
+	operator sdk.AccAddress,
+) *MsgSetTransferLimit {
+	return &MsgSetTransferLimit{
+		Denom:                denom,
+		MaxAmountPerTransfer: maxAmountPerTransfer,
+		DailyVolumeLimit:     dailyVolumeLimit,
+		DailyCountLimit:      dailyCountLimit,
+		Action:               action,
+		Operator:             operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgSetTransferLimit) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgSetTransferLimit) Type() string {
+	return TypeMsgSetTransferLimit
+}
+
+// ValidateBasic implements Msg.
+func (m MsgSetTransferLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if err := m.GetTransferLimit().Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTransferLimit, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgSetTransferLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgSetTransferLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// GetTransferLimit returns the transfer limit to set
+func (m MsgSetTransferLimit) GetTransferLimit() TransferLimit {
+	return NewTransferLimit(m.Denom, m.MaxAmountPerTransfer, m.DailyVolumeLimit, m.DailyCountLimit, m.Action)
+}
+
+// NewMsgCreateEscrow creates a new MsgCreateEscrow instance.
+func NewMsgCreateEscrow(
+	payer, beneficiary, arbiter sdk.AccAddress,
+	amount sdk.Coin,
+	expiryHeight int64,
+	hashLock string,
+) *MsgCreateEscrow {
+	return &MsgCreateEscrow{
+		Payer:        payer.String(),
+		Beneficiary:  beneficiary.String(),
+		Arbiter:      arbiter.String(),
+		Amount:       amount,
+		ExpiryHeight: expiryHeight,
+		HashLock:     hashLock,
+	}
+}
+
+// Route implements Msg.
+func (m MsgCreateEscrow) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgCreateEscrow) Type() string {
+	return TypeMsgCreateEscrow
+}
+
+// ValidateBasic implements Msg.
+func (m MsgCreateEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer %s: %s", m.Payer, err)
+	}
+
+	if err := ValidateEscrowParties(m.Payer, m.Beneficiary, m.Arbiter); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEscrow, err.Error())
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid amount %s", m.Amount)
+	}
+
+	if m.ExpiryHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidEscrow, "expiry height must be greater than 0")
+	}
+
+	if err := ValidateHashLock(m.HashLock); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEscrow, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgCreateEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Payer)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgReleaseEscrow creates a new MsgReleaseEscrow instance.
+func NewMsgReleaseEscrow(id uint64, preimage string, operator sdk.AccAddress) *MsgReleaseEscrow {
+	return &MsgReleaseEscrow{
+		Id:       id,
+		Preimage: preimage,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgReleaseEscrow) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgReleaseEscrow) Type() string {
+	return TypeMsgReleaseEscrow
+}
+
+// ValidateBasic implements Msg.
+func (m MsgReleaseEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidEscrow, "escrow id must be greater than 0")
+	}
+
+	if err := ValidatePreimage(m.Preimage); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEscrow, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgReleaseEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgReleaseEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimEscrowRefund creates a new MsgClaimEscrowRefund instance.
+func NewMsgClaimEscrowRefund(id uint64, payer sdk.AccAddress) *MsgClaimEscrowRefund {
+	return &MsgClaimEscrowRefund{
+		Id:    id,
+		Payer: payer.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgClaimEscrowRefund) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgClaimEscrowRefund) Type() string {
+	return TypeMsgClaimEscrowRefund
+}
+
+// ValidateBasic implements Msg.
+func (m MsgClaimEscrowRefund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Payer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid payer %s: %s", m.Payer, err)
+	}
+
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidEscrow, "escrow id must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgClaimEscrowRefund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgClaimEscrowRefund) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Payer)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(params Params, operator sdk.AccAddress) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Params:   params,
+		Operator: operator.String(),
+	}
+}
+
+// Route implements Msg.
+func (m MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type implements Msg.
+func (m MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+// ValidateBasic implements Msg.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Operator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator %s: %s", m.Operator, err)
+	}
+
+	if err := m.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Operator)
+	return []sdk.AccAddress{addr}
+}
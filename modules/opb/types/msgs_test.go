@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,6 +17,9 @@ var (
 	testAddress = sdk.AccAddress(tmhash.SumTruncated([]byte("test-address")))
 	testDenom   = sdk.DefaultBondDenom
 	testAmount  = uint64(1000)
+	testCoin    = sdk.NewInt64Coin(testDenom, 1000)
+
+	testSettlementRef = "SETTLE-0001"
 
 	emptyAddress = sdk.AccAddress{}
 )
@@ -140,3 +145,577 @@ func TestMsgReclaimGetSigners(t *testing.T) {
 	expected := "[BC821DFCD54A1730C09D78440223D9F9403053EE]"
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
 }
+
+// TestMsgSetMintAllowanceRoute tests Route for MsgSetMintAllowance
+func TestMsgSetMintAllowanceRoute(t *testing.T) {
+	msg := NewMsgSetMintAllowance(testAddress, testAmount, testAddress)
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+// TestMsgSetMintAllowanceType tests Type for MsgSetMintAllowance
+func TestMsgSetMintAllowanceType(t *testing.T) {
+	msg := NewMsgSetMintAllowance(testAddress, testAmount, testAddress)
+	require.Equal(t, TypeMsgSetMintAllowance, msg.Type())
+}
+
+// TestMsgSetMintAllowanceValidation tests ValidateBasic for MsgSetMintAllowance
+func TestMsgSetMintAllowanceValidation(t *testing.T) {
+	testMsgs := []*MsgSetMintAllowance{
+		NewMsgSetMintAllowance(testAddress, testAmount, testAddress),  // valid msg
+		NewMsgSetMintAllowance(testAddress, 0, testAddress),           // valid msg to revoke the allowance
+		NewMsgSetMintAllowance(testAddress, testAmount, emptyAddress), // missing operator address
+		NewMsgSetMintAllowance(emptyAddress, testAmount, testAddress), // missing minter address
+	}
+
+	testCases := []struct {
+		msg     *MsgSetMintAllowance
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "missing minter address"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgSetMintAllowanceGetSignBytes tests GetSignBytes for MsgSetMintAllowance
+func TestMsgSetMintAllowanceGetSignBytes(t *testing.T) {
+	msg := NewMsgSetMintAllowance(testAddress, testAmount, testAddress)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"irita/opb/MsgSetMintAllowance","value":{"amount":"1000","minter":"cosmos1hjppmlx4fgtnpsya0pzqyg7el9qrq5lw58dd9x","operator":"cosmos1hjppmlx4fgtnpsya0pzqyg7el9qrq5lw58dd9x"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// TestMsgSetMintAllowanceGetSigners tests GetSigners for MsgSetMintAllowance
+func TestMsgSetMintAllowanceGetSigners(t *testing.T) {
+	msg := NewMsgSetMintAllowance(testAddress, testAmount, testAddress)
+	res := msg.GetSigners()
+
+	expected := "[BC821DFCD54A1730C09D78440223D9F9403053EE]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// TestMsgRedeemRoute tests Route for MsgRedeem
+func TestMsgRedeemRoute(t *testing.T) {
+	msg := NewMsgRedeem(testCoin, testSettlementRef, testAddress)
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+// TestMsgRedeemType tests Type for MsgRedeem
+func TestMsgRedeemType(t *testing.T) {
+	msg := NewMsgRedeem(testCoin, testSettlementRef, testAddress)
+	require.Equal(t, TypeMsgRedeem, msg.Type())
+}
+
+// TestMsgRedeemValidation tests ValidateBasic for MsgRedeem
+func TestMsgRedeemValidation(t *testing.T) {
+	testMsgs := []*MsgRedeem{
+		NewMsgRedeem(testCoin, testSettlementRef, testAddress),                             // valid msg
+		NewMsgRedeem(testCoin, testSettlementRef, emptyAddress),                            // missing holder address
+		NewMsgRedeem(sdk.NewInt64Coin(testDenom, 0), testSettlementRef, testAddress),       // amount must be greater than 0
+		NewMsgRedeem(testCoin, "", testAddress),                                            // missing settlement reference
+		NewMsgRedeem(testCoin, strings.Repeat("r", MaxSettlementRefLength+1), testAddress), // settlement reference too long
+	}
+
+	testCases := []struct {
+		msg     *MsgRedeem
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing holder address"},
+		{testMsgs[2], false, "amount must be greater than 0"},
+		{testMsgs[3], false, "missing settlement reference"},
+		{testMsgs[4], false, "settlement reference too long"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgRedeemGetSignBytes tests GetSignBytes for MsgRedeem
+func TestMsgRedeemGetSignBytes(t *testing.T) {
+	msg := NewMsgRedeem(testCoin, testSettlementRef, testAddress)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"irita/opb/MsgRedeem","value":{"amount":{"amount":"1000","denom":"stake"},"holder":"cosmos1hjppmlx4fgtnpsya0pzqyg7el9qrq5lw58dd9x","settlement_ref":"SETTLE-0001"}}`
+	require.Equal(t, expected, string(res))
+}
+
+// TestMsgRedeemGetSigners tests GetSigners for MsgRedeem
+func TestMsgRedeemGetSigners(t *testing.T) {
+	msg := NewMsgRedeem(testCoin, testSettlementRef, testAddress)
+	res := msg.GetSigners()
+
+	expected := "[BC821DFCD54A1730C09D78440223D9F9403053EE]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// TestMsgSettleRedemptionValidation tests ValidateBasic for MsgSettleRedemption
+func TestMsgSettleRedemptionValidation(t *testing.T) {
+	testMsgs := []*MsgSettleRedemption{
+		NewMsgSettleRedemption(1, testAddress),  // valid msg
+		NewMsgSettleRedemption(1, emptyAddress), // missing operator address
+		NewMsgSettleRedemption(0, testAddress),  // invalid redemption id
+	}
+
+	testCases := []struct {
+		msg     *MsgSettleRedemption
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing operator address"},
+		{testMsgs[2], false, "invalid redemption id"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgRejectRedemptionValidation tests ValidateBasic for MsgRejectRedemption
+func TestMsgRejectRedemptionValidation(t *testing.T) {
+	testMsgs := []*MsgRejectRedemption{
+		NewMsgRejectRedemption(1, "invalid settlement account", testAddress),                 // valid msg
+		NewMsgRejectRedemption(1, "", testAddress),                                           // valid msg without reason
+		NewMsgRejectRedemption(1, "invalid settlement account", emptyAddress),                // missing operator address
+		NewMsgRejectRedemption(0, "invalid settlement account", testAddress),                 // invalid redemption id
+		NewMsgRejectRedemption(1, strings.Repeat("r", MaxRejectReasonLength+1), testAddress), // reason too long
+	}
+
+	testCases := []struct {
+		msg     *MsgRejectRedemption
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "invalid redemption id"},
+		{testMsgs[4], false, "reason too long"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgReclaimSplitsValidation tests ValidateBasic for MsgReclaim with splits
+func TestMsgReclaimSplitsValidation(t *testing.T) {
+	testAddress2 := sdk.AccAddress(tmhash.SumTruncated([]byte("test-address2")))
+
+	validSplits := []ReclaimSplit{
+		NewReclaimSplit(testAddress, sdk.NewDecWithPrec(6, 1)),
+		NewReclaimSplit(testAddress2, sdk.NewDecWithPrec(4, 1)),
+	}
+	invalidSplits := []ReclaimSplit{
+		NewReclaimSplit(testAddress, sdk.NewDecWithPrec(6, 1)),
+		NewReclaimSplit(testAddress2, sdk.NewDecWithPrec(3, 1)),
+	}
+	duplicateSplits := []ReclaimSplit{
+		NewReclaimSplit(testAddress, sdk.NewDecWithPrec(5, 1)),
+		NewReclaimSplit(testAddress, sdk.NewDecWithPrec(5, 1)),
+	}
+
+	recipientAndSplits := NewMsgReclaimSplits(testDenom, sdk.NewInt(100), validSplits, testAddress)
+	recipientAndSplits.Recipient = testAddress.String()
+
+	testMsgs := []*MsgReclaim{
+		NewMsgReclaimSplits(testDenom, sdk.NewInt(100), validSplits, testAddress),     // valid msg
+		NewMsgReclaimSplits(testDenom, sdk.Int{}, validSplits, testAddress),           // valid msg to reclaim the whole balance
+		NewMsgReclaimSplits(testDenom, sdk.NewInt(-1), validSplits, testAddress),      // negative amount
+		NewMsgReclaimSplits(testDenom, sdk.NewInt(100), invalidSplits, testAddress),   // weights not summing up to 1
+		NewMsgReclaimSplits(testDenom, sdk.NewInt(100), duplicateSplits, testAddress), // duplicate recipients
+		recipientAndSplits, // recipient and splits both specified
+	}
+
+	testCases := []struct {
+		msg     *MsgReclaim
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "negative amount"},
+		{testMsgs[3], false, "weights not summing up to 1"},
+		{testMsgs[4], false, "duplicate recipients"},
+		{testMsgs[5], false, "recipient and splits both specified"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgCreateReclaimScheduleValidation tests ValidateBasic for MsgCreateReclaimSchedule
+func TestMsgCreateReclaimScheduleValidation(t *testing.T) {
+	splits := []ReclaimSplit{NewReclaimSplit(testAddress, sdk.OneDec())}
+
+	testMsgs := []*MsgCreateReclaimSchedule{
+		NewMsgCreateReclaimSchedule(testDenom, sdk.Int{}, splits, 100, testAddress),  // valid msg
+		NewMsgCreateReclaimSchedule(testDenom, sdk.Int{}, splits, 100, emptyAddress), // missing operator address
+		NewMsgCreateReclaimSchedule(testDenom, sdk.Int{}, nil, 100, testAddress),     // missing splits
+		NewMsgCreateReclaimSchedule(testDenom, sdk.Int{}, splits, 0, testAddress),    // interval must be greater than 0
+	}
+
+	testCases := []struct {
+		msg     *MsgCreateReclaimSchedule
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing operator address"},
+		{testMsgs[2], false, "missing splits"},
+		{testMsgs[3], false, "interval must be greater than 0"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgSetTransferModeValidation tests ValidateBasic for MsgSetTransferMode
+func TestMsgSetTransferModeValidation(t *testing.T) {
+	testMsgs := []*MsgSetTransferMode{
+		NewMsgSetTransferMode(testDenom, TransferModeAllowlist, testAddress),   // valid msg
+		NewMsgSetTransferMode(testDenom, TransferModeUnspecified, testAddress), // valid msg
+		NewMsgSetTransferMode(testDenom, TransferModeAllowlist, emptyAddress),  // missing operator address
+		NewMsgSetTransferMode("1a", TransferModeAllowlist, testAddress),        // invalid denom
+		NewMsgSetTransferMode(testDenom, TransferMode(10), testAddress),        // invalid transfer mode
+	}
+
+	testCases := []struct {
+		msg     *MsgSetTransferMode
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "invalid denom"},
+		{testMsgs[4], false, "invalid transfer mode"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgUpdateTransferAllowlistValidation tests ValidateBasic for MsgUpdateTransferAllowlist
+func TestMsgUpdateTransferAllowlistValidation(t *testing.T) {
+	addr := testAddress.String()
+
+	testMsgs := []*MsgUpdateTransferAllowlist{
+		NewMsgUpdateTransferAllowlist(testDenom, []string{addr}, nil, testAddress),            // valid msg
+		NewMsgUpdateTransferAllowlist(testDenom, nil, []string{addr}, testAddress),            // valid msg
+		NewMsgUpdateTransferAllowlist(testDenom, []string{addr}, nil, emptyAddress),           // missing operator address
+		NewMsgUpdateTransferAllowlist(testDenom, nil, nil, testAddress),                       // no address to add or remove
+		NewMsgUpdateTransferAllowlist(testDenom, []string{"invalid"}, nil, testAddress),       // invalid address
+		NewMsgUpdateTransferAllowlist(testDenom, []string{addr}, []string{addr}, testAddress), // duplicate address
+	}
+
+	testCases := []struct {
+		msg     *MsgUpdateTransferAllowlist
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "no address to add or remove"},
+		{testMsgs[4], false, "invalid address"},
+		{testMsgs[5], false, "duplicate address"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestTransferModeFromString tests TransferModeFromString
+func TestTransferModeFromString(t *testing.T) {
+	mode, err := TransferModeFromString("owner-only")
+	require.NoError(t, err)
+	require.Equal(t, TransferModeOwnerOnly, mode)
+
+	mode, err = TransferModeFromString("TRANSFER_MODE_ALLOWLIST")
+	require.NoError(t, err)
+	require.Equal(t, TransferModeAllowlist, mode)
+
+	_, err = TransferModeFromString("whitelist")
+	require.Error(t, err)
+}
+
+// TestMsgSetTransferLimitValidation tests ValidateBasic for MsgSetTransferLimit
+func TestMsgSetTransferLimitValidation(t *testing.T) {
+	amount := sdk.NewInt(1000)
+
+	testMsgs := []*MsgSetTransferLimit{
+		NewMsgSetTransferLimit(testDenom, amount, amount, 10, LimitActionReject, testAddress),           // valid msg
+		NewMsgSetTransferLimit(testDenom, sdk.ZeroInt(), sdk.ZeroInt(), 0, LimitActionTag, testAddress), // valid msg
+		NewMsgSetTransferLimit(testDenom, amount, amount, 10, LimitActionReject, emptyAddress),          // missing operator address
+		NewMsgSetTransferLimit("1a", amount, amount, 10, LimitActionReject, testAddress),                // invalid denom
+		NewMsgSetTransferLimit(testDenom, sdk.NewInt(-1), amount, 10, LimitActionReject, testAddress),   // negative max amount per transfer
+		NewMsgSetTransferLimit(testDenom, amount, sdk.Int{}, 10, LimitActionReject, testAddress),        // missing daily volume limit
+		NewMsgSetTransferLimit(testDenom, amount, amount, 10, LimitAction(5), testAddress),              // invalid limit action
+	}
+
+	testCases := []struct {
+		msg     *MsgSetTransferLimit
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "invalid denom"},
+		{testMsgs[4], false, "negative max amount per transfer"},
+		{testMsgs[5], false, "missing daily volume limit"},
+		{testMsgs[6], false, "invalid limit action"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestDistributeReclaim tests DistributeReclaim
+func TestDistributeReclaim(t *testing.T) {
+	testAddress2 := sdk.AccAddress(tmhash.SumTruncated([]byte("test-address2")))
+	testAddress3 := sdk.AccAddress(tmhash.SumTruncated([]byte("test-address3")))
+
+	splits := []ReclaimSplit{
+		NewReclaimSplit(testAddress, sdk.NewDecWithPrec(333, 3)),
+		NewReclaimSplit(testAddress2, sdk.NewDecWithPrec(333, 3)),
+		NewReclaimSplit(testAddress3, sdk.NewDecWithPrec(334, 3)),
+	}
+
+	testCases := []struct {
+		amount int64
+		shares []int64
+	}{
+		{100, []int64{33, 33, 34}},
+		{1, []int64{0, 0, 1}},
+	}
+
+	for _, tc := range testCases {
+		shares := DistributeReclaim(sdk.NewInt(tc.amount), splits)
+		require.Len(t, shares, len(tc.shares))
+
+		for i, share := range tc.shares {
+			require.True(t, sdk.NewInt(share).Equal(shares[i]), "expected share %d, got %s", share, shares[i])
+		}
+	}
+}
+
+// TestMsgCreateEscrowValidation tests ValidateBasic for MsgCreateEscrow
+func TestMsgCreateEscrowValidation(t *testing.T) {
+	testBeneficiary := sdk.AccAddress(tmhash.SumTruncated([]byte("test-beneficiary")))
+	hashLock := hex.EncodeToString(tmhash.Sum([]byte("preimage")))
+
+	testMsgs := []*MsgCreateEscrow{
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, testCoin, 100, ""),                            // valid msg
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, testCoin, 100, hashLock),                      // valid msg with hash lock
+		NewMsgCreateEscrow(emptyAddress, testBeneficiary, testAddress, testCoin, 100, ""),                           // missing payer address
+		NewMsgCreateEscrow(testAddress, emptyAddress, testAddress, testCoin, 100, ""),                               // missing beneficiary address
+		NewMsgCreateEscrow(testAddress, testBeneficiary, emptyAddress, testCoin, 100, ""),                           // missing arbiter address
+		NewMsgCreateEscrow(testAddress, testAddress, testAddress, testCoin, 100, ""),                                // payer as beneficiary
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, sdk.NewInt64Coin(testDenom, 0), 100, ""),      // zero amount
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, testCoin, 0, ""),                              // zero expiry height
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, testCoin, 100, "hashlock"),                    // invalid hash lock
+		NewMsgCreateEscrow(testAddress, testBeneficiary, testAddress, testCoin, 100, hex.EncodeToString([]byte{1})), // short hash lock
+	}
+
+	testCases := []struct {
+		msg     *MsgCreateEscrow
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing payer address"},
+		{testMsgs[3], false, "missing beneficiary address"},
+		{testMsgs[4], false, "missing arbiter address"},
+		{testMsgs[5], false, "payer as beneficiary"},
+		{testMsgs[6], false, "zero amount"},
+		{testMsgs[7], false, "zero expiry height"},
+		{testMsgs[8], false, "invalid hash lock"},
+		{testMsgs[9], false, "short hash lock"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgReleaseEscrowValidation tests ValidateBasic for MsgReleaseEscrow
+func TestMsgReleaseEscrowValidation(t *testing.T) {
+	preimage := hex.EncodeToString([]byte("preimage"))
+
+	testMsgs := []*MsgReleaseEscrow{
+		NewMsgReleaseEscrow(1, "", testAddress),                                        // valid msg
+		NewMsgReleaseEscrow(1, preimage, testAddress),                                  // valid msg with preimage
+		NewMsgReleaseEscrow(1, "", emptyAddress),                                       // missing operator address
+		NewMsgReleaseEscrow(0, "", testAddress),                                        // zero escrow id
+		NewMsgReleaseEscrow(1, "preimage", testAddress),                                // invalid preimage
+		NewMsgReleaseEscrow(1, strings.Repeat("ab", MaxPreimageLength+1), testAddress), // preimage too long
+	}
+
+	testCases := []struct {
+		msg     *MsgReleaseEscrow
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], true, ""},
+		{testMsgs[2], false, "missing operator address"},
+		{testMsgs[3], false, "zero escrow id"},
+		{testMsgs[4], false, "invalid preimage"},
+		{testMsgs[5], false, "preimage too long"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgClaimEscrowRefundValidation tests ValidateBasic for MsgClaimEscrowRefund
+func TestMsgClaimEscrowRefundValidation(t *testing.T) {
+	testMsgs := []*MsgClaimEscrowRefund{
+		NewMsgClaimEscrowRefund(1, testAddress),  // valid msg
+		NewMsgClaimEscrowRefund(1, emptyAddress), // missing payer address
+		NewMsgClaimEscrowRefund(0, testAddress),  // zero escrow id
+	}
+
+	testCases := []struct {
+		msg     *MsgClaimEscrowRefund
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing payer address"},
+		{testMsgs[2], false, "zero escrow id"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestEscrowUnlocks tests Unlocks for Escrow
+func TestEscrowUnlocks(t *testing.T) {
+	preimage := []byte("preimage")
+	hashLock := hex.EncodeToString(tmhash.Sum(preimage))
+
+	escrow := NewEscrow(1, testAddress, testAddress, testAddress, testCoin, 100, hashLock, 1)
+	require.True(t, escrow.Unlocks(hex.EncodeToString(preimage)))
+	require.False(t, escrow.Unlocks(hex.EncodeToString([]byte("other"))))
+	require.False(t, escrow.Unlocks(""))
+
+	escrow.HashLock = ""
+	require.False(t, escrow.Unlocks(hex.EncodeToString(preimage)))
+}
+
+// TestMsgUpdateParamsValidation tests ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	invalidDenomParams := DefaultParams()
+	invalidDenomParams.BaseTokenDenom = ""
+
+	invalidManagerParams := DefaultParams()
+	invalidManagerParams.BaseTokenManager = "invalid"
+
+	testMsgs := []*MsgUpdateParams{
+		NewMsgUpdateParams(DefaultParams(), testAddress),      // valid msg
+		NewMsgUpdateParams(DefaultParams(), emptyAddress),     // missing operator address
+		NewMsgUpdateParams(invalidDenomParams, testAddress),   // empty base token denom
+		NewMsgUpdateParams(invalidManagerParams, testAddress), // invalid base token manager
+	}
+
+	testCases := []struct {
+		msg     *MsgUpdateParams
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "missing operator address"},
+		{testMsgs[2], false, "empty base token denom"},
+		{testMsgs[3], false, "invalid base token manager"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
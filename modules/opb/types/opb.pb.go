@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,12 +25,150 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RedemptionStatus defines the status of a redemption request
+type RedemptionStatus int32
+
+const (
+	// REDEMPTION_STATUS_PENDING defines a redemption request awaiting the settlement
+	RedemptionStatusPending RedemptionStatus = 0
+	// REDEMPTION_STATUS_SETTLED defines a redemption request settled off-chain
+	RedemptionStatusSettled RedemptionStatus = 1
+	// REDEMPTION_STATUS_REJECTED defines a rejected redemption request
+	RedemptionStatusRejected RedemptionStatus = 2
+)
+
+var RedemptionStatus_name = map[int32]string{
+	0: "REDEMPTION_STATUS_PENDING",
+	1: "REDEMPTION_STATUS_SETTLED",
+	2: "REDEMPTION_STATUS_REJECTED",
+}
+
+var RedemptionStatus_value = map[string]int32{
+	"REDEMPTION_STATUS_PENDING":  0,
+	"REDEMPTION_STATUS_SETTLED":  1,
+	"REDEMPTION_STATUS_REJECTED": 2,
+}
+
+func (x RedemptionStatus) String() string {
+	return proto.EnumName(RedemptionStatus_name, int32(x))
+}
+
+func (RedemptionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{0}
+}
+
+// TransferMode defines the transfer restriction mode of a token
+type TransferMode int32
+
+const (
+	// TRANSFER_MODE_UNSPECIFIED defines a token following the global unrestricted_token_transfer param
+	TransferModeUnspecified TransferMode = 0
+	// TRANSFER_MODE_UNRESTRICTED defines a token transferable without restriction
+	TransferModeUnrestricted TransferMode = 1
+	// TRANSFER_MODE_OWNER_ONLY defines a token transferable only from or to the owner, or by the platform users
+	TransferModeOwnerOnly TransferMode = 2
+	// TRANSFER_MODE_ALLOWLIST defines a token transferable only among the owner and the allowlisted addresses
+	TransferModeAllowlist TransferMode = 3
+)
+
+var TransferMode_name = map[int32]string{
+	0: "TRANSFER_MODE_UNSPECIFIED",
+	1: "TRANSFER_MODE_UNRESTRICTED",
+	2: "TRANSFER_MODE_OWNER_ONLY",
+	3: "TRANSFER_MODE_ALLOWLIST",
+}
+
+var TransferMode_value = map[string]int32{
+	"TRANSFER_MODE_UNSPECIFIED":  0,
+	"TRANSFER_MODE_UNRESTRICTED": 1,
+	"TRANSFER_MODE_OWNER_ONLY":   2,
+	"TRANSFER_MODE_ALLOWLIST":    3,
+}
+
+func (x TransferMode) String() string {
+	return proto.EnumName(TransferMode_name, int32(x))
+}
+
+func (TransferMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{1}
+}
+
+// LimitAction defines the action taken on a transfer exceeding the transfer limit
+type LimitAction int32
+
+const (
+	// LIMIT_ACTION_REJECT defines the transfer over the limit is rejected
+	LimitActionReject LimitAction = 0
+	// LIMIT_ACTION_TAG defines the transfer over the limit is allowed and tagged with an event
+	LimitActionTag LimitAction = 1
+)
+
+var LimitAction_name = map[int32]string{
+	0: "LIMIT_ACTION_REJECT",
+	1: "LIMIT_ACTION_TAG",
+}
+
+var LimitAction_value = map[string]int32{
+	"LIMIT_ACTION_REJECT": 0,
+	"LIMIT_ACTION_TAG":    1,
+}
+
+func (x LimitAction) String() string {
+	return proto.EnumName(LimitAction_name, int32(x))
+}
+
+func (LimitAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{2}
+}
+
+// EscrowStatus defines the status of an escrow
+type EscrowStatus int32
+
+const (
+	// ESCROW_STATUS_LOCKED defines an escrow holding the funds until the release or expiry
+	EscrowStatusLocked EscrowStatus = 0
+	// ESCROW_STATUS_RELEASED defines an escrow released to the beneficiary
+	EscrowStatusReleased EscrowStatus = 1
+	// ESCROW_STATUS_REFUNDED defines an expired escrow refunded to the payer
+	EscrowStatusRefunded EscrowStatus = 2
+	// ESCROW_STATUS_REFUND_FAILED defines an expired escrow failed to be refunded, whose funds stay in the escrow account
+	EscrowStatusRefundFailed EscrowStatus = 3
+)
+
+var EscrowStatus_name = map[int32]string{
+	0: "ESCROW_STATUS_LOCKED",
+	1: "ESCROW_STATUS_RELEASED",
+	2: "ESCROW_STATUS_REFUNDED",
+	3: "ESCROW_STATUS_REFUND_FAILED",
+}
+
+var EscrowStatus_value = map[string]int32{
+	"ESCROW_STATUS_LOCKED":        0,
+	"ESCROW_STATUS_RELEASED":      1,
+	"ESCROW_STATUS_REFUNDED":      2,
+	"ESCROW_STATUS_REFUND_FAILED": 3,
+}
+
+func (x EscrowStatus) String() string {
+	return proto.EnumName(EscrowStatus_name, int32(x))
+}
+
+func (EscrowStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{3}
+}
+
 // Params defines the parameters for the OPB module.
 type Params struct {
-	BaseTokenDenom            string `protobuf:"bytes,1,opt,name=base_token_denom,json=baseTokenDenom,proto3" json:"base_token_denom,omitempty"`
-	PointTokenDenom           string `protobuf:"bytes,2,opt,name=point_token_denom,json=pointTokenDenom,proto3" json:"point_token_denom,omitempty"`
-	BaseTokenManager          string `protobuf:"bytes,3,opt,name=base_token_manager,json=baseTokenManager,proto3" json:"base_token_manager,omitempty"`
-	UnrestrictedTokenTransfer bool   `protobuf:"varint,4,opt,name=unrestricted_token_transfer,json=unrestrictedTokenTransfer,proto3" json:"unrestricted_token_transfer,omitempty"`
+	BaseTokenDenom   string `protobuf:"bytes,1,opt,name=base_token_denom,json=baseTokenDenom,proto3" json:"base_token_denom,omitempty"`
+	PointTokenDenom  string `protobuf:"bytes,2,opt,name=point_token_denom,json=pointTokenDenom,proto3" json:"point_token_denom,omitempty"`
+	BaseTokenManager string `protobuf:"bytes,3,opt,name=base_token_manager,json=baseTokenManager,proto3" json:"base_token_manager,omitempty"`
+	// unrestricted_token_transfer is the fallback transfer restriction of the tokens without an
+	// explicit transfer mode, which always takes precedence over it
+	UnrestrictedTokenTransfer bool                                   `protobuf:"varint,4,opt,name=unrestricted_token_transfer,json=unrestrictedTokenTransfer,proto3" json:"unrestricted_token_transfer,omitempty"`
+	MintEpochBlocks           uint64                                 `protobuf:"varint,5,opt,name=mint_epoch_blocks,json=mintEpochBlocks,proto3" json:"mint_epoch_blocks,omitempty"`
+	MintEpochCap              uint64                                 `protobuf:"varint,6,opt,name=mint_epoch_cap,json=mintEpochCap,proto3" json:"mint_epoch_cap,omitempty"`
+	MaxSupply                 uint64                                 `protobuf:"varint,7,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	PointTokenFeeRate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=point_token_fee_rate,json=pointTokenFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"point_token_fee_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,163 +204,4663 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "iritamod.opb.Params")
+// MintAllowance defines the remaining amount of the base native token a minter is allowed to mint.
+type MintAllowance struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func init() { proto.RegisterFile("opb/opb.proto", fileDescriptor_1cbfaa920b6e27d9) }
+func (m *MintAllowance) Reset()         { *m = MintAllowance{} }
+func (m *MintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MintAllowance) ProtoMessage()    {}
+func (*MintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{1}
+}
+func (m *MintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllowance.Merge(m, src)
+}
+func (m *MintAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllowance.DiscardUnknown(m)
+}
 
-var fileDescriptor_1cbfaa920b6e27d9 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbd, 0x4e, 0xc3, 0x30,
-	0x14, 0x85, 0x63, 0xa8, 0x2a, 0xb0, 0xf8, 0x29, 0x11, 0x43, 0x00, 0xc9, 0x54, 0x4c, 0x11, 0x42,
-	0xf1, 0xc0, 0xc6, 0xc0, 0x80, 0x58, 0x2b, 0xa1, 0xaa, 0x13, 0x4b, 0x65, 0x37, 0x26, 0x18, 0xb0,
-	0xaf, 0x65, 0x3b, 0x03, 0x6f, 0xc1, 0x23, 0xf0, 0x38, 0x1d, 0x2b, 0x26, 0x46, 0x48, 0x16, 0x1e,
-	0x03, 0xd9, 0x2d, 0x28, 0xdd, 0xae, 0xbe, 0xfb, 0x9d, 0x33, 0x1c, 0xbc, 0x0b, 0x86, 0x53, 0x30,
-	0xbc, 0x30, 0x16, 0x3c, 0xa4, 0x3b, 0xd2, 0x4a, 0xcf, 0x14, 0x94, 0x05, 0x18, 0x7e, 0x7c, 0x58,
-	0x41, 0x05, 0xf1, 0x41, 0xc3, 0xb5, 0x74, 0xce, 0x3e, 0x10, 0xee, 0xdf, 0x31, 0xcb, 0x94, 0x4b,
-	0x73, 0x3c, 0xe0, 0xcc, 0x89, 0xa9, 0x87, 0x67, 0xa1, 0xa7, 0xa5, 0xd0, 0xa0, 0x32, 0x34, 0x44,
-	0xf9, 0xf6, 0x78, 0x2f, 0xf0, 0x49, 0xc0, 0xb7, 0x81, 0xa6, 0xe7, 0xf8, 0xc0, 0x80, 0xd4, 0x7e,
-	0x4d, 0xdd, 0x88, 0xea, 0x7e, 0x7c, 0x74, 0xdc, 0x0b, 0x9c, 0x76, 0x5a, 0x15, 0xd3, 0xac, 0x12,
-	0x36, 0xdb, 0x8c, 0xf2, 0xe0, 0xbf, 0x77, 0xb4, 0xe4, 0xe9, 0x35, 0x3e, 0xa9, 0xb5, 0x15, 0xce,
-	0x5b, 0x39, 0xf3, 0xa2, 0x5c, 0xa5, 0xbc, 0x65, 0xda, 0x3d, 0x08, 0x9b, 0xf5, 0x86, 0x28, 0xdf,
-	0x1a, 0x1f, 0x75, 0x95, 0x18, 0x9f, 0xac, 0x84, 0xab, 0xde, 0xcf, 0xfb, 0x29, 0xba, 0x19, 0xcd,
-	0xbf, 0x49, 0x32, 0x6f, 0x08, 0x5a, 0x34, 0x04, 0x7d, 0x35, 0x04, 0xbd, 0xb5, 0x24, 0x59, 0xb4,
-	0x24, 0xf9, 0x6c, 0x49, 0x72, 0x4f, 0x2b, 0xe9, 0x1f, 0x6b, 0x5e, 0xcc, 0x40, 0x51, 0x2e, 0x99,
-	0x7e, 0x92, 0x82, 0x49, 0xfa, 0xb7, 0x15, 0x55, 0x50, 0xd6, 0x2f, 0xc2, 0x85, 0x1d, 0xa9, 0x7f,
-	0x35, 0xc2, 0xf1, 0x7e, 0x9c, 0xea, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x33, 0xd8, 0x9e, 0x87,
-	0x5f, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MintAllowance proto.InternalMessageInfo
+
+// MintRecord defines a record of the base native token minting.
+type MintRecord struct {
+	Id                 uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Minter             string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty"`
+	Recipient          string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount             uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height             int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	RemainingAllowance uint64 `protobuf:"varint,6,opt,name=remaining_allowance,json=remainingAllowance,proto3" json:"remaining_allowance,omitempty"`
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{2}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+// EpochMint defines the amount of the base native token minted in a mint epoch.
+type EpochMint struct {
+	Epoch  uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EpochMint) Reset()         { *m = EpochMint{} }
+func (m *EpochMint) String() string { return proto.CompactTextString(m) }
+func (*EpochMint) ProtoMessage()    {}
+func (*EpochMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{3}
+}
+func (m *EpochMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *EpochMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochMint.Merge(m, src)
+}
+func (m *EpochMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochMint proto.InternalMessageInfo
+
+// Redemption defines a request to redeem the native token for an off-chain settlement.
+type Redemption struct {
+	Id            uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Holder        string           `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount        types.Coin       `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	SettlementRef string           `protobuf:"bytes,4,opt,name=settlement_ref,json=settlementRef,proto3" json:"settlement_ref,omitempty"`
+	Status        RedemptionStatus `protobuf:"varint,5,opt,name=status,proto3,enum=iritamod.opb.RedemptionStatus" json:"status,omitempty"`
+	RequestHeight int64            `protobuf:"varint,6,opt,name=request_height,json=requestHeight,proto3" json:"request_height,omitempty"`
+	ResolveHeight int64            `protobuf:"varint,7,opt,name=resolve_height,json=resolveHeight,proto3" json:"resolve_height,omitempty"`
+	Reason        string           `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{4}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.BaseTokenDenom != that1.BaseTokenDenom {
-		return false
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+// ReclaimSplit defines a recipient of the reclaimed token along with its share.
+type ReclaimSplit struct {
+	Recipient string                                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Weight    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *ReclaimSplit) Reset()         { *m = ReclaimSplit{} }
+func (m *ReclaimSplit) String() string { return proto.CompactTextString(m) }
+func (*ReclaimSplit) ProtoMessage()    {}
+func (*ReclaimSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{5}
+}
+func (m *ReclaimSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PointTokenDenom != that1.PointTokenDenom {
-		return false
+}
+func (m *ReclaimSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimSplit.Merge(m, src)
+}
+func (m *ReclaimSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimSplit proto.InternalMessageInfo
+
+// ReclaimSchedule defines a standing reclaim of the native token executed every interval blocks.
+type ReclaimSchedule struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount reclaimed each time, the whole balance if zero
+	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Splits     []ReclaimSplit                         `protobuf:"bytes,4,rep,name=splits,proto3" json:"splits"`
+	Interval   uint64                                 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	NextHeight int64                                  `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	Creator    string                                 `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *ReclaimSchedule) Reset()         { *m = ReclaimSchedule{} }
+func (m *ReclaimSchedule) String() string { return proto.CompactTextString(m) }
+func (*ReclaimSchedule) ProtoMessage()    {}
+func (*ReclaimSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{6}
+}
+func (m *ReclaimSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.BaseTokenManager != that1.BaseTokenManager {
-		return false
+}
+func (m *ReclaimSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimSchedule.Merge(m, src)
+}
+func (m *ReclaimSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimSchedule proto.InternalMessageInfo
+
+// TokenTransferMode defines the transfer mode of a token.
+type TokenTransferMode struct {
+	Denom string       `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Mode  TransferMode `protobuf:"varint,2,opt,name=mode,proto3,enum=iritamod.opb.TransferMode" json:"mode,omitempty"`
+}
+
+func (m *TokenTransferMode) Reset()         { *m = TokenTransferMode{} }
+func (m *TokenTransferMode) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferMode) ProtoMessage()    {}
+func (*TokenTransferMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{7}
+}
+func (m *TokenTransferMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTransferMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTransferMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.UnrestrictedTokenTransfer != that1.UnrestrictedTokenTransfer {
-		return false
+}
+func (m *TokenTransferMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferMode.Merge(m, src)
+}
+func (m *TokenTransferMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTransferMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferMode proto.InternalMessageInfo
+
+// TransferAllowlist defines the addresses allowed to hold and transfer a token in the allowlist mode.
+type TransferAllowlist struct {
+	Denom     string   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *TransferAllowlist) Reset()         { *m = TransferAllowlist{} }
+func (m *TransferAllowlist) String() string { return proto.CompactTextString(m) }
+func (*TransferAllowlist) ProtoMessage()    {}
+func (*TransferAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{8}
+}
+func (m *TransferAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransferAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferAllowlist.Merge(m, src)
+}
+func (m *TransferAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferAllowlist proto.InternalMessageInfo
+
+// TransferLimit defines the transfer limits of a token, where zero means no limit.
+type TransferLimit struct {
+	Denom                string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxAmountPerTransfer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_amount_per_transfer,json=maxAmountPerTransfer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_transfer"`
+	DailyVolumeLimit     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=daily_volume_limit,json=dailyVolumeLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"daily_volume_limit"`
+	DailyCountLimit      uint64                                 `protobuf:"varint,4,opt,name=daily_count_limit,json=dailyCountLimit,proto3" json:"daily_count_limit,omitempty"`
+	Action               LimitAction                            `protobuf:"varint,5,opt,name=action,proto3,enum=iritamod.opb.LimitAction" json:"action,omitempty"`
+}
+
+func (m *TransferLimit) Reset()         { *m = TransferLimit{} }
+func (m *TransferLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferLimit) ProtoMessage()    {}
+func (*TransferLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{9}
+}
+func (m *TransferLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TransferLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimit.Merge(m, src)
+}
+func (m *TransferLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimit.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TransferLimit proto.InternalMessageInfo
+
+// TransferUsage defines the volume and number of the transfers of a token sent by an account in a day.
+type TransferUsage struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Day     uint64                                 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Volume  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	Count   uint64                                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnrestrictedTokenTransfer {
-		i--
-		if m.UnrestrictedTokenTransfer {
-			dAtA[i] = 1
+func (m *TransferUsage) Reset()         { *m = TransferUsage{} }
+func (m *TransferUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferUsage) ProtoMessage()    {}
+func (*TransferUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{10}
+}
+func (m *TransferUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferUsage.Merge(m, src)
+}
+func (m *TransferUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferUsage proto.InternalMessageInfo
+
+// MinterTotal defines the total amount of the base native token minted by a minter.
+type MinterTotal struct {
+	Minter string `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MinterTotal) Reset()         { *m = MinterTotal{} }
+func (m *MinterTotal) String() string { return proto.CompactTextString(m) }
+func (*MinterTotal) ProtoMessage()    {}
+func (*MinterTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{11}
+}
+func (m *MinterTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterTotal.Merge(m, src)
+}
+func (m *MinterTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterTotal proto.InternalMessageInfo
+
+// ReclaimTotal defines the total amount of a native token reclaimed.
+type ReclaimTotal struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ReclaimTotal) Reset()         { *m = ReclaimTotal{} }
+func (m *ReclaimTotal) String() string { return proto.CompactTextString(m) }
+func (*ReclaimTotal) ProtoMessage()    {}
+func (*ReclaimTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{12}
+}
+func (m *ReclaimTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReclaimTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimTotal.Merge(m, src)
+}
+func (m *ReclaimTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimTotal proto.InternalMessageInfo
+
+// ReclaimRecord defines the record of the native token reclaimed to a recipient.
+type ReclaimRecord struct {
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operator  string     `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Height    int64      `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReclaimRecord) Reset()         { *m = ReclaimRecord{} }
+func (m *ReclaimRecord) String() string { return proto.CompactTextString(m) }
+func (*ReclaimRecord) ProtoMessage()    {}
+func (*ReclaimRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{13}
+}
+func (m *ReclaimRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReclaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReclaimRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReclaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReclaimRecord.Merge(m, src)
+}
+func (m *ReclaimRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReclaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReclaimRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReclaimRecord proto.InternalMessageInfo
+
+// Escrow defines the base native token locked by a payer for a beneficiary,
+// released by the arbiter or the preimage of the hash lock, and refunded upon expiry.
+type Escrow struct {
+	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Payer       string     `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Beneficiary string     `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	Arbiter     string     `protobuf:"bytes,4,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	Amount      types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// expiry_height is the height at the end of which the escrow is refunded if not released
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// hash_lock is the hex-encoded SHA-256 hash of the preimage releasing the escrow, optional
+	HashLock      string       `protobuf:"bytes,7,opt,name=hash_lock,json=hashLock,proto3" json:"hash_lock,omitempty"`
+	Status        EscrowStatus `protobuf:"varint,8,opt,name=status,proto3,enum=iritamod.opb.EscrowStatus" json:"status,omitempty"`
+	CreateHeight  int64        `protobuf:"varint,9,opt,name=create_height,json=createHeight,proto3" json:"create_height,omitempty"`
+	ResolveHeight int64        `protobuf:"varint,10,opt,name=resolve_height,json=resolveHeight,proto3" json:"resolve_height,omitempty"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cbfaa920b6e27d9, []int{14}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("iritamod.opb.RedemptionStatus", RedemptionStatus_name, RedemptionStatus_value)
+	proto.RegisterEnum("iritamod.opb.TransferMode", TransferMode_name, TransferMode_value)
+	proto.RegisterEnum("iritamod.opb.LimitAction", LimitAction_name, LimitAction_value)
+	proto.RegisterEnum("iritamod.opb.EscrowStatus", EscrowStatus_name, EscrowStatus_value)
+	proto.RegisterType((*Params)(nil), "iritamod.opb.Params")
+	proto.RegisterType((*MintAllowance)(nil), "iritamod.opb.MintAllowance")
+	proto.RegisterType((*MintRecord)(nil), "iritamod.opb.MintRecord")
+	proto.RegisterType((*EpochMint)(nil), "iritamod.opb.EpochMint")
+	proto.RegisterType((*Redemption)(nil), "iritamod.opb.Redemption")
+	proto.RegisterType((*ReclaimSplit)(nil), "iritamod.opb.ReclaimSplit")
+	proto.RegisterType((*ReclaimSchedule)(nil), "iritamod.opb.ReclaimSchedule")
+	proto.RegisterType((*TokenTransferMode)(nil), "iritamod.opb.TokenTransferMode")
+	proto.RegisterType((*TransferAllowlist)(nil), "iritamod.opb.TransferAllowlist")
+	proto.RegisterType((*TransferLimit)(nil), "iritamod.opb.TransferLimit")
+	proto.RegisterType((*TransferUsage)(nil), "iritamod.opb.TransferUsage")
+	proto.RegisterType((*MinterTotal)(nil), "iritamod.opb.MinterTotal")
+	proto.RegisterType((*ReclaimTotal)(nil), "iritamod.opb.ReclaimTotal")
+	proto.RegisterType((*ReclaimRecord)(nil), "iritamod.opb.ReclaimRecord")
+	proto.RegisterType((*Escrow)(nil), "iritamod.opb.Escrow")
+}
+
+func init() { proto.RegisterFile("opb/opb.proto", fileDescriptor_1cbfaa920b6e27d9) }
+
+var fileDescriptor_1cbfaa920b6e27d9 = []byte{
+	// 1589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0xda,
+	0x11, 0x16, 0x25, 0x59, 0xb6, 0xc6, 0x96, 0x2d, 0x33, 0x8e, 0x23, 0xcb, 0xa9, 0x2c, 0x24, 0x6d,
+	0x61, 0x18, 0xad, 0x14, 0xbb, 0x45, 0x52, 0x04, 0xfd, 0x81, 0x2c, 0x51, 0xa9, 0x5a, 0xfd, 0x18,
+	0x94, 0xdc, 0xa0, 0x45, 0x01, 0xe2, 0x88, 0x3c, 0x96, 0x58, 0x93, 0x3c, 0x2c, 0x49, 0xd9, 0x56,
+	0x9f, 0xa0, 0xf0, 0xaa, 0xab, 0xee, 0x0c, 0x14, 0x68, 0x1f, 0xa0, 0x9b, 0x02, 0xed, 0x1b, 0x64,
+	0xd5, 0x66, 0x59, 0xdc, 0x45, 0x70, 0x6f, 0xb2, 0xb9, 0xc0, 0x5d, 0xdd, 0x27, 0xb8, 0x17, 0xe7,
+	0x47, 0x14, 0x25, 0xcb, 0x08, 0xe2, 0x95, 0x3d, 0x73, 0xbe, 0x99, 0x33, 0xf3, 0xcd, 0x9c, 0x19,
+	0x0a, 0x32, 0xc4, 0xed, 0x97, 0x89, 0xdb, 0x2f, 0xb9, 0x1e, 0x09, 0x88, 0xbc, 0x66, 0x7a, 0x66,
+	0x80, 0x6c, 0x62, 0x94, 0x88, 0xdb, 0xcf, 0x6f, 0x0d, 0xc8, 0x80, 0xb0, 0x83, 0x32, 0xfd, 0x8f,
+	0x63, 0xf2, 0x05, 0x9d, 0xf8, 0x36, 0xf1, 0xcb, 0x7d, 0xe4, 0xe3, 0xf2, 0xc5, 0x61, 0x1f, 0x07,
+	0xe8, 0xb0, 0xac, 0x13, 0xd3, 0xe1, 0xe7, 0x4f, 0xfe, 0x91, 0x80, 0xd4, 0x09, 0xf2, 0x90, 0xed,
+	0xcb, 0xfb, 0x90, 0xa5, 0x28, 0x2d, 0x20, 0xe7, 0xd8, 0xd1, 0x0c, 0xec, 0x10, 0x3b, 0x27, 0x15,
+	0xa5, 0xfd, 0xb4, 0xba, 0x4e, 0xf5, 0x3d, 0xaa, 0xae, 0x51, 0xad, 0x7c, 0x00, 0x9b, 0x2e, 0x31,
+	0x9d, 0x60, 0x06, 0x1a, 0x67, 0xd0, 0x0d, 0x76, 0x10, 0xc1, 0xfe, 0x00, 0xe4, 0x88, 0x57, 0x1b,
+	0x39, 0x68, 0x80, 0xbd, 0x5c, 0x82, 0x81, 0xb3, 0xa1, 0xdf, 0x16, 0xd7, 0xcb, 0x3f, 0x87, 0xdd,
+	0x91, 0xe3, 0x61, 0x3f, 0xf0, 0x4c, 0x3d, 0xc0, 0x86, 0xb0, 0x0a, 0x3c, 0xe4, 0xf8, 0x67, 0xd8,
+	0xcb, 0x25, 0x8b, 0xd2, 0xfe, 0x8a, 0xba, 0x13, 0x85, 0x30, 0xf3, 0x9e, 0x00, 0xd0, 0xc8, 0x6c,
+	0x1a, 0x18, 0x76, 0x89, 0x3e, 0xd4, 0xfa, 0x16, 0xd1, 0xcf, 0xfd, 0xdc, 0x52, 0x51, 0xda, 0x4f,
+	0xaa, 0x1b, 0xf4, 0x40, 0xa1, 0xfa, 0x63, 0xa6, 0x96, 0xbf, 0x0b, 0xeb, 0x11, 0xac, 0x8e, 0xdc,
+	0x5c, 0x8a, 0x01, 0xd7, 0x42, 0x60, 0x15, 0xb9, 0xf2, 0x77, 0x00, 0x6c, 0x74, 0xa5, 0xf9, 0x23,
+	0xd7, 0xb5, 0xc6, 0xb9, 0x65, 0x86, 0x48, 0xdb, 0xe8, 0xaa, 0xcb, 0x14, 0xb2, 0x06, 0x5b, 0x51,
+	0x2a, 0xce, 0x30, 0xd6, 0x3c, 0x14, 0xe0, 0xdc, 0x0a, 0x4d, 0xf0, 0xb8, 0xf4, 0xe6, 0xdd, 0x5e,
+	0xec, 0xb3, 0x77, 0x7b, 0xdf, 0x1f, 0x98, 0xc1, 0x70, 0xd4, 0x2f, 0xe9, 0xc4, 0x2e, 0x8b, 0x82,
+	0xf0, 0x3f, 0x3f, 0xf4, 0x8d, 0xf3, 0x72, 0x30, 0x76, 0xb1, 0x5f, 0xaa, 0x61, 0x5d, 0xdd, 0x9c,
+	0xb2, 0x57, 0xc7, 0x58, 0x45, 0x01, 0x7e, 0x99, 0xfc, 0xf2, 0x6f, 0x7b, 0xd2, 0x13, 0x05, 0x32,
+	0x2d, 0xd3, 0x09, 0x2a, 0x96, 0x45, 0x2e, 0x91, 0xa3, 0x63, 0x79, 0x1b, 0x52, 0x34, 0x4c, 0xec,
+	0x89, 0x12, 0x09, 0x89, 0xea, 0x91, 0x4d, 0x46, 0x4e, 0xc0, 0xea, 0x91, 0x54, 0x85, 0x24, 0xdc,
+	0xfc, 0x47, 0x02, 0xa0, 0x7e, 0x54, 0xac, 0x13, 0xcf, 0x90, 0xd7, 0x21, 0x6e, 0x1a, 0xcc, 0x41,
+	0x52, 0x8d, 0x9b, 0x46, 0xc4, 0x69, 0x7c, 0xc6, 0xe9, 0x63, 0x48, 0x7b, 0x58, 0x37, 0x5d, 0x13,
+	0x3b, 0x81, 0x28, 0xdd, 0x54, 0x11, 0xb9, 0x32, 0x19, 0xbd, 0x92, 0xea, 0x87, 0xd8, 0x1c, 0x0c,
+	0x03, 0x56, 0x80, 0x84, 0x2a, 0x24, 0xb9, 0x0c, 0x0f, 0x3c, 0x6c, 0x23, 0xd3, 0x31, 0x9d, 0x81,
+	0x86, 0x26, 0x19, 0x09, 0xf2, 0xe5, 0xf0, 0x28, 0xcc, 0x55, 0xc4, 0xfe, 0x0b, 0x48, 0xb3, 0xa2,
+	0xd0, 0xf8, 0xe5, 0x2d, 0x58, 0x62, 0x65, 0x13, 0xc1, 0x73, 0xe1, 0x23, 0xc9, 0xff, 0x3b, 0x0e,
+	0xa0, 0x62, 0x03, 0xdb, 0x6e, 0x60, 0x12, 0x67, 0x51, 0xf2, 0x43, 0x62, 0x19, 0xd3, 0xe4, 0xb9,
+	0x24, 0xbf, 0x08, 0x9d, 0xd2, 0xcc, 0x57, 0x8f, 0x76, 0x4a, 0xbc, 0x74, 0x25, 0xda, 0xbc, 0x25,
+	0xf1, 0xa4, 0x4a, 0x55, 0x62, 0x3a, 0xc7, 0x49, 0x5a, 0xee, 0x30, 0xff, 0xef, 0xc1, 0xba, 0x8f,
+	0x83, 0xc0, 0xc2, 0x36, 0x76, 0x02, 0xcd, 0xc3, 0x67, 0x8c, 0x9f, 0xb4, 0x9a, 0x99, 0x6a, 0x55,
+	0x7c, 0x26, 0x3f, 0x87, 0x94, 0x1f, 0xa0, 0x60, 0xc4, 0xfb, 0x74, 0xfd, 0xa8, 0x50, 0x8a, 0x3e,
+	0xeb, 0xd2, 0x34, 0xe2, 0x2e, 0x43, 0xa9, 0x02, 0x4d, 0xdd, 0x7b, 0xf8, 0x8f, 0x23, 0xec, 0x07,
+	0x9a, 0xa0, 0x39, 0xc5, 0x68, 0xce, 0x08, 0xed, 0x2f, 0x39, 0xdb, 0x0c, 0xe6, 0x13, 0xeb, 0x02,
+	0x4f, 0x60, 0xcb, 0x13, 0x18, 0xd3, 0x0a, 0xd8, 0x36, 0xa4, 0x3c, 0x8c, 0x7c, 0xe2, 0xf0, 0xce,
+	0x55, 0x85, 0x24, 0xa8, 0xfb, 0x13, 0xac, 0xa9, 0x58, 0xb7, 0x90, 0x69, 0x77, 0x5d, 0xcb, 0x0c,
+	0x66, 0x1b, 0x42, 0x9a, 0x6f, 0x88, 0x3a, 0xa4, 0x2e, 0xf9, 0x55, 0xf1, 0x7b, 0xbd, 0x02, 0x61,
+	0x2d, 0xee, 0xfe, 0x6b, 0x1c, 0x36, 0x26, 0x97, 0xeb, 0x43, 0x6c, 0x8c, 0x2c, 0x7c, 0xab, 0x76,
+	0x5b, 0xb0, 0x14, 0x1d, 0x42, 0x5c, 0xa0, 0x71, 0x44, 0x2a, 0xf7, 0x69, 0x71, 0x34, 0x9c, 0x20,
+	0x2c, 0xe4, 0x4f, 0x20, 0xe5, 0xd3, 0xb4, 0xfd, 0x5c, 0xb2, 0x98, 0xd8, 0x5f, 0x3d, 0xca, 0xcf,
+	0x57, 0x68, 0xca, 0xcc, 0xa4, 0x05, 0x38, 0x5e, 0xce, 0xc3, 0x0a, 0x7b, 0x41, 0x17, 0xc8, 0x12,
+	0x53, 0x28, 0x94, 0xe5, 0x3d, 0x58, 0x75, 0xf0, 0xd5, 0x5c, 0xf1, 0x80, 0xaa, 0x44, 0x49, 0x72,
+	0xb0, 0xac, 0x7b, 0x18, 0x05, 0xc4, 0x63, 0x25, 0x4b, 0xab, 0x13, 0x51, 0x10, 0xa3, 0xc1, 0xe6,
+	0xcc, 0xf0, 0x6b, 0x11, 0x03, 0x4f, 0x99, 0x90, 0xa2, 0x4c, 0x94, 0x20, 0x69, 0x13, 0x03, 0x33,
+	0x7a, 0xd6, 0xe7, 0xe3, 0x8f, 0xda, 0xab, 0x0c, 0x27, 0x2e, 0x68, 0xc1, 0xe6, 0xe4, 0x8c, 0x3d,
+	0x46, 0xcb, 0xf4, 0x83, 0x3b, 0x2e, 0x78, 0x0c, 0x69, 0x64, 0x18, 0x1e, 0xf6, 0x7d, 0xec, 0xe7,
+	0xe2, 0xc5, 0x04, 0x6d, 0x88, 0x50, 0x21, 0xdc, 0xfd, 0x2f, 0x0e, 0x99, 0x89, 0xbf, 0xa6, 0x69,
+	0x9b, 0x77, 0xf9, 0xc2, 0xf0, 0x88, 0x4e, 0x5c, 0x4e, 0xbe, 0xe6, 0x62, 0x6f, 0x3a, 0xff, 0xe3,
+	0xf7, 0xaa, 0xe3, 0x96, 0x8d, 0xae, 0x2a, 0xcc, 0xdb, 0x09, 0xf6, 0xc2, 0x55, 0xf1, 0x7b, 0x90,
+	0x0d, 0x64, 0x5a, 0x63, 0xed, 0x82, 0x58, 0x23, 0x1b, 0x6b, 0x16, 0x0d, 0xe9, 0x9e, 0x9d, 0x92,
+	0x65, 0x9e, 0x7e, 0xc3, 0x1c, 0xf1, 0xd4, 0x0e, 0x60, 0x93, 0x7b, 0xd7, 0x59, 0x16, 0xdc, 0x39,
+	0x9f, 0x8f, 0x1b, 0xec, 0xa0, 0x4a, 0xf5, 0x1c, 0x7b, 0x08, 0x29, 0xa4, 0xd3, 0x17, 0x2e, 0x26,
+	0xc0, 0xce, 0x6c, 0x7d, 0x18, 0xa8, 0xc2, 0x00, 0xaa, 0x00, 0x0a, 0x46, 0xff, 0x25, 0x4d, 0x19,
+	0x3d, 0xf5, 0xd1, 0xe0, 0xae, 0xf2, 0xe7, 0x60, 0x59, 0x14, 0x43, 0x3c, 0x90, 0x89, 0x28, 0x67,
+	0x21, 0x61, 0xa0, 0x31, 0xcb, 0x3a, 0xa9, 0xd2, 0x7f, 0xe9, 0xa3, 0xe1, 0x84, 0xe4, 0x92, 0xf7,
+	0xa2, 0x42, 0x58, 0xd3, 0x48, 0x58, 0xea, 0xa2, 0xef, 0xb9, 0x20, 0xe2, 0xae, 0xc2, 0x6a, 0x8b,
+	0xbd, 0x83, 0x1e, 0x09, 0x90, 0x75, 0xcf, 0x5d, 0xe6, 0x85, 0x33, 0x89, 0x7b, 0x59, 0x9c, 0x7a,
+	0x7d, 0xc6, 0xc7, 0xbd, 0x67, 0x80, 0xb8, 0xf3, 0x9f, 0x12, 0x64, 0xc4, 0xa5, 0x77, 0xac, 0xd0,
+	0x3c, 0xac, 0x10, 0x17, 0x7b, 0xec, 0xd5, 0x72, 0xae, 0x43, 0xf9, 0x23, 0x6b, 0xf4, 0xc5, 0xcc,
+	0x1a, 0xfd, 0x84, 0x3d, 0x73, 0xc7, 0x9e, 0x15, 0x21, 0x7f, 0x15, 0x87, 0x94, 0xe2, 0xeb, 0x1e,
+	0xb9, 0x5c, 0x34, 0x35, 0x5d, 0x34, 0x0e, 0x17, 0x1e, 0x17, 0xe4, 0x22, 0xac, 0xf6, 0xb1, 0x83,
+	0xcf, 0x4c, 0xdd, 0x44, 0xde, 0x58, 0xc4, 0x19, 0x55, 0xb1, 0x76, 0xf2, 0xfa, 0x66, 0x20, 0x3e,
+	0xc8, 0xd2, 0xea, 0x44, 0x8c, 0xe4, 0xb0, 0xf4, 0x69, 0x39, 0x3c, 0x85, 0x0c, 0xbe, 0x72, 0x4d,
+	0x6f, 0x3c, 0x3b, 0x0e, 0xd7, 0xb8, 0x52, 0x0c, 0xc4, 0x5d, 0x48, 0x0f, 0x91, 0x3f, 0xd4, 0xe8,
+	0xe7, 0x9b, 0x18, 0x89, 0x2b, 0x54, 0xd1, 0x24, 0xfa, 0xb9, 0x7c, 0x14, 0xae, 0xd1, 0x95, 0x45,
+	0x43, 0x8e, 0x53, 0x30, 0xb7, 0x42, 0x9f, 0x42, 0x86, 0x8d, 0xd4, 0x70, 0x35, 0xa6, 0xf9, 0xad,
+	0x5c, 0x79, 0xe7, 0x02, 0x85, 0x05, 0x0b, 0x94, 0xb3, 0x7d, 0xf0, 0x5f, 0x09, 0xb2, 0xf3, 0x1b,
+	0x5b, 0x7e, 0x09, 0x3b, 0xaa, 0x52, 0x53, 0x5a, 0x27, 0xbd, 0x46, 0xa7, 0xad, 0x75, 0x7b, 0x95,
+	0xde, 0x69, 0x57, 0x3b, 0x51, 0xda, 0xb5, 0x46, 0xfb, 0x55, 0x36, 0x96, 0xdf, 0xbd, 0xbe, 0x29,
+	0x3e, 0x9a, 0x37, 0x3a, 0xc1, 0x8e, 0x61, 0x3a, 0x83, 0xc5, 0xb6, 0x5d, 0xa5, 0xd7, 0x6b, 0x2a,
+	0xb5, 0xac, 0xb4, 0xd8, 0xb6, 0xcb, 0xbe, 0x2f, 0x0c, 0xf9, 0xa7, 0x90, 0xbf, 0x6d, 0xab, 0x2a,
+	0xbf, 0x52, 0xaa, 0x3d, 0xa5, 0x96, 0x8d, 0xe7, 0x1f, 0x5f, 0xdf, 0x14, 0x73, 0xf3, 0xc6, 0x2a,
+	0xfe, 0x03, 0xa6, 0xdf, 0xd5, 0xf9, 0xe4, 0x9f, 0xff, 0x5e, 0x88, 0x1d, 0x7c, 0x23, 0xc1, 0xda,
+	0xcc, 0x82, 0x79, 0x09, 0x3b, 0x3d, 0xb5, 0xd2, 0xee, 0xd6, 0x15, 0x55, 0x6b, 0x75, 0x6a, 0x8a,
+	0x76, 0xda, 0xee, 0x9e, 0x28, 0xd5, 0x46, 0xbd, 0xa1, 0xd4, 0x26, 0xc9, 0x44, 0x0d, 0x4e, 0x1d,
+	0xdf, 0xc5, 0xba, 0x79, 0x66, 0xf2, 0x80, 0xe6, 0x6d, 0x55, 0xa5, 0xdb, 0x53, 0x1b, 0x2c, 0x20,
+	0x89, 0x07, 0x34, 0x6b, 0x3c, 0xfd, 0xd0, 0x97, 0x5f, 0x40, 0x6e, 0xd6, 0xba, 0xf3, 0xba, 0xad,
+	0xa8, 0x5a, 0xa7, 0xdd, 0xfc, 0x6d, 0x36, 0x9e, 0xdf, 0xb9, 0xbe, 0x29, 0x3e, 0x8c, 0xda, 0x76,
+	0x2e, 0x1d, 0xec, 0x75, 0x1c, 0x6b, 0x2c, 0x3f, 0x87, 0x47, 0xb3, 0x86, 0x95, 0x66, 0xb3, 0xf3,
+	0xba, 0xd9, 0xe8, 0xf6, 0xb2, 0x89, 0xdb, 0x76, 0xe1, 0xaa, 0x13, 0x0c, 0xd8, 0xb0, 0x1a, 0x99,
+	0xc0, 0x72, 0x09, 0x1e, 0x34, 0x1b, 0xad, 0x46, 0x4f, 0xab, 0x54, 0x19, 0xad, 0x9c, 0xcf, 0x6c,
+	0x2c, 0xff, 0xf0, 0xfa, 0xa6, 0xb8, 0x19, 0x41, 0x72, 0x22, 0xe9, 0xaf, 0xaa, 0x19, 0x7c, 0xaf,
+	0xf2, 0x2a, 0x2b, 0xe5, 0xe5, 0xeb, 0x9b, 0xe2, 0x7a, 0x04, 0xdc, 0x43, 0x03, 0x71, 0xdd, 0xd7,
+	0x12, 0xac, 0x45, 0x9b, 0x55, 0x7e, 0x06, 0x5b, 0x4a, 0xb7, 0xaa, 0x76, 0x5e, 0x4f, 0x2a, 0xd8,
+	0xec, 0x54, 0x7f, 0xcd, 0xb8, 0xde, 0xbe, 0xbe, 0x29, 0xca, 0x51, 0x2c, 0x7d, 0x08, 0xd8, 0x90,
+	0x7f, 0x0c, 0xdb, 0xb3, 0x16, 0xaa, 0xd2, 0x54, 0x2a, 0x5d, 0x46, 0x71, 0xee, 0xfa, 0xa6, 0xb8,
+	0x35, 0xf3, 0x18, 0xb0, 0x85, 0x91, 0xbf, 0xd8, 0xaa, 0x7e, 0xda, 0xae, 0xb1, 0x4e, 0x59, 0x60,
+	0x75, 0x36, 0x72, 0x0c, 0x6c, 0xc8, 0x3f, 0x83, 0xdd, 0x45, 0x56, 0x5a, 0xbd, 0xd2, 0xa0, 0x1d,
+	0x9a, 0xe0, 0x35, 0xbd, 0x6d, 0x5a, 0x47, 0xa6, 0x35, 0x69, 0xb2, 0xe3, 0xd6, 0x9b, 0x2f, 0x0a,
+	0xb1, 0x37, 0xef, 0x0b, 0xd2, 0xdb, 0xf7, 0x05, 0xe9, 0xf3, 0xf7, 0x05, 0xe9, 0x2f, 0x1f, 0x0a,
+	0xb1, 0xb7, 0x1f, 0x0a, 0xb1, 0xff, 0x7f, 0x28, 0xc4, 0x7e, 0x57, 0x8e, 0x8c, 0x6a, 0x84, 0x8c,
+	0xa1, 0xf9, 0xec, 0xf9, 0xe1, 0x51, 0x79, 0xf2, 0xba, 0xcb, 0x36, 0xa1, 0x9f, 0x84, 0x3e, 0xfd,
+	0x5d, 0xcc, 0xe7, 0x76, 0x3f, 0xc5, 0x7e, 0xda, 0xfe, 0xe8, 0xdb, 0x01, 0x00, 0x36, 0xc9, 0x7f,
+	0x2a, 0x2f, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseTokenDenom != that1.BaseTokenDenom {
+		return false
+	}
+	if this.PointTokenDenom != that1.PointTokenDenom {
+		return false
+	}
+	if this.BaseTokenManager != that1.BaseTokenManager {
+		return false
+	}
+	if this.UnrestrictedTokenTransfer != that1.UnrestrictedTokenTransfer {
+		return false
+	}
+	if this.MintEpochBlocks != that1.MintEpochBlocks {
+		return false
+	}
+	if this.MintEpochCap != that1.MintEpochCap {
+		return false
+	}
+	if this.MaxSupply != that1.MaxSupply {
+		return false
+	}
+	if !this.PointTokenFeeRate.Equal(that1.PointTokenFeeRate) {
+		return false
+	}
+	return true
+}
+func (this *MintAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintAllowance)
+	if !ok {
+		that2, ok := that.(MintAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	return true
+}
+func (this *MintRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintRecord)
+	if !ok {
+		that2, ok := that.(MintRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.RemainingAllowance != that1.RemainingAllowance {
+		return false
+	}
+	return true
+}
+func (this *EpochMint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochMint)
+	if !ok {
+		that2, ok := that.(EpochMint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	return true
+}
+func (this *Redemption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Redemption)
+	if !ok {
+		that2, ok := that.(Redemption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Holder != that1.Holder {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.SettlementRef != that1.SettlementRef {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.RequestHeight != that1.RequestHeight {
+		return false
+	}
+	if this.ResolveHeight != that1.ResolveHeight {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *ReclaimSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReclaimSplit)
+	if !ok {
+		that2, ok := that.(ReclaimSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *ReclaimSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReclaimSchedule)
+	if !ok {
+		that2, ok := that.(ReclaimSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if len(this.Splits) != len(that1.Splits) {
+		return false
+	}
+	for i := range this.Splits {
+		if !this.Splits[i].Equal(&that1.Splits[i]) {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	return true
+}
+func (this *TokenTransferMode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenTransferMode)
+	if !ok {
+		that2, ok := that.(TokenTransferMode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	return true
+}
+func (this *TransferAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferAllowlist)
+	if !ok {
+		that2, ok := that.(TransferAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TransferLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferLimit)
+	if !ok {
+		that2, ok := that.(TransferLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MaxAmountPerTransfer.Equal(that1.MaxAmountPerTransfer) {
+		return false
+	}
+	if !this.DailyVolumeLimit.Equal(that1.DailyVolumeLimit) {
+		return false
+	}
+	if this.DailyCountLimit != that1.DailyCountLimit {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	return true
+}
+func (this *TransferUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransferUsage)
+	if !ok {
+		that2, ok := that.(TransferUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Day != that1.Day {
+		return false
+	}
+	if !this.Volume.Equal(that1.Volume) {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *MinterTotal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MinterTotal)
+	if !ok {
+		that2, ok := that.(MinterTotal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if this.Amount != that1.Amount {
+		return false
+	}
+	return true
+}
+func (this *ReclaimTotal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReclaimTotal)
+	if !ok {
+		that2, ok := that.(ReclaimTotal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *ReclaimRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReclaimRecord)
+	if !ok {
+		that2, ok := that.(ReclaimRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *Escrow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Escrow)
+	if !ok {
+		that2, ok := that.(Escrow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Payer != that1.Payer {
+		return false
+	}
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if this.Arbiter != that1.Arbiter {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if this.HashLock != that1.HashLock {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.CreateHeight != that1.CreateHeight {
+		return false
+	}
+	if this.ResolveHeight != that1.ResolveHeight {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PointTokenFeeRate.Size()
+		i -= size
+		if _, err := m.PointTokenFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxSupply != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MintEpochCap != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.MintEpochCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MintEpochBlocks != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.MintEpochBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UnrestrictedTokenTransfer {
+		i--
+		if m.UnrestrictedTokenTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BaseTokenManager) > 0 {
+		i -= len(m.BaseTokenManager)
+		copy(dAtA[i:], m.BaseTokenManager)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.BaseTokenManager)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PointTokenDenom) > 0 {
+		i -= len(m.PointTokenDenom)
+		copy(dAtA[i:], m.PointTokenDenom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.PointTokenDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseTokenDenom) > 0 {
+		i -= len(m.BaseTokenDenom)
+		copy(dAtA[i:], m.BaseTokenDenom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.BaseTokenDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingAllowance != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.RemainingAllowance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ResolveHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.ResolveHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequestHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SettlementRef) > 0 {
+		i -= len(m.SettlementRef)
+		copy(dAtA[i:], m.SettlementRef)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.SettlementRef)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOpb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenTransferMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTransferMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenTransferMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintOpb(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DailyCountLimit != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.DailyCountLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DailyVolumeLimit.Size()
+		i -= size
+		if _, err := m.DailyVolumeLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxAmountPerTransfer.Size()
+		i -= size
+		if _, err := m.MaxAmountPerTransfer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Day != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinterTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReclaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReclaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReclaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolveHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.ResolveHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreateHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.CreateHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.HashLock) > 0 {
+		i -= len(m.HashLock)
+		copy(dAtA[i:], m.HashLock)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.HashLock)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOpb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintOpb(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOpb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOpb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOpb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.PointTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.BaseTokenManager)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.UnrestrictedTokenTransfer {
+		n += 2
+	}
+	if m.MintEpochBlocks != 0 {
+		n += 1 + sovOpb(uint64(m.MintEpochBlocks))
+	}
+	if m.MintEpochCap != 0 {
+		n += 1 + sovOpb(uint64(m.MintEpochCap))
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovOpb(uint64(m.MaxSupply))
+	}
+	l = m.PointTokenFeeRate.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	return n
+}
+
+func (m *MintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOpb(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOpb(uint64(m.Id))
+	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOpb(uint64(m.Amount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovOpb(uint64(m.Height))
+	}
+	if m.RemainingAllowance != 0 {
+		n += 1 + sovOpb(uint64(m.RemainingAllowance))
+	}
+	return n
+}
+
+func (m *EpochMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovOpb(uint64(m.Epoch))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOpb(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *Redemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOpb(uint64(m.Id))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	l = len(m.SettlementRef)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOpb(uint64(m.Status))
+	}
+	if m.RequestHeight != 0 {
+		n += 1 + sovOpb(uint64(m.RequestHeight))
+	}
+	if m.ResolveHeight != 0 {
+		n += 1 + sovOpb(uint64(m.ResolveHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	return n
+}
+
+func (m *ReclaimSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	return n
+}
+
+func (m *ReclaimSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOpb(uint64(m.Id))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovOpb(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovOpb(uint64(m.Interval))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovOpb(uint64(m.NextHeight))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenTransferMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovOpb(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *TransferAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovOpb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransferLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.MaxAmountPerTransfer.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	l = m.DailyVolumeLimit.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	if m.DailyCountLimit != 0 {
+		n += 1 + sovOpb(uint64(m.DailyCountLimit))
+	}
+	if m.Action != 0 {
+		n += 1 + sovOpb(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *TransferUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovOpb(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovOpb(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MinterTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovOpb(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *ReclaimTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	return n
+}
+
+func (m *ReclaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOpb(uint64(m.Id))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOpb(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOpb(uint64(m.Id))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOpb(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovOpb(uint64(m.ExpiryHeight))
+	}
+	l = len(m.HashLock)
+	if l > 0 {
+		n += 1 + l + sovOpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovOpb(uint64(m.Status))
+	}
+	if m.CreateHeight != 0 {
+		n += 1 + sovOpb(uint64(m.CreateHeight))
+	}
+	if m.ResolveHeight != 0 {
+		n += 1 + sovOpb(uint64(m.ResolveHeight))
+	}
+	return n
+}
+
+func sovOpb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOpb(x uint64) (n int) {
+	return sovOpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointTokenDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PointTokenDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTokenManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseTokenManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedTokenTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnrestrictedTokenTransfer = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpochBlocks", wireType)
+			}
+			m.MintEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintEpochCap", wireType)
+			}
+			m.MintEpochCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintEpochCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointTokenFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PointTokenFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAllowance", wireType)
+			}
+			m.RemainingAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= RedemptionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveHeight", wireType)
+			}
+			m.ResolveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReclaimSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReclaimSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReclaimSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReclaimSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReclaimSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReclaimSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, ReclaimSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTransferMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTransferMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTransferMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TransferMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferAllowlist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferAllowlist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerTransfer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyVolumeLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyVolumeLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCountLimit", wireType)
+			}
+			m.DailyCountLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailyCountLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= LimitAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinterTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReclaimTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReclaimTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReclaimTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReclaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReclaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReclaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.BaseTokenManager) > 0 {
-		i -= len(m.BaseTokenManager)
-		copy(dAtA[i:], m.BaseTokenManager)
-		i = encodeVarintOpb(dAtA, i, uint64(len(m.BaseTokenManager)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PointTokenDenom) > 0 {
-		i -= len(m.PointTokenDenom)
-		copy(dAtA[i:], m.PointTokenDenom)
-		i = encodeVarintOpb(dAtA, i, uint64(len(m.PointTokenDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseTokenDenom) > 0 {
-		i -= len(m.BaseTokenDenom)
-		copy(dAtA[i:], m.BaseTokenDenom)
-		i = encodeVarintOpb(dAtA, i, uint64(len(m.BaseTokenDenom)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintOpb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOpb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovOpb(uint64(l))
-	}
-	l = len(m.PointTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovOpb(uint64(l))
-	}
-	l = len(m.BaseTokenManager)
-	if l > 0 {
-		n += 1 + l + sovOpb(uint64(l))
-	}
-	if m.UnrestrictedTokenTransfer {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovOpb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOpb(x uint64) (n int) {
-	return sovOpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Escrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -243,15 +4883,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseTokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -279,11 +4938,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseTokenDenom = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointTokenDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -311,11 +4970,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PointTokenDenom = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseTokenManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -343,13 +5002,46 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseTokenManager = string(dAtA[iNdEx:postIndex])
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrestrictedTokenTransfer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var v int
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOpb
@@ -359,12 +5051,100 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOpb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashLock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EscrowStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateHeight", wireType)
+			}
+			m.CreateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveHeight", wireType)
+			}
+			m.ResolveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolveHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UnrestrictedTokenTransfer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOpb(dAtA[iNdEx:])
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -19,14 +18,20 @@ const (
 	// DefaultUnrestrictedTokenTransfer is set to false, which
 	// means that the token transfer is under certain constraint
 	DefaultUnrestrictedTokenTransfer = true
+
+	// DefaultMintEpochBlocks is the number of blocks of a mint epoch by default
+	DefaultMintEpochBlocks = uint64(17280)
+
+	// DefaultMintEpochCap is set to 0, which means that the mint amount per epoch is not capped
+	DefaultMintEpochCap = uint64(0)
+
+	// DefaultMaxSupply is set to 0, which means that the supply of the base token is not capped
+	DefaultMaxSupply = uint64(0)
 )
 
-// Parameter store keys
 var (
-	KeyBaseTokenDenom            = []byte("BaseTokenDenom")
-	KeyPointTokenDenom           = []byte("PointTokenDenom")
-	KeyBaseTokenManager          = []byte("BaseTokenManager")
-	KeyUnrestrictedTokenTransfer = []byte("UnrestrictedTokenTransfer")
+	// DefaultPointTokenFeeRate is set to 0, which means that the fee can not be paid in the point token
+	DefaultPointTokenFeeRate = sdk.ZeroDec()
 )
 
 // NewParams creates a new Params instance
@@ -35,12 +40,20 @@ func NewParams(
 	pointTokenDenom string,
 	baseTokenManager string,
 	unrestrictedTokenTransfer bool,
+	mintEpochBlocks uint64,
+	mintEpochCap uint64,
+	maxSupply uint64,
+	pointTokenFeeRate sdk.Dec,
 ) Params {
 	return Params{
 		BaseTokenDenom:            baseTokenDenom,
 		PointTokenDenom:           pointTokenDenom,
 		BaseTokenManager:          baseTokenManager,
 		UnrestrictedTokenTransfer: unrestrictedTokenTransfer,
+		MintEpochBlocks:           mintEpochBlocks,
+		MintEpochCap:              mintEpochCap,
+		MaxSupply:                 maxSupply,
+		PointTokenFeeRate:         pointTokenFeeRate,
 	}
 }
 
@@ -51,6 +64,10 @@ func DefaultParams() Params {
 		DefaultPointTokenDenom,
 		"",
 		DefaultUnrestrictedTokenTransfer,
+		DefaultMintEpochBlocks,
+		DefaultMintEpochCap,
+		DefaultMaxSupply,
+		DefaultPointTokenFeeRate,
 	)
 }
 
@@ -72,17 +89,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return nil
-}
+	if err := validateMintEpochBlocks(p.MintEpochBlocks); err != nil {
+		return err
+	}
+
+	if err := validateMintEpochCap(p.MintEpochCap); err != nil {
+		return err
+	}
+
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
 
-// ParamSetPairs implements params.ParamSet
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyBaseTokenDenom, &p.BaseTokenDenom, validateBaseTokenDenom),
-		paramtypes.NewParamSetPair(KeyPointTokenDenom, &p.PointTokenDenom, validatePointTokenDenom),
-		paramtypes.NewParamSetPair(KeyBaseTokenManager, &p.BaseTokenManager, validateBaseTokenManager),
-		paramtypes.NewParamSetPair(KeyUnrestrictedTokenTransfer, &p.UnrestrictedTokenTransfer, validateUnrestrictedTokenTransfer),
+	if err := validatePointTokenFeeRate(p.PointTokenFeeRate); err != nil {
+		return err
 	}
+
+	return nil
 }
 
 func validateBaseTokenDenom(i interface{}) error {
@@ -142,3 +165,47 @@ func validateUnrestrictedTokenTransfer(i interface{}) error {
 
 	return nil
 }
+
+func validateMintEpochBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("mint epoch blocks must be greater than 0")
+	}
+
+	return nil
+}
+
+func validateMintEpochCap(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePointTokenFeeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return errors.New("point token fee rate can not be negative")
+	}
+
+	return nil
+}
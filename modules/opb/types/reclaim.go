@@ -0,0 +1,135 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReclaimSplit creates a new ReclaimSplit instance
+func NewReclaimSplit(recipient sdk.AccAddress, weight sdk.Dec) ReclaimSplit {
+	return ReclaimSplit{
+		Recipient: recipient.String(),
+		Weight:    weight,
+	}
+}
+
+// NewReclaimSchedule creates a new ReclaimSchedule instance
+func NewReclaimSchedule(
+	id uint64,
+	denom string,
+	amount sdk.Int,
+	splits []ReclaimSplit,
+	interval uint64,
+	nextHeight int64,
+	creator sdk.AccAddress,
+) ReclaimSchedule {
+	return ReclaimSchedule{
+		Id:         id,
+		Denom:      denom,
+		Amount:     amount,
+		Splits:     splits,
+		Interval:   interval,
+		NextHeight: nextHeight,
+		Creator:    creator.String(),
+	}
+}
+
+// Validate validates the reclaim schedule
+func (s ReclaimSchedule) Validate() error {
+	if s.Id == 0 {
+		return errors.New("reclaim schedule id must be greater than 0")
+	}
+
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("reclaim schedule %d: invalid denom %s: %s", s.Id, s.Denom, err)
+	}
+
+	if err := ValidateReclaimAmount(s.Amount); err != nil {
+		return fmt.Errorf("reclaim schedule %d: %s", s.Id, err)
+	}
+
+	if err := ValidateReclaimSplits(s.Splits); err != nil {
+		return fmt.Errorf("reclaim schedule %d: %s", s.Id, err)
+	}
+
+	if s.Interval == 0 {
+		return fmt.Errorf("reclaim schedule %d: interval must be greater than 0", s.Id)
+	}
+
+	if s.NextHeight <= 0 {
+		return fmt.Errorf("reclaim schedule %d: next height must be greater than 0", s.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Creator); err != nil {
+		return fmt.Errorf("invalid creator %s: %s", s.Creator, err)
+	}
+
+	return nil
+}
+
+// ValidateReclaimAmount validates the reclaim amount, where zero means the whole balance
+func ValidateReclaimAmount(amount sdk.Int) error {
+	if !amount.IsNil() && amount.IsNegative() {
+		return fmt.Errorf("amount %s can not be negative", amount)
+	}
+
+	return nil
+}
+
+// ValidateReclaimSplits validates the reclaim splits, whose weights must be positive and sum up to 1
+func ValidateReclaimSplits(splits []ReclaimSplit) error {
+	if len(splits) == 0 {
+		return errors.New("reclaim splits can not be empty")
+	}
+
+	if len(splits) > MaxReclaimSplits {
+		return fmt.Errorf("number of the reclaim splits cannot be greater than %d", MaxReclaimSplits)
+	}
+
+	total := sdk.ZeroDec()
+	recipients := make(map[string]bool)
+
+	for _, split := range splits {
+		if _, err := sdk.AccAddressFromBech32(split.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient %s: %s", split.Recipient, err)
+		}
+
+		if recipients[split.Recipient] {
+			return fmt.Errorf("duplicate recipient %s", split.Recipient)
+		}
+		recipients[split.Recipient] = true
+
+		if split.Weight.IsNil() || !split.Weight.IsPositive() {
+			return fmt.Errorf("weight of %s must be positive", split.Recipient)
+		}
+
+		total = total.Add(split.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("weights of the reclaim splits must sum up to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// DistributeReclaim distributes the amount to the splits by weight. The truncated
+// shares are rounded in favor of the last recipient so that the amount is fully distributed
+func DistributeReclaim(amount sdk.Int, splits []ReclaimSplit) []sdk.Int {
+	shares := make([]sdk.Int, len(splits))
+
+	remaining := amount
+	for i, split := range splits {
+		if i == len(splits)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		shares[i] = amount.ToDec().Mul(split.Weight).TruncateInt()
+		remaining = remaining.Sub(shares[i])
+	}
+
+	return shares
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRedemption creates a new pending Redemption instance
+func NewRedemption(
+	id uint64,
+	holder sdk.AccAddress,
+	amount sdk.Coin,
+	settlementRef string,
+	requestHeight int64,
+) Redemption {
+	return Redemption{
+		Id:            id,
+		Holder:        holder.String(),
+		Amount:        amount,
+		SettlementRef: settlementRef,
+		Status:        RedemptionStatusPending,
+		RequestHeight: requestHeight,
+	}
+}
+
+// Validate validates the redemption
+func (r Redemption) Validate() error {
+	if r.Id == 0 {
+		return errors.New("redemption id must be greater than 0")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Holder); err != nil {
+		return fmt.Errorf("invalid holder %s: %s", r.Holder, err)
+	}
+
+	if !r.Amount.IsValid() || r.Amount.IsZero() {
+		return fmt.Errorf("redemption %d: invalid amount %s", r.Id, r.Amount)
+	}
+
+	if err := ValidateSettlementRef(r.SettlementRef); err != nil {
+		return err
+	}
+
+	if _, ok := RedemptionStatus_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("redemption %d: invalid status (%d)", r.Id, r.Status)
+	}
+
+	if r.RequestHeight < 0 || r.ResolveHeight < 0 {
+		return fmt.Errorf("redemption %d: height can not be negative", r.Id)
+	}
+
+	return nil
+}
+
+// ValidateSettlementRef validates the settlement reference of a redemption
+func ValidateSettlementRef(settlementRef string) error {
+	if len(settlementRef) == 0 {
+		return errors.New("settlement reference can not be empty")
+	}
+
+	if len(settlementRef) > MaxSettlementRefLength {
+		return fmt.Errorf("length of the settlement reference cannot be greater than %d", MaxSettlementRefLength)
+	}
+
+	return nil
+}
+
+// RedemptionStatusFromString parses the redemption status from the given string,
+// which is either the short form (e.g. pending) or the full enum name
+func RedemptionStatusFromString(str string) (RedemptionStatus, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "REDEMPTION_STATUS_") {
+		name = "REDEMPTION_STATUS_" + name
+	}
+
+	status, ok := RedemptionStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid redemption status %s", str)
+	}
+
+	return RedemptionStatus(status), nil
+}
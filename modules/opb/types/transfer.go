@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the token transfer mode
+func (m TokenTransferMode) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", m.Denom, err)
+	}
+
+	if m.Mode == TransferModeUnspecified {
+		return fmt.Errorf("transfer mode of %s can not be unspecified", m.Denom)
+	}
+
+	return ValidateTransferMode(m.Mode)
+}
+
+// Validate validates the transfer allowlist
+func (a TransferAllowlist) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return fmt.Errorf("invalid denom %s: %s", a.Denom, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range a.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allowlisted address %s: %s", addr, err)
+		}
+
+		if seen[addr] {
+			return fmt.Errorf("duplicate allowlisted address %s for %s", addr, a.Denom)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// ValidateTransferMode validates the transfer mode
+func ValidateTransferMode(mode TransferMode) error {
+	if _, ok := TransferMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid transfer mode (%d)", mode)
+	}
+
+	return nil
+}
+
+// TransferModeFromString parses the transfer mode from the given string,
+// which is either the short form (e.g. allowlist) or the full enum name
+func TransferModeFromString(str string) (TransferMode, error) {
+	name := strings.ToUpper(strings.ReplaceAll(str, "-", "_"))
+	if !strings.HasPrefix(name, "TRANSFER_MODE_") {
+		name = "TRANSFER_MODE_" + name
+	}
+
+	mode, ok := TransferMode_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid transfer mode %s", str)
+	}
+
+	return TransferMode(mode), nil
+}
@@ -46,11 +46,21 @@ func (k Keeper) IsPermAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
 	return (auth & types.RolePermAdmin.Auth()) > 0
 }
 
+func (k Keeper) IsParamAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	auth := k.GetAuth(ctx, address)
+	return (auth & types.RoleParamAdmin.Auth()) > 0
+}
+
 func (k Keeper) IsBaseM1Admin(ctx sdk.Context, address sdk.AccAddress) bool {
 	auth := k.GetAuth(ctx, address)
 	return (auth & types.RoleBaseM1Admin.Auth()) > 0
 }
 
+func (k Keeper) IsIDAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	auth := k.GetAuth(ctx, address)
+	return (auth & types.RoleIDAdmin.Auth()) > 0
+}
+
 func (k Keeper) IsPlatformUser(ctx sdk.Context, address sdk.AccAddress) bool {
 	auth := k.GetAuth(ctx, address)
 	return (auth & types.RolePlatformUser.Auth()) > 0
@@ -64,7 +64,7 @@ func (k Keeper) GetAllBlockAccounts(ctx sdk.Context) (accounts []string) {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		account := sdk.AccAddress(iterator.Key()[1:])
+		account := types.AddressFromAuthOrBlackKey(iterator.Key())
 		accounts = append(accounts, account.String())
 	}
 
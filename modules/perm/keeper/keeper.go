@@ -136,7 +136,7 @@ func (k Keeper) GetRoles(ctx sdk.Context) (roleAccounts []types.RoleAccount) {
 		var role gogotypes.Int32Value
 		k.cdc.MustUnmarshal(iterator.Value(), &role)
 
-		account := sdk.AccAddress(iterator.Key()[len(types.AuthKey):])
+		account := types.AddressFromAuthOrBlackKey(iterator.Key())
 		roleAccounts = append(roleAccounts, types.RoleAccount{
 			Address: account.String(),
 			Roles:   types.Auth(role.Value).Roles(),
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/aadhi0612/iritamod/modules/perm/migrations/v2"
+)
+
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates from version 1 to 2, length-prefixing the addresses of the auth and black keys.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.k.storeKey)
+}
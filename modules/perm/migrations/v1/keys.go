@@ -0,0 +1,22 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	AuthKey  = []byte{0x01} // prefix for each key to a account auth
+	BlackKey = []byte{0x02} // prefix for each key to a black account
+)
+
+// GetAuthKey gets the key for the role with address
+// NOTE: the address is not length-prefixed in v1
+func GetAuthKey(addr sdk.AccAddress) []byte {
+	return append(AuthKey, addr...)
+}
+
+// GetBlackKey gets the key for the black with address
+// NOTE: the address is not length-prefixed in v1
+func GetBlackKey(addr sdk.AccAddress) []byte {
+	return append(BlackKey, addr...)
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	v1 "github.com/aadhi0612/iritamod/modules/perm/migrations/v1"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+//   - Length-prefix the addresses of the auth keys:
+//     0x01 | addr => 0x01 | len(addr) | addr
+//   - Length-prefix the addresses of the black keys:
+//     0x02 | addr => 0x02 | len(addr) | addr
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateAddressKeys(store, v1.AuthKey)
+	migrateAddressKeys(store, v1.BlackKey)
+
+	return nil
+}
+
+// migrateAddressKeys length-prefixes the addresses of the keys under the given prefix.
+// NOTE: the old and new keys share the prefix, so the entries are collected before rewritten
+func migrateAddressKeys(store sdk.KVStore, keyPrefix []byte) {
+	oldStore := prefix.NewStore(store, keyPrefix)
+
+	var addrs, values [][]byte
+
+	iterator := oldStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, append([]byte{}, iterator.Key()...))
+		values = append(values, append([]byte{}, iterator.Value()...))
+	}
+	iterator.Close()
+
+	for i, addr := range addrs {
+		oldStore.Delete(addr)
+		oldStore.Set(address.MustLengthPrefix(addr), values[i])
+	}
+}
@@ -0,0 +1,66 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	v1 "github.com/aadhi0612/iritamod/modules/perm/migrations/v1"
+	v2 "github.com/aadhi0612/iritamod/modules/perm/migrations/v2"
+	"github.com/aadhi0612/iritamod/modules/perm/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	permKey := sdk.NewKVStoreKey("perm")
+	ctx := testutil.DefaultContext(permKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(permKey)
+
+	rootAdmin := sdk.AccAddress("root_admin__________")
+	powerUser := sdk.AccAddress("power_user__________")
+	blocked := sdk.AccAddress("blocked_account_____")
+
+	rootAuth, err := (&gogotypes.Int32Value{Value: int32(types.RoleRootAdmin.Auth())}).Marshal()
+	require.NoError(t, err)
+	powerUserAuth, err := (&gogotypes.Int32Value{Value: int32(types.RolePowerUser.Auth())}).Marshal()
+	require.NoError(t, err)
+	black, err := (&gogotypes.BoolValue{Value: true}).Marshal()
+	require.NoError(t, err)
+
+	contractDenyListKey := types.GetContractDenyListKey(types.BytesToAddress([]byte("contract")))
+
+	// the v1 store
+	store.Set(v1.GetAuthKey(rootAdmin), rootAuth)
+	store.Set(v1.GetAuthKey(powerUser), powerUserAuth)
+	store.Set(v1.GetBlackKey(blocked), black)
+	store.Set(contractDenyListKey, []byte{})
+
+	require.NoError(t, v2.MigrateStore(ctx, permKey))
+
+	testCases := []struct {
+		name   string
+		oldKey []byte
+		newKey []byte
+		value  []byte
+	}{
+		{"root admin", v1.GetAuthKey(rootAdmin), types.GetAuthKey(rootAdmin), rootAuth},
+		{"power user", v1.GetAuthKey(powerUser), types.GetAuthKey(powerUser), powerUserAuth},
+		{"blocked account", v1.GetBlackKey(blocked), types.GetBlackKey(blocked), black},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, store.Has(tc.oldKey))
+			require.Equal(t, tc.value, store.Get(tc.newKey))
+			require.Equal(t, tc.newKey[1:], address.MustLengthPrefix(types.AddressFromAuthOrBlackKey(tc.newKey)))
+		})
+	}
+
+	// the contract deny list is left untouched
+	require.True(t, store.Has(contractDenyListKey))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -29,13 +30,20 @@ var (
 )
 
 // GetAuthKey gets the key for the role with address
+// VALUE: gogotypes.Int32Value
 func GetAuthKey(addr sdk.AccAddress) []byte {
-	return append(AuthKey, addr...)
+	return append(AuthKey, address.MustLengthPrefix(addr)...)
 }
 
 // GetBlackKey gets the key for the black with address
+// VALUE: gogotypes.BoolValue
 func GetBlackKey(addr sdk.AccAddress) []byte {
-	return append(BlackKey, addr...)
+	return append(BlackKey, address.MustLengthPrefix(addr)...)
+}
+
+// AddressFromAuthOrBlackKey returns the address from the length-prefixed auth or black key
+func AddressFromAuthOrBlackKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[2:])
 }
 
 // GetContractDenyListKey defines the full key under which a contract deny list is stored.
@@ -0,0 +1,55 @@
+package sidechain
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/keeper"
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// EndBlocker finalizes the block headers whose challenge period passes, and removes the expired space transfers
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	type pendingHeader struct {
+		spaceId uint64
+		height  uint64
+	}
+
+	var headers []pendingHeader
+	k.IterateMaturePendingHeaders(ctx, uint64(ctx.BlockHeight()), func(spaceId, height uint64) bool {
+		headers = append(headers, pendingHeader{spaceId: spaceId, height: height})
+		return false
+	})
+
+	for _, header := range headers {
+		k.FinalizeBlockHeader(ctx, header.spaceId, header.height)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFinalizeHeader,
+				sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(header.spaceId, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(header.height, 10)),
+			),
+		)
+	}
+
+	var transfers []types.SpaceTransfer
+	k.IterateExpiredSpaceTransfers(ctx, uint64(ctx.BlockHeight()), func(transfer types.SpaceTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+
+	for _, transfer := range transfers {
+		k.ExpireSpaceTransfer(ctx, transfer)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireSpaceTransfer,
+				sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(transfer.SpaceId, 10)),
+				sdk.NewAttribute(types.AttributeKeySender, transfer.Sender),
+				sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Recipient),
+			),
+		)
+	}
+}
@@ -12,13 +12,54 @@ const (
 
 	EventTypeCreateSpace   = types.EventTypeCreateSpace
 	EventTypeTransferSpace = types.EventTypeTransferSpace
-	EventTypeCreateRecord  = types.EventTypeCreateRecord
+	EventTypeUpdateSpace   = types.EventTypeUpdateSpace
+	EventTypeFreezeSpace   = types.EventTypeFreezeSpace
+	EventTypeUnfreezeSpace = types.EventTypeUnfreezeSpace
+	EventTypeArchiveSpace  = types.EventTypeArchiveSpace
+	EventTypePruneHeaders  = types.EventTypePruneHeaders
+
+	EventTypeAcceptSpaceTransfer  = types.EventTypeAcceptSpaceTransfer
+	EventTypeCancelSpaceTransfer  = types.EventTypeCancelSpaceTransfer
+	EventTypeExpireSpaceTransfer  = types.EventTypeExpireSpaceTransfer
+	EventTypeRegisterValidatorSet = types.EventTypeRegisterValidatorSet
+	EventTypeUpdateValidatorSet   = types.EventTypeUpdateValidatorSet
+	EventTypeCreateRecord         = types.EventTypeCreateRecord
+	EventTypeAddSubmitter         = types.EventTypeAddSubmitter
+	EventTypeRemoveSubmitter      = types.EventTypeRemoveSubmitter
+	EventTypeChallengeHeader      = types.EventTypeChallengeHeader
+	EventTypeResolveChallenge     = types.EventTypeResolveChallenge
+	EventTypeFinalizeHeader       = types.EventTypeFinalizeHeader
 
 	AttributeKeySender       = types.AttributeKeySender
 	AttributeKeyOwner        = types.AttributeKeyOwner
 	AttributeKeyRecipient    = types.AttributeKeyRecipient
 	AttributeKeySpaceId      = types.AttributeKeySpaceId
 	AttributeKeyRecordHeight = types.AttributeKeyRecordHeight
+	AttributeKeyHeaderHash   = types.AttributeKeyHeaderHash
+	AttributeKeySubmitter    = types.AttributeKeySubmitter
+	AttributeKeyChallenger   = types.AttributeKeyChallenger
+	AttributeKeyUpheld       = types.AttributeKeyUpheld
+	AttributeKeyHeaderStatus = types.AttributeKeyHeaderStatus
+	AttributeKeyPruneHeight  = types.AttributeKeyPruneHeight
+	AttributeKeyPruned       = types.AttributeKeyPruned
+	AttributeKeyValidators   = types.AttributeKeyValidators
+	AttributeKeyExpiryHeight = types.AttributeKeyExpiryHeight
+
+	DoNotModify = types.DoNotModify
+
+	SpaceStatusActive   = types.SpaceStatusActive
+	SpaceStatusFrozen   = types.SpaceStatusFrozen
+	SpaceStatusArchived = types.SpaceStatusArchived
+
+	HeaderStatusFinalized  = types.HeaderStatusFinalized
+	HeaderStatusPending    = types.HeaderStatusPending
+	HeaderStatusChallenged = types.HeaderStatusChallenged
+
+	RootTypeState = types.RootTypeState
+	RootTypeTx    = types.RootTypeTx
+
+	HashAlgorithmSHA256 = types.HashAlgorithmSHA256
+	HashAlgorithmSM3    = types.HashAlgorithmSM3
 )
 
 var (
@@ -27,6 +68,11 @@ var (
 	DefaultGenesis  = types.DefaultGenesisState
 	ValidateGenesis = types.ValidateGenesis
 	NewGenesisState = types.NewGenesisState
+	NewParams       = types.NewParams
+	DefaultParams   = types.DefaultParams
+	NewValidator    = types.NewValidator
+
+	ProofsFromLeaves = types.ProofsFromLeaves
 )
 
 type (
@@ -34,5 +80,37 @@ type (
 	GenesisState         = types.GenesisState
 	MsgCreateSpace       = types.MsgCreateSpace
 	MsgTransferSpace     = types.MsgTransferSpace
+	SpaceTransfer        = types.SpaceTransfer
 	MsgCreateBlockHeader = types.MsgCreateBlockHeader
+	StructuredHeader     = types.StructuredHeader
+	MsgAddSubmitter      = types.MsgAddSubmitter
+	MsgRemoveSubmitter   = types.MsgRemoveSubmitter
+	Submitter            = types.Submitter
+
+	MsgChallengeBlockHeader = types.MsgChallengeBlockHeader
+	MsgResolveChallenge     = types.MsgResolveChallenge
+	MsgCreateBlockHeaders   = types.MsgCreateBlockHeaders
+	BatchBlockHeader        = types.BatchBlockHeader
+	HeightRange             = types.HeightRange
+	MsgAcceptSpaceTransfer  = types.MsgAcceptSpaceTransfer
+	MsgCancelSpaceTransfer  = types.MsgCancelSpaceTransfer
+	MsgUpdateSpace          = types.MsgUpdateSpace
+	MsgFreezeSpace          = types.MsgFreezeSpace
+	MsgUnfreezeSpace        = types.MsgUnfreezeSpace
+	MsgArchiveSpace         = types.MsgArchiveSpace
+	MsgPruneBlockHeaders    = types.MsgPruneBlockHeaders
+	SpaceStatus             = types.SpaceStatus
+	Params                  = types.Params
+	MsgRegisterValidatorSet = types.MsgRegisterValidatorSet
+	Validator               = types.Validator
+	ValidatorSet            = types.ValidatorSet
+	ValidatorSignature      = types.ValidatorSignature
+	HeaderCommit            = types.HeaderCommit
+	HeaderStatus            = types.HeaderStatus
+	HeaderFinality          = types.HeaderFinality
+	ConflictingHeader       = types.ConflictingHeader
+	Challenge               = types.Challenge
+	RootType                = types.RootType
+	HashAlgorithm           = types.HashAlgorithm
+	InclusionProof          = types.InclusionProof
 )
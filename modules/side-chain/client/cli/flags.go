@@ -1,17 +1,78 @@
 package cli
 
-import flag "github.com/spf13/pflag"
+import (
+	flag "github.com/spf13/pflag"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
 
 const (
-	FlagName = "name"
-	FlagUri  = "uri"
+	FlagName        = "name"
+	FlagUri         = "uri"
+	FlagParentHash  = "parent-hash"
+	FlagStateRoot   = "state-root"
+	FlagTxRoot      = "tx-root"
+	FlagTimestamp   = "timestamp"
+	FlagMinHeight   = "min-height"
+	FlagMaxHeight   = "max-height"
+	FlagMaxPerBlock = "max-per-block"
+
+	FlagChallengePeriod   = "challenge-period"
+	FlagConflictingHeader = "conflicting-header"
+	FlagEvidence          = "evidence"
+	FlagUpheld            = "upheld"
+
+	FlagCommit = "commit"
+
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+
+	FlagRootType = "root-type"
+
+	FlagExpiryHeight = "expiry-height"
+	FlagRecipient    = "recipient"
 )
 
 var (
-	FsSpaceCreate = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSpaceCreate       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSpaceUpdate       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSpaceTransfer     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryTransfers    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCreateBlockHeader = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAddSubmitter      = flag.NewFlagSet("", flag.ContinueOnError)
+	FsChallengeHeader   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsResolveChallenge  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryBlockHeaders = flag.NewFlagSet("", flag.ContinueOnError)
+	FsVerifyInclusion   = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsSpaceCreate.String(FlagName, "", "name of the space")
 	FsSpaceCreate.String(FlagUri, "", "uri of the space")
+	FsSpaceUpdate.String(FlagName, types.DoNotModify, "name of the space")
+	FsSpaceUpdate.String(FlagUri, types.DoNotModify, "uri of the space")
+
+	FsSpaceTransfer.Uint64(FlagExpiryHeight, 0, "the block height after which the transfer expires if not accepted by the recipient")
+	FsQueryTransfers.String(FlagRecipient, "", "the recipient of the pending transfers, all transfers if empty")
+
+	FsSpaceCreate.Uint64(FlagChallengePeriod, 0, "the number of blocks in which the block headers can be challenged, 0 for immediate finality")
+
+	FsCreateBlockHeader.String(FlagParentHash, "", "hex encoded hash of the parent header of the structured header")
+	FsCreateBlockHeader.String(FlagStateRoot, "", "hex encoded state root of the structured header")
+	FsCreateBlockHeader.String(FlagTxRoot, "", "hex encoded tx root of the structured header")
+	FsCreateBlockHeader.String(FlagTimestamp, "", "timestamp of the structured header in RFC3339 format")
+	FsCreateBlockHeader.String(FlagCommit, "", "path to the JSON file of the validator signatures on the header, required if the validator set is registered")
+
+	FsAddSubmitter.Uint64(FlagMinHeight, 0, "the lowest height allowed to submit, 0 for no limit")
+	FsAddSubmitter.Uint64(FlagMaxHeight, 0, "the highest height allowed to submit, 0 for no limit")
+	FsAddSubmitter.Uint64(FlagMaxPerBlock, 0, "the maximum number of headers allowed to submit in a block, 0 for no limit")
+
+	FsChallengeHeader.String(FlagConflictingHeader, "", "path to the JSON file of the signed conflicting header")
+	FsChallengeHeader.String(FlagEvidence, "", "the optional description of the fraud evidence")
+	FsResolveChallenge.Bool(FlagUpheld, false, "whether the challenge is upheld, replacing the challenged header with the conflicting header")
+
+	FsQueryBlockHeaders.Uint64(FlagStartHeight, 0, "the lowest height of the block headers, 0 for no limit")
+	FsQueryBlockHeaders.Uint64(FlagEndHeight, 0, "the highest height of the block headers, 0 for no limit")
+
+	FsVerifyInclusion.String(FlagRootType, "state", "the root of the structured header to verify against, state or tx")
 }
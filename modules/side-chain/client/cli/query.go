@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,8 +25,11 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetCmdQueryParams(),
 		GetQuerySpaceCmd(),
 		GetCmdQueryBlockHeader(),
+		GetCmdQueryBlockHeaders(),
+		GetCmdQueryVerifyInclusion(),
 	)
 
 	return cmd
@@ -40,6 +45,11 @@ func GetQuerySpaceCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQuerySpaceInfo(),
 		GetCmdQuerySpacesOfOwner(),
+		GetCmdQuerySubmitters(),
+		GetCmdQueryHeaderGaps(),
+		GetCmdQueryValidatorSet(),
+		GetCmdQuerySpaceTransfer(),
+		GetCmdQuerySpaceTransfers(),
 	)
 
 	return cmd
@@ -120,6 +130,47 @@ func GetCmdQuerySpacesOfOwner() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQuerySubmitters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submitters [space-id]",
+		Long:    "query the block header submitters of the given space-id",
+		Example: fmt.Sprintf("$ %s q sidechain space submitters [space-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Submitters(
+				context.Background(),
+				&types.QuerySubmittersRequest{
+					SpaceId:    spaceId,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "submitters")
+
+	return cmd
+}
+
 func GetCmdQueryBlockHeader() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "blockheader [space-id] [height]",
@@ -159,3 +210,311 @@ func GetCmdQueryBlockHeader() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdQueryBlockHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "blockheaders [space-id]",
+		Long: "query the side chain block headers of the given space-id in the height range",
+		Example: fmt.Sprintf(
+			"$ %s q sidechain blockheaders [space-id] "+
+				"--start-height=<start-height> "+
+				"--end-height=<end-height>",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			startHeight, err := cmd.Flags().GetUint64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetUint64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.BlockHeaders(
+				context.Background(),
+				&types.QueryBlockHeadersRequest{
+					SpaceId:     spaceId,
+					StartHeight: startHeight,
+					EndHeight:   endHeight,
+					Pagination:  pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryBlockHeaders)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blockheaders")
+
+	return cmd
+}
+
+func GetCmdQueryHeaderGaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gaps [space-id]",
+		Long:    "query the missing heights between the first and the latest block header of the given space-id",
+		Example: fmt.Sprintf("$ %s q sidechain space gaps [space-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.HeaderGaps(
+				context.Background(),
+				&types.QueryHeaderGapsRequest{
+					SpaceId: spaceId,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Long:    "query the parameters of the side chain module",
+		Example: fmt.Sprintf("$ %s q sidechain params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryValidatorSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validators [space-id]",
+		Long:    "query the validator set of the side chain in the given space-id",
+		Example: fmt.Sprintf("$ %s q sidechain space validators [space-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ValidatorSet(
+				context.Background(),
+				&types.QueryValidatorSetRequest{
+					SpaceId: spaceId,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryVerifyInclusion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "verify-inclusion [space-id] [height] [leaf] [proof-file]",
+		Long: "verify that the hex encoded leaf is included in the state or tx root of the block header, " +
+			"where the proof file is in the JSON format of {\"algorithm\":<HASH_ALGORITHM_SHA256|HASH_ALGORITHM_SM3>,\"index\":<index>,\"total\":<total>,\"aunts\":[<base64-hash>]}",
+		Example: fmt.Sprintf(
+			"$ %s q sidechain verify-inclusion [space-id] [height] [leaf] [proof-file] --root-type=tx",
+			version.AppName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			leaf, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("leaf must be hex encoded: %w", err)
+			}
+
+			bz, err := os.ReadFile(args[3])
+			if err != nil {
+				return err
+			}
+
+			var proof types.InclusionProof
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &proof); err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+
+			rootTypeStr, err := cmd.Flags().GetString(FlagRootType)
+			if err != nil {
+				return err
+			}
+
+			var rootType types.RootType
+			switch rootTypeStr {
+			case "state":
+				rootType = types.RootTypeState
+			case "tx":
+				rootType = types.RootTypeTx
+			default:
+				return fmt.Errorf("invalid root type %s, must be either state or tx", rootTypeStr)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.VerifyInclusion(
+				context.Background(),
+				&types.QueryVerifyInclusionRequest{
+					SpaceId:  spaceId,
+					Height:   height,
+					RootType: rootType,
+					Leaf:     leaf,
+					Proof:    proof,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsVerifyInclusion)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySpaceTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [space-id]",
+		Long:    "query the pending transfer of the given space-id",
+		Example: fmt.Sprintf("$ %s q sidechain space transfer [space-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SpaceTransfer(
+				context.Background(),
+				&types.QuerySpaceTransferRequest{
+					SpaceId: spaceId,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQuerySpaceTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfers",
+		Long:    "query the pending space transfers, optionally to the given recipient",
+		Example: fmt.Sprintf("$ %s q sidechain space transfers --recipient=<recipient>", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SpaceTransfers(
+				context.Background(),
+				&types.QuerySpaceTransfersRequest{
+					Recipient:  recipient,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsQueryTransfers)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers")
+
+	return cmd
+}
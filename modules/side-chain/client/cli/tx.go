@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,6 +27,9 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdSpaceCmd(),
 		GetCmdCreateBlockHeader(),
+		GetCmdCreateBlockHeaders(),
+		GetCmdChallengeBlockHeader(),
+		GetCmdResolveChallenge(),
 	)
 
 	return cmd
@@ -42,6 +47,16 @@ func GetCmdSpaceCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdSpaceCreate(),
 		GetCmdSpaceTransfer(),
+		GetCmdSpaceAcceptTransfer(),
+		GetCmdSpaceCancelTransfer(),
+		GetCmdSpaceUpdate(),
+		GetCmdSpaceFreeze(),
+		GetCmdSpaceUnfreeze(),
+		GetCmdSpaceArchive(),
+		GetCmdSpacePruneBlockHeaders(),
+		GetCmdSpaceRegisterValidatorSet(),
+		GetCmdSpaceAddSubmitter(),
+		GetCmdSpaceRemoveSubmitter(),
 	)
 
 	return cmd
@@ -54,7 +69,8 @@ func GetCmdSpaceCreate() *cobra.Command {
 		Example: fmt.Sprintf(
 			"$ %s tx sidechain space create "+
 				"--name=<name> "+
-				"--uri=<uri>",
+				"--uri=<uri> "+
+				"--challenge-period=<challenge-period>",
 			version.AppName),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,9 +89,15 @@ func GetCmdSpaceCreate() *cobra.Command {
 				return nil
 			}
 
+			challengePeriod, err := cmd.Flags().GetUint64(FlagChallengePeriod)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateSpace(
 				spaceName,
 				spaceUri,
+				challengePeriod,
 				clientCtx.GetFromAddress().String(),
 			)
 
@@ -94,9 +116,9 @@ func GetCmdSpaceCreate() *cobra.Command {
 func GetCmdSpaceTransfer() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "transfer [space-id] [recipient]",
-		Long: "transfer ownership of space from sender to recipient",
+		Long: "propose to transfer ownership of space from sender to recipient, which must be accepted by the recipient no later than the expiry height",
 		Example: fmt.Sprintf(
-			"$ %s tx sidechain space transfer [space-id] [recipient]",
+			"$ %s tx sidechain space transfer [space-id] [recipient] --expiry-height=<expiry-height>",
 			version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -110,12 +132,451 @@ func GetCmdSpaceTransfer() *cobra.Command {
 				return err
 			}
 
+			expiryHeight, err := cmd.Flags().GetUint64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransferSpace(
 				spaceId,
 				args[1],
+				expiryHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSpaceTransfer)
+	_ = cmd.MarkFlagRequired(FlagExpiryHeight)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceAcceptTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "accept-transfer [space-id]",
+		Long: "accept the pending transfer of the space, which makes the sender the space owner",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space accept-transfer [space-id]",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptSpaceTransfer(
+				spaceId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceCancelTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "cancel-transfer [space-id]",
+		Long: "cancel the pending transfer of the space proposed by the sender",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space cancel-transfer [space-id]",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSpaceTransfer(
+				spaceId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "update [space-id]",
+		Long: "update the name and uri of the space",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space update [space-id] "+
+				"--name=<name> "+
+				"--uri=<uri>",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			spaceName, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+
+			spaceUri, err := cmd.Flags().GetString(FlagUri)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateSpace(
+				spaceId,
+				spaceName,
+				spaceUri,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSpaceUpdate)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "freeze [space-id]",
+		Long: "freeze the space, which rejects the block headers until unfrozen",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space freeze [space-id]",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeSpace(
+				spaceId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "unfreeze [space-id]",
+		Long: "unfreeze the frozen space",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space unfreeze [space-id]",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeSpace(
+				spaceId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceArchive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "archive [space-id]",
+		Long: "retire the space permanently, which becomes read only",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space archive [space-id]",
+			version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveSpace(
+				spaceId,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpacePruneBlockHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "prune-blockheaders [space-id] [height]",
+		Long: "prune the finalized block headers of the space below the height, retaining the block headers of the latest heights",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space prune-blockheaders [space-id] [height]",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPruneBlockHeaders(
+				spaceId,
+				height,
 				clientCtx.GetFromAddress().String(),
 			)
 
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceRegisterValidatorSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "register-validators [space-id] [validators-file]",
+		Long: "register the initial validator set of the side chain in the space, " +
+			"where the validators file is in the JSON format of {\"validators\":[{\"pub_key\":<bech32-consensus-pubkey>,\"power\":<power>}]}",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space register-validators [space-id] [validators-file]",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var valSet types.MsgRegisterValidatorSet
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &valSet); err != nil {
+				return fmt.Errorf("invalid validators file: %w", err)
+			}
+
+			msg := types.NewMsgRegisterValidatorSet(
+				spaceId,
+				valSet.Validators,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceAddSubmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "add-submitter [space-id] [submitter]",
+		Long: "authorize a block header submitter of the space, optionally limited in the height range and the submissions per block",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space add-submitter [space-id] [submitter] "+
+				"--min-height=<min-height> "+
+				"--max-height=<max-height> "+
+				"--max-per-block=<max-per-block>",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			minHeight, err := cmd.Flags().GetUint64(FlagMinHeight)
+			if err != nil {
+				return err
+			}
+
+			maxHeight, err := cmd.Flags().GetUint64(FlagMaxHeight)
+			if err != nil {
+				return err
+			}
+
+			maxPerBlock, err := cmd.Flags().GetUint64(FlagMaxPerBlock)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddSubmitter(
+				spaceId,
+				args[1],
+				minHeight,
+				maxHeight,
+				maxPerBlock,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsAddSubmitter)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSpaceRemoveSubmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "remove-submitter [space-id] [submitter]",
+		Long: "revoke a block header submitter of the space",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain space remove-submitter [space-id] [submitter]",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSubmitter(
+				spaceId,
+				args[1],
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -128,9 +589,91 @@ func GetCmdSpaceTransfer() *cobra.Command {
 func GetCmdCreateBlockHeader() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:  "create-blockheader [space-id] [height] [header]",
-		Long: "create a side chain block header record",
+		Long: "create a side chain block header record, optionally carrying a structured header specified by the flags",
 		Example: fmt.Sprintf(
-			"$ %s tx sidechain create-blockheader [space-id] [height] [header]",
+			"$ %s tx sidechain create-blockheader [space-id] [height] [header]\n"+
+				"$ %s tx sidechain create-blockheader [space-id] [height] "+
+				"--parent-hash=<parent-hash> "+
+				"--state-root=<state-root> "+
+				"--tx-root=<tx-root> "+
+				"--timestamp=<timestamp> "+
+				"--commit=<commit-file>",
+			version.AppName, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			header := ""
+			if len(args) > 2 {
+				header = args[2]
+			}
+
+			msg := types.NewMsgCreateBlockHeader(
+				spaceId,
+				height,
+				header,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if cmd.Flags().Changed(FlagStateRoot) {
+				structuredHeader, err := parseStructuredHeader(cmd)
+				if err != nil {
+					return err
+				}
+				msg.StructuredHeader = &structuredHeader
+			}
+
+			commitFile, err := cmd.Flags().GetString(FlagCommit)
+			if err != nil {
+				return err
+			}
+
+			if len(commitFile) > 0 {
+				bz, err := os.ReadFile(commitFile)
+				if err != nil {
+					return err
+				}
+
+				msg.Commit = &types.HeaderCommit{}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, msg.Commit); err != nil {
+					return fmt.Errorf("invalid commit: %w", err)
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCreateBlockHeader)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateBlockHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "create-blockheaders [space-id] [start-height] [headers-file]",
+		Long: "create the side chain block header records at the contiguous heights starting from the start height atomically, " +
+			"where the headers file is in the JSON format of {\"headers\":[{\"header\":<header>,\"structured_header\":<structured-header>}]}",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain create-blockheaders [space-id] [start-height] [headers-file]",
 			version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -144,23 +687,185 @@ func GetCmdCreateBlockHeader() *cobra.Command {
 				return err
 			}
 
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var batch types.MsgCreateBlockHeaders
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &batch); err != nil {
+				return fmt.Errorf("invalid headers file: %w", err)
+			}
+
+			msg := types.NewMsgCreateBlockHeaders(
+				spaceId,
+				startHeight,
+				batch.Headers,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdChallengeBlockHeader() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "challenge-blockheader [space-id] [height]",
+		Long: "challenge a pending side chain block header with a signed conflicting header, optionally described by the fraud evidence",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain challenge-blockheader [space-id] [height] "+
+				"--conflicting-header=<conflicting-header-file> "+
+				"--evidence=<evidence>",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
 			height, err := strconv.ParseUint(args[1], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgCreateBlockHeader(
+			evidence, err := cmd.Flags().GetString(FlagEvidence)
+			if err != nil {
+				return err
+			}
+
+			conflictingHeaderFile, err := cmd.Flags().GetString(FlagConflictingHeader)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(conflictingHeaderFile)
+			if err != nil {
+				return err
+			}
+
+			conflictingHeader := &types.ConflictingHeader{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, conflictingHeader); err != nil {
+				return fmt.Errorf("invalid conflicting header: %w", err)
+			}
+
+			msg := types.NewMsgChallengeBlockHeader(
 				spaceId,
 				height,
-				args[2],
+				conflictingHeader,
+				evidence,
 				clientCtx.GetFromAddress().String(),
 			)
 
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FsChallengeHeader)
 	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagConflictingHeader)
 
 	return cmd
 }
+
+func GetCmdResolveChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "resolve-challenge [space-id] [height]",
+		Long: "resolve a challenged side chain block header, replacing it with the conflicting header if the challenge is upheld",
+		Example: fmt.Sprintf(
+			"$ %s tx sidechain resolve-challenge [space-id] [height] --upheld=<true|false>",
+			version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spaceId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			upheld, err := cmd.Flags().GetBool(FlagUpheld)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveChallenge(spaceId, height, upheld, clientCtx.GetFromAddress().String())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsResolveChallenge)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseStructuredHeader(cmd *cobra.Command) (types.StructuredHeader, error) {
+	parentHash, err := cmd.Flags().GetString(FlagParentHash)
+	if err != nil {
+		return types.StructuredHeader{}, err
+	}
+
+	stateRoot, err := cmd.Flags().GetString(FlagStateRoot)
+	if err != nil {
+		return types.StructuredHeader{}, err
+	}
+
+	txRoot, err := cmd.Flags().GetString(FlagTxRoot)
+	if err != nil {
+		return types.StructuredHeader{}, err
+	}
+
+	timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+	if err != nil {
+		return types.StructuredHeader{}, err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, timestampStr)
+	if err != nil {
+		return types.StructuredHeader{}, fmt.Errorf("invalid timestamp (%s): %w", timestampStr, err)
+	}
+
+	return types.StructuredHeader{
+		ParentHash: parentHash,
+		StateRoot:  stateRoot,
+		TxRoot:     txRoot,
+		Timestamp:  timestamp.UTC(),
+	}, nil
+}
@@ -36,13 +36,109 @@ func (dlt ValidateSideChainDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 			if err := dlt.validateSideChainUserRole(ctx, msg.Recipient); err != nil {
 				return ctx, err
 			}
+		case *types.MsgAcceptSpaceTransfer:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgCancelSpaceTransfer:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgUpdateSpace:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgFreezeSpace:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgUnfreezeSpace:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgArchiveSpace:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgPruneBlockHeaders:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgRegisterValidatorSet:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
 		case *types.MsgCreateBlockHeader:
+			// both the space owner and the authorized submitters are required to hold the side chain user role
 			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
 				return ctx, err
 			}
 			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
 				return ctx, err
 			}
+		case *types.MsgCreateBlockHeaders:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgAddSubmitter:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Submitter); err != nil {
+				return ctx, err
+			}
+		case *types.MsgRemoveSubmitter:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
+		case *types.MsgChallengeBlockHeader:
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateSideChainUserRole(ctx, msg.Challenger); err != nil {
+				return ctx, err
+			}
+		case *types.MsgResolveChallenge:
+			// the challenges are resolved by the root admin
+			if err := dlt.validateOnlySpace(ctx, msg.SpaceId); err != nil {
+				return ctx, err
+			}
+			if err := dlt.validateRootAdmin(ctx, msg.Sender); err != nil {
+				return ctx, err
+			}
 		}
 	}
 
@@ -63,3 +159,11 @@ func (dlt ValidateSideChainDecorator) validateSideChainUserRole(ctx sdk.Context,
 	}
 	return nil
 }
+
+func (dlt ValidateSideChainDecorator) validateRootAdmin(ctx sdk.Context, addr string) error {
+	accAddr, _ := sdk.AccAddressFromBech32(addr)
+	if !dlt.permKeeper.IsRootAdmin(ctx, accAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account (%s) does not have the root admin role", addr)
+	}
+	return nil
+}
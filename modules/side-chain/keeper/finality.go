@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// ChallengeBlockHeader challenges the pending block header with a conflicting header, optionally described
+// by the fraud evidence. The conflicting header must be signed by the space owner or a submitter, and differ
+// from the recorded one, so the challenge proves the equivocation of the space. The evidence alone is not
+// verifiable and can not challenge a header. The challenged header is no longer finalized
+func (k Keeper) ChallengeBlockHeader(
+	ctx sdk.Context,
+	spaceId, height uint64,
+	conflictingHeader *types.ConflictingHeader,
+	evidence string,
+	challenger sdk.AccAddress,
+) error {
+	if !k.HasBlockHeader(ctx, spaceId, height) {
+		return sdkerrors.Wrapf(types.ErrBlockHeader, "block header does not exist at height (%d) in space (%d)", height, spaceId)
+	}
+
+	finality := k.GetHeaderFinality(ctx, spaceId, height)
+	if finality.Status != types.HeaderStatusPending {
+		return sdkerrors.Wrapf(types.ErrInvalidChallenge, "block header at height (%d) in space (%d) is %s", height, spaceId, finality.Status)
+	}
+
+	if conflictingHeader == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidChallenge, "conflicting header is required to challenge the block header")
+	}
+
+	if err := k.verifyConflictingHeader(ctx, spaceId, height, *conflictingHeader); err != nil {
+		return err
+	}
+
+	k.setChallenge(ctx, types.Challenge{
+		SpaceId:           spaceId,
+		Height:            height,
+		Challenger:        challenger.String(),
+		ConflictingHeader: conflictingHeader,
+		Evidence:          evidence,
+		BlockHeight:       ctx.BlockHeight(),
+	})
+
+	k.deletePendingHeader(ctx, finality.FinalizeHeight, spaceId, height)
+
+	finality.Status = types.HeaderStatusChallenged
+	k.setHeaderFinality(ctx, spaceId, height, finality)
+
+	return nil
+}
+
+// ResolveChallenge resolves the challenged block header, returning the hash of the resolved header. If the
+// challenge is upheld, the challenged header is replaced with the conflicting header, which must link to the
+// parent and child headers as the submitted header does. Otherwise the challenged header is kept.
+// The resolved header is finalized and the challenge is removed either way.
+// NOTE: the validator set updated by the replaced header is not restored
+func (k Keeper) ResolveChallenge(ctx sdk.Context, spaceId, height uint64, upheld bool) (tmbytes.HexBytes, error) {
+	if !k.HasBlockHeader(ctx, spaceId, height) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "block header does not exist at height (%d) in space (%d)", height, spaceId)
+	}
+
+	finality := k.GetHeaderFinality(ctx, spaceId, height)
+	if finality.Status != types.HeaderStatusChallenged {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidChallenge, "block header at height (%d) in space (%d) is %s", height, spaceId, finality.Status)
+	}
+
+	challenge, found := k.GetChallenge(ctx, spaceId, height)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidChallenge, "challenge of block header at height (%d) in space (%d) not found", height, spaceId)
+	}
+
+	if upheld {
+		if err := k.replaceBlockHeader(ctx, spaceId, height, *challenge.ConflictingHeader); err != nil {
+			return nil, err
+		}
+	}
+
+	k.deleteChallenge(ctx, spaceId, height)
+
+	finality.Status = types.HeaderStatusFinalized
+	k.setHeaderFinality(ctx, spaceId, height, finality)
+
+	hash, _ := k.GetBlockHeaderHash(ctx, spaceId, height)
+	return hash, nil
+}
+
+// replaceBlockHeader replaces the block header with the conflicting header
+func (k Keeper) replaceBlockHeader(ctx sdk.Context, spaceId, height uint64, conflictingHeader types.ConflictingHeader) error {
+	hash := conflictingHeader.Hash()
+
+	if conflictingHeader.StructuredHeader != nil {
+		if err := k.verifyHeaderLinkage(ctx, spaceId, height, *conflictingHeader.StructuredHeader, hash); err != nil {
+			return err
+		}
+		k.setStructuredHeader(ctx, spaceId, height, *conflictingHeader.StructuredHeader)
+	} else {
+		k.deleteStructuredHeader(ctx, spaceId, height)
+	}
+
+	k.setBlockHeader(ctx, spaceId, height, conflictingHeader.Header)
+	k.setBlockHeaderTxHash(ctx, spaceId, height, tmhash.Sum(ctx.TxBytes()))
+	k.setBlockHeaderHash(ctx, spaceId, height, hash)
+
+	return nil
+}
+
+// verifyConflictingHeader verifies that the conflicting header is signed by the space owner or a submitter,
+// and differs from the recorded header at the same height
+func (k Keeper) verifyConflictingHeader(ctx sdk.Context, spaceId, height uint64, conflictingHeader types.ConflictingHeader) error {
+	hash := conflictingHeader.Hash()
+
+	recordedHash, found := k.GetBlockHeaderHash(ctx, spaceId, height)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidChallenge, "hash of block header at height (%d) in space (%d) is not recorded", height, spaceId)
+	}
+
+	if recordedHash.String() == hash.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidChallenge, "header (%s) does not conflict with the recorded header", hash)
+	}
+
+	signer, err := sdk.AccAddressFromBech32(conflictingHeader.Signer)
+	if err != nil {
+		return err
+	}
+
+	if !k.HasSpaceOfOwner(ctx, signer, spaceId) && !k.HasSubmitter(ctx, spaceId, signer) {
+		return sdkerrors.Wrapf(types.ErrInvalidChallenge, "signer (%s) is neither the owner nor a submitter of space (%d)", signer, spaceId)
+	}
+
+	acc := k.acc.GetAccount(ctx, signer)
+	if acc == nil || acc.GetPubKey() == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key of signer (%s) is unknown", signer)
+	}
+
+	signBytes := types.HeaderSignBytes(ctx.ChainID(), spaceId, height, hash)
+	if !acc.GetPubKey().VerifySignature(signBytes, conflictingHeader.Signature) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid signature of the conflicting header")
+	}
+
+	return nil
+}
+
+// markBlockHeaderPending marks the block header as pending until the challenge period of the space passes
+func (k Keeper) markBlockHeaderPending(ctx sdk.Context, spaceId, height, challengePeriod uint64) {
+	finalizeHeight := uint64(ctx.BlockHeight()) + challengePeriod
+
+	k.setHeaderFinality(ctx, spaceId, height, types.HeaderFinality{
+		Status:         types.HeaderStatusPending,
+		FinalizeHeight: finalizeHeight,
+	})
+	k.setPendingHeader(ctx, finalizeHeight, spaceId, height)
+}
+
+// FinalizeBlockHeader marks the pending block header as finalized
+func (k Keeper) FinalizeBlockHeader(ctx sdk.Context, spaceId, height uint64) {
+	finality := k.GetHeaderFinality(ctx, spaceId, height)
+	k.deletePendingHeader(ctx, finality.FinalizeHeight, spaceId, height)
+
+	finality.Status = types.HeaderStatusFinalized
+	k.setHeaderFinality(ctx, spaceId, height, finality)
+}
+
+// IterateMaturePendingHeaders iterates through the pending block headers whose finalize height
+// is not greater than the given height
+func (k Keeper) IterateMaturePendingHeaders(
+	ctx sdk.Context,
+	height uint64,
+	op func(spaceId, height uint64) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixPendingHeader, types.PendingHeaderByFinalizeHeightStoreKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, spaceId, height := types.SplitPendingHeaderStoreKey(iterator.Key())
+		if stop := op(spaceId, height); stop {
+			break
+		}
+	}
+}
+
+// GetHeaderFinality returns the finality of the block header.
+// The block header without the finality recorded is finalized
+func (k Keeper) GetHeaderFinality(ctx sdk.Context, spaceId, height uint64) types.HeaderFinality {
+	var finality types.HeaderFinality
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HeaderFinalityStoreKey(spaceId, height))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &finality)
+	}
+
+	return finality
+}
+
+func (k Keeper) setHeaderFinality(ctx sdk.Context, spaceId, height uint64, finality types.HeaderFinality) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&finality)
+	store.Set(types.HeaderFinalityStoreKey(spaceId, height), bz)
+}
+
+func (k Keeper) setPendingHeader(ctx sdk.Context, finalizeHeight, spaceId, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingHeaderStoreKey(finalizeHeight, spaceId, height), types.Placeholder)
+}
+
+func (k Keeper) deletePendingHeader(ctx sdk.Context, finalizeHeight, spaceId, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingHeaderStoreKey(finalizeHeight, spaceId, height))
+}
+
+func (k Keeper) GetChallenge(ctx sdk.Context, spaceId, height uint64) (types.Challenge, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChallengeStoreKey(spaceId, height))
+	if bz == nil {
+		return types.Challenge{}, false
+	}
+
+	var challenge types.Challenge
+	k.cdc.MustUnmarshal(bz, &challenge)
+	return challenge, true
+}
+
+func (k Keeper) GetChallenges(ctx sdk.Context) []types.Challenge {
+	challenges := make([]types.Challenge, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixChallenge)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var challenge types.Challenge
+		k.cdc.MustUnmarshal(iterator.Value(), &challenge)
+		challenges = append(challenges, challenge)
+	}
+
+	return challenges
+}
+
+func (k Keeper) setChallenge(ctx sdk.Context, challenge types.Challenge) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&challenge)
+	store.Set(types.ChallengeStoreKey(challenge.SpaceId, challenge.Height), bz)
+}
+
+func (k Keeper) deleteChallenge(ctx sdk.Context, spaceId, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChallengeStoreKey(spaceId, height))
+}
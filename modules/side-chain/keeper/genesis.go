@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/aadhi0612/iritamod/modules/side-chain/types"
@@ -11,6 +13,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err.Error())
 	}
 
+	k.SetParams(ctx, data.Params)
+
+	// ensure the module account holding the space deposits exists
+	k.acc.GetModuleAccount(ctx, types.ModuleName)
+
 	k.setSpaceSequence(ctx, data.SpaceSequence)
 	for _, space := range data.Spaces {
 		owner, _ := sdk.AccAddressFromBech32(space.Owner)
@@ -23,11 +30,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		if blockHeader.TxHash != "" {
 			k.setBlockHeaderTxHashString(ctx, blockHeader.SpaceId, blockHeader.Height, blockHeader.TxHash)
 		}
+		if blockHeader.StructuredHeader != nil {
+			k.setStructuredHeader(ctx, blockHeader.SpaceId, blockHeader.Height, *blockHeader.StructuredHeader)
+		}
+		if blockHeader.Hash != "" {
+			hash, _ := hex.DecodeString(blockHeader.Hash)
+			k.setBlockHeaderHash(ctx, blockHeader.SpaceId, blockHeader.Height, hash)
+		}
+		if blockHeader.Status != types.HeaderStatusFinalized || blockHeader.FinalizeHeight != 0 {
+			k.setHeaderFinality(ctx, blockHeader.SpaceId, blockHeader.Height, types.HeaderFinality{
+				Status:         blockHeader.Status,
+				FinalizeHeight: blockHeader.FinalizeHeight,
+			})
+		}
+		if blockHeader.Status == types.HeaderStatusPending {
+			k.setPendingHeader(ctx, blockHeader.FinalizeHeight, blockHeader.SpaceId, blockHeader.Height)
+		}
 	}
 
 	for _, spaceLatestHeight := range data.SpaceLatestHeights {
 		k.setSpaceLatestHeight(ctx, spaceLatestHeight.SpaceId, spaceLatestHeight.Height)
 	}
+
+	for _, submitter := range data.Submitters {
+		k.setSubmitter(ctx, submitter)
+	}
+
+	for _, challenge := range data.Challenges {
+		k.setChallenge(ctx, challenge)
+	}
+
+	for _, valSet := range data.ValidatorSets {
+		k.setValidatorSet(ctx, valSet)
+	}
+
+	for _, transfer := range data.SpaceTransfers {
+		k.setSpaceTransfer(ctx, transfer)
+	}
+
+	for _, prunedHeight := range data.SpacePrunedHeights {
+		k.setSpacePrunedHeight(ctx, prunedHeight.SpaceId, prunedHeight.Height)
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -36,11 +79,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Spaces:             make([]types.Space, 0),
 		BlockHeaders:       make([]types.BlockHeader, 0),
 		SpaceLatestHeights: make([]types.SpaceLatestHeight, 0),
+		Submitters:         make([]types.Submitter, 0),
+		Challenges:         make([]types.Challenge, 0),
+		ValidatorSets:      make([]types.ValidatorSet, 0),
+		SpaceTransfers:     make([]types.SpaceTransfer, 0),
+		SpacePrunedHeights: make([]types.SpaceLatestHeight, 0),
 	}
 
 	data.SpaceSequence = k.GetSpaceSequence(ctx)
 	data.Spaces = k.GetSpaces(ctx)
 	data.BlockHeaders = k.GetBlockHeaders(ctx)
 	data.SpaceLatestHeights = k.GetSpaceLatestHeights(ctx)
+	data.Submitters = k.GetSubmitters(ctx)
+	data.Challenges = k.GetChallenges(ctx)
+	data.Params = k.GetParams(ctx)
+	data.ValidatorSets = k.GetValidatorSets(ctx)
+	data.SpaceTransfers = k.GetSpaceTransfers(ctx)
+	data.SpacePrunedHeights = k.GetSpacePrunedHeights(ctx)
 	return &data
 }
@@ -1,15 +1,23 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/aadhi0612/iritamod/modules/side-chain/types"
 )
 
+func (k Keeper) Params(goCtx context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 func (k Keeper) Space(goCtx context.Context, request *types.QuerySpaceRequest) (*types.QuerySpaceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -63,6 +71,83 @@ func (k Keeper) SpaceOfOwner(goCtx context.Context, req *types.QuerySpaceOfOwner
 	}, nil
 }
 
+func (k Keeper) Submitters(goCtx context.Context, req *types.QuerySubmittersRequest) (*types.QuerySubmittersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSpace(ctx, req.SpaceId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceId, "space (%d) does not exist", req.SpaceId)
+	}
+
+	submitters := make([]types.Submitter, 0)
+	pageResp, err := query.Paginate(k.getSubmitterStore(ctx, req.SpaceId), req.Pagination, func(_ []byte, value []byte) error {
+		var submitter types.Submitter
+		if err := k.cdc.Unmarshal(value, &submitter); err != nil {
+			return err
+		}
+		submitters = append(submitters, submitter)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySubmittersResponse{
+		Submitters: submitters,
+		Pagination: pageResp,
+	}, nil
+}
+
+func (k Keeper) SpaceTransfer(goCtx context.Context, req *types.QuerySpaceTransferRequest) (*types.QuerySpaceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSpace(ctx, req.SpaceId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceId, "space (%d) does not exist", req.SpaceId)
+	}
+
+	transfer, found := k.GetSpaceTransfer(ctx, req.SpaceId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "space (%d) has no pending transfer", req.SpaceId)
+	}
+
+	return &types.QuerySpaceTransferResponse{Transfer: transfer}, nil
+}
+
+func (k Keeper) SpaceTransfers(goCtx context.Context, req *types.QuerySpaceTransfersRequest) (*types.QuerySpaceTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(req.Recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(req.Recipient); err != nil {
+			return nil, err
+		}
+	}
+
+	transfers := make([]types.SpaceTransfer, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSpaceTransfer)
+	pageResp, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var transfer types.SpaceTransfer
+		if err := k.cdc.Unmarshal(value, &transfer); err != nil {
+			return false, err
+		}
+
+		if len(req.Recipient) > 0 && transfer.Recipient != req.Recipient {
+			return false, nil
+		}
+
+		if accumulate {
+			transfers = append(transfers, transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySpaceTransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageResp,
+	}, nil
+}
+
 func (k Keeper) BlockHeader(goCtx context.Context, request *types.QueryBlockHeaderRequest) (*types.QueryBlockHeaderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -81,8 +166,131 @@ func (k Keeper) BlockHeader(goCtx context.Context, request *types.QueryBlockHead
 		txHash = th
 	}
 
-	return &types.QueryBlockHeaderResponse{
+	res := &types.QueryBlockHeaderResponse{
 		TxHash: txHash,
 		Header: header,
+	}
+
+	if structuredHeader, found := k.GetStructuredHeader(ctx, request.SpaceId, request.Height); found {
+		res.StructuredHeader = &structuredHeader
+	}
+
+	// NOTE: history data didn't record block header hash, so return empty if not exist.
+	if hash, found := k.GetBlockHeaderHash(ctx, request.SpaceId, request.Height); found {
+		res.Hash = hash.String()
+	}
+
+	finality := k.GetHeaderFinality(ctx, request.SpaceId, request.Height)
+	res.Status = finality.Status
+	res.FinalizeHeight = finality.FinalizeHeight
+
+	if challenge, found := k.GetChallenge(ctx, request.SpaceId, request.Height); found {
+		res.Challenge = &challenge
+	}
+
+	return res, nil
+}
+
+func (k Keeper) BlockHeaders(goCtx context.Context, req *types.QueryBlockHeadersRequest) (*types.QueryBlockHeadersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSpace(ctx, req.SpaceId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceId, "space (%d) does not exist", req.SpaceId)
+	}
+
+	if req.EndHeight != 0 && req.StartHeight > req.EndHeight {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "start height (%d) cannot be greater than end height (%d)", req.StartHeight, req.EndHeight)
+	}
+
+	// start the iteration from the start height unless paginating by the key or offset
+	pageReq := req.Pagination
+	if req.StartHeight > 0 && (pageReq == nil || (len(pageReq.Key) == 0 && pageReq.Offset == 0)) {
+		pageReq = &query.PageRequest{Key: sdk.Uint64ToBigEndian(req.StartHeight)}
+		if req.Pagination != nil {
+			pageReq.Limit = req.Pagination.Limit
+			pageReq.CountTotal = req.Pagination.CountTotal
+			pageReq.Reverse = req.Pagination.Reverse
+		}
+	}
+
+	headers := make([]types.BlockHeader, 0)
+	pageResp, err := query.FilteredPaginate(
+		k.getBlockHeaderHeightStore(ctx, req.SpaceId),
+		pageReq,
+		func(key []byte, _ []byte, accumulate bool) (bool, error) {
+			height := sdk.BigEndianToUint64(key)
+			if height < req.StartHeight || (req.EndHeight != 0 && height > req.EndHeight) {
+				return false, nil
+			}
+
+			if accumulate {
+				header, err := k.GetBlockHeaderRecord(ctx, req.SpaceId, height)
+				if err != nil {
+					return false, err
+				}
+				headers = append(headers, header)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockHeadersResponse{
+		BlockHeaders: headers,
+		Pagination:   pageResp,
+	}, nil
+}
+
+func (k Keeper) HeaderGaps(goCtx context.Context, req *types.QueryHeaderGapsRequest) (*types.QueryHeaderGapsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSpace(ctx, req.SpaceId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceId, "space (%d) does not exist", req.SpaceId)
+	}
+
+	latestHeight, _ := k.GetSpaceLatestHeight(ctx, req.SpaceId)
+	firstHeight, gaps := k.GetHeaderGaps(ctx, req.SpaceId)
+
+	return &types.QueryHeaderGapsResponse{
+		FirstHeight:  firstHeight,
+		LatestHeight: latestHeight,
+		Gaps:         gaps,
+	}, nil
+}
+
+func (k Keeper) ValidatorSet(goCtx context.Context, req *types.QueryValidatorSetRequest) (*types.QueryValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.HasSpace(ctx, req.SpaceId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceId, "space (%d) does not exist", req.SpaceId)
+	}
+
+	valSet, found := k.GetValidatorSet(ctx, req.SpaceId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidValidatorSet, "validator set of space (%d) is not registered", req.SpaceId)
+	}
+
+	return &types.QueryValidatorSetResponse{ValidatorSet: valSet}, nil
+}
+
+func (k Keeper) VerifyInclusion(goCtx context.Context, req *types.QueryVerifyInclusionRequest) (*types.QueryVerifyInclusionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	root, err := k.GetHeaderRoot(ctx, req.SpaceId, req.Height, req.RootType)
+	if err != nil {
+		return nil, err
+	}
+
+	computedRoot, err := req.Proof.ComputeRoot(req.Leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifyInclusionResponse{
+		Verified: bytes.Equal(root, computedRoot),
+		Root:     root.String(),
+		Status:   k.GetHeaderFinality(ctx, req.SpaceId, req.Height).Status,
 	}, nil
 }
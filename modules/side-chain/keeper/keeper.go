@@ -1,23 +1,46 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/aadhi0612/iritamod/modules/side-chain/types"
 )
 
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey storetypes.StoreKey
-	acc      types.AccountKeeper
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	paramSpace paramstypes.Subspace
+	acc        types.AccountKeeper
+	bank       types.BankKeeper
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, acc types.AccountKeeper) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	paramSpace paramstypes.Subspace,
+	acc types.AccountKeeper,
+	bank types.BankKeeper,
+) Keeper {
+	// ensure the side-chain module account, which holds the space deposits, is set
+	if addr := acc.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		acc:      acc,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		acc:        acc,
+		bank:       bank,
 	}
 }
 
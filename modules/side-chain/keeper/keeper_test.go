@@ -59,7 +59,7 @@ func (s *TestSuite) SetupTest() {
 }
 
 func (s *TestSuite) prepareSideChain() {
-	id, err := s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, accAvata)
+	id, err := s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accAvata)
 	s.Require().NoError(err)
 	s.Require().Equal(avataSpaceId, id)
 }
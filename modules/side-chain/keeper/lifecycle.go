@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// UpdateSpace updates the name and uri of the space, which are not modified if set to types.DoNotModify
+func (k Keeper) UpdateSpace(ctx sdk.Context, spaceId uint64, name, uri string, sender sdk.AccAddress) error {
+	space, err := k.getOwnedSpace(ctx, spaceId, sender)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is archived", spaceId)
+	}
+
+	if name != types.DoNotModify {
+		space.Name = name
+	}
+	if uri != types.DoNotModify {
+		space.Uri = uri
+	}
+
+	k.setSpace(ctx, spaceId, space)
+	return nil
+}
+
+// FreezeSpace freezes the active space, which rejects the block headers until unfrozen
+func (k Keeper) FreezeSpace(ctx sdk.Context, spaceId uint64, sender sdk.AccAddress) error {
+	return k.setSpaceStatus(ctx, spaceId, types.SpaceStatusActive, types.SpaceStatusFrozen, sender)
+}
+
+// UnfreezeSpace unfreezes the frozen space
+func (k Keeper) UnfreezeSpace(ctx sdk.Context, spaceId uint64, sender sdk.AccAddress) error {
+	return k.setSpaceStatus(ctx, spaceId, types.SpaceStatusFrozen, types.SpaceStatusActive, sender)
+}
+
+// ArchiveSpace retires the space permanently. The archived space is read only, and its submitters are revoked.
+// The deposit of the space is refunded to the owner
+func (k Keeper) ArchiveSpace(ctx sdk.Context, spaceId uint64, sender sdk.AccAddress) error {
+	space, err := k.getOwnedSpace(ctx, spaceId, sender)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is already archived", spaceId)
+	}
+
+	if !space.Deposit.IsZero() {
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, space.Deposit); err != nil {
+			return err
+		}
+	}
+
+	space.Status = types.SpaceStatusArchived
+	space.Deposit = nil
+	k.setSpace(ctx, spaceId, space)
+	k.deleteSubmitters(ctx, spaceId)
+
+	if transfer, found := k.GetSpaceTransfer(ctx, spaceId); found {
+		k.deleteSpaceTransfer(ctx, transfer)
+	}
+
+	return nil
+}
+
+// PruneBlockHeaders prunes the finalized block headers of the space below the given height, returning
+// the number of the pruned headers. The headers of the latest heights within the header retention are kept.
+// The heights below the given height are recorded as pruned and no longer accept the block headers
+func (k Keeper) PruneBlockHeaders(ctx sdk.Context, spaceId, height uint64, sender sdk.AccAddress) (uint64, error) {
+	if _, err := k.getOwnedSpace(ctx, spaceId, sender); err != nil {
+		return 0, err
+	}
+
+	retention := k.HeaderRetention(ctx)
+	if retention == 0 {
+		return 0, sdkerrors.Wrap(types.ErrPruneNotAllowed, "block header pruning is disabled")
+	}
+
+	latestHeight, _ := k.GetSpaceLatestHeight(ctx, spaceId)
+	if latestHeight < retention || height > latestHeight-retention+1 {
+		return 0, sdkerrors.Wrapf(
+			types.ErrPruneNotAllowed,
+			"block headers of the latest (%d) heights must be retained, latest height (%d)", retention, latestHeight,
+		)
+	}
+
+	var heights []uint64
+	k.IterateBlockHeaderHeights(ctx, spaceId, func(h uint64) bool {
+		if h >= height {
+			return true
+		}
+
+		// the pending and challenged block headers are kept
+		if k.GetHeaderFinality(ctx, spaceId, h).Status == types.HeaderStatusFinalized {
+			heights = append(heights, h)
+		}
+		return false
+	})
+
+	for _, h := range heights {
+		k.deleteBlockHeader(ctx, spaceId, h)
+	}
+
+	// the pruned heights can not be submitted again, otherwise the history would be rewritten
+	if prunedHeight, _ := k.GetSpacePrunedHeight(ctx, spaceId); height-1 > prunedHeight {
+		k.setSpacePrunedHeight(ctx, spaceId, height-1)
+	}
+
+	return uint64(len(heights)), nil
+}
+
+// getOwnedSpace returns the space owned by the given owner
+func (k Keeper) getOwnedSpace(ctx sdk.Context, spaceId uint64, owner sdk.AccAddress) (types.Space, error) {
+	space, err := k.GetSpace(ctx, spaceId)
+	if err != nil {
+		return types.Space{}, err
+	}
+
+	if !k.HasSpaceOfOwner(ctx, owner, spaceId) {
+		return types.Space{}, sdkerrors.Wrapf(types.ErrInvalidSpaceOwner, "space (%d) is not owned by (%s)", spaceId, owner.String())
+	}
+
+	return space, nil
+}
+
+// setSpaceStatus transits the status of the space owned by the sender
+func (k Keeper) setSpaceStatus(ctx sdk.Context, spaceId uint64, from, to types.SpaceStatus, sender sdk.AccAddress) error {
+	space, err := k.getOwnedSpace(ctx, spaceId, sender)
+	if err != nil {
+		return err
+	}
+
+	if space.Status != from {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is %s", spaceId, space.Status)
+	}
+
+	space.Status = to
+	k.setSpace(ctx, spaceId, space)
+
+	return nil
+}
+
+// deleteBlockHeader deletes the block header with the associated records
+func (k Keeper) deleteBlockHeader(ctx sdk.Context, spaceId, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BlockHeaderStoreKey(spaceId, height))
+	store.Delete(types.BlockHeaderHeightStoreKey(spaceId, height))
+	store.Delete(types.BlockHeaderTxHashStoreKey(spaceId, height))
+	store.Delete(types.BlockHeaderHashStoreKey(spaceId, height))
+	store.Delete(types.StructuredHeaderStoreKey(spaceId, height))
+	store.Delete(types.HeaderFinalityStoreKey(spaceId, height))
+	store.Delete(types.ChallengeStoreKey(spaceId, height))
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/aadhi0612/iritamod/modules/side-chain/migrations/v2"
+	v3 "github.com/aadhi0612/iritamod/modules/side-chain/migrations/v3"
+)
+
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates from version 1 to 2, indexing the existing block headers by height.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.k.storeKey)
+}
+
+// Migrate2to3 migrates from version 2 to 3, setting the params absent from the store to the default values.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.k.paramSpace)
+}
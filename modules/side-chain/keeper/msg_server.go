@@ -28,7 +28,7 @@ func (m msgServer) CreateSpace(goCtx context.Context, msg *types.MsgCreateSpace)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	spaceId, err := m.Keeper.CreateSpace(ctx, msg.Name, msg.Uri, sender)
+	spaceId, err := m.Keeper.CreateSpace(ctx, msg.Name, msg.Uri, msg.ChallengePeriod, sender)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func (m msgServer) TransferSpace(goCtx context.Context, msg *types.MsgTransferSp
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err = m.Keeper.TransferSpace(ctx, msg.SpaceId, sender, recipient)
+	err = m.Keeper.TransferSpace(ctx, msg.SpaceId, sender, recipient, msg.ExpiryHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -68,12 +68,209 @@ func (m msgServer) TransferSpace(goCtx context.Context, msg *types.MsgTransferSp
 			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
 			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatUint(msg.ExpiryHeight, 10)),
 		),
 	})
 
 	return &types.MsgTransferSpaceResponse{}, nil
 }
 
+// AcceptSpaceTransfer accepts the pending transfer of a space
+func (m msgServer) AcceptSpaceTransfer(goCtx context.Context, msg *types.MsgAcceptSpaceTransfer) (*types.MsgAcceptSpaceTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.AcceptSpaceTransfer(ctx, msg.SpaceId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptSpaceTransfer,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgAcceptSpaceTransferResponse{}, nil
+}
+
+// CancelSpaceTransfer cancels the pending transfer of a space
+func (m msgServer) CancelSpaceTransfer(goCtx context.Context, msg *types.MsgCancelSpaceTransfer) (*types.MsgCancelSpaceTransferResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.CancelSpaceTransfer(ctx, msg.SpaceId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelSpaceTransfer,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCancelSpaceTransferResponse{}, nil
+}
+
+// UpdateSpace updates the name and uri of a space
+func (m msgServer) UpdateSpace(goCtx context.Context, msg *types.MsgUpdateSpace) (*types.MsgUpdateSpaceResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UpdateSpace(ctx, msg.SpaceId, msg.Name, msg.Uri, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateSpace,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUpdateSpaceResponse{}, nil
+}
+
+// FreezeSpace freezes a space
+func (m msgServer) FreezeSpace(goCtx context.Context, msg *types.MsgFreezeSpace) (*types.MsgFreezeSpaceResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.FreezeSpace(ctx, msg.SpaceId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeSpace,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgFreezeSpaceResponse{}, nil
+}
+
+// UnfreezeSpace unfreezes a space
+func (m msgServer) UnfreezeSpace(goCtx context.Context, msg *types.MsgUnfreezeSpace) (*types.MsgUnfreezeSpaceResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.UnfreezeSpace(ctx, msg.SpaceId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeSpace,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnfreezeSpaceResponse{}, nil
+}
+
+// ArchiveSpace retires a space permanently
+func (m msgServer) ArchiveSpace(goCtx context.Context, msg *types.MsgArchiveSpace) (*types.MsgArchiveSpaceResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ArchiveSpace(ctx, msg.SpaceId, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeArchiveSpace,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgArchiveSpaceResponse{}, nil
+}
+
+// RegisterValidatorSet registers the initial validator set of the side chain in a space
+func (m msgServer) RegisterValidatorSet(goCtx context.Context, msg *types.MsgRegisterValidatorSet) (*types.MsgRegisterValidatorSetResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RegisterValidatorSet(ctx, msg.SpaceId, msg.Validators, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterValidatorSet,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidators, strconv.Itoa(len(msg.Validators))),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRegisterValidatorSetResponse{}, nil
+}
+
+// PruneBlockHeaders prunes the block headers of a space below a height
+func (m msgServer) PruneBlockHeaders(goCtx context.Context, msg *types.MsgPruneBlockHeaders) (*types.MsgPruneBlockHeadersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, err := m.Keeper.PruneBlockHeaders(ctx, msg.SpaceId, msg.Height, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneHeaders,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPruneHeight, strconv.FormatUint(msg.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyPruned, strconv.FormatUint(pruned, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPruneBlockHeadersResponse{Pruned: pruned}, nil
+}
+
 // CreateBlockHeader creates a layer 2 record
 func (m msgServer) CreateBlockHeader(goCtx context.Context, msg *types.MsgCreateBlockHeader) (*types.MsgCreateBlockHeaderResponse, error) {
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -83,7 +280,7 @@ func (m msgServer) CreateBlockHeader(goCtx context.Context, msg *types.MsgCreate
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	err = m.Keeper.CreateBlockHeader(ctx, msg.SpaceId, msg.Height, msg.Header, sender)
+	hash, err := m.Keeper.CreateBlockHeader(ctx, msg.SpaceId, msg.Height, msg.Header, msg.StructuredHeader, msg.Commit, sender)
 	if err != nil {
 		return nil, err
 	}
@@ -93,8 +290,171 @@ func (m msgServer) CreateBlockHeader(goCtx context.Context, msg *types.MsgCreate
 			types.EventTypeCreateRecord,
 			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
 			sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(msg.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeaderHash, hash.String()),
+			sdk.NewAttribute(types.AttributeKeyHeaderStatus, m.Keeper.GetHeaderFinality(ctx, msg.SpaceId, msg.Height).Status.String()),
+		),
+	})
+
+	if msg.Commit != nil && len(msg.Commit.NextValidators) > 0 {
+		m.emitUpdateValidatorSetEvent(ctx, msg.SpaceId, msg.Height, len(msg.Commit.NextValidators))
+	}
+
+	return &types.MsgCreateBlockHeaderResponse{Hash: hash.String()}, nil
+}
+
+// CreateBlockHeaders creates a contiguous range of layer 2 records
+func (m msgServer) CreateBlockHeaders(goCtx context.Context, msg *types.MsgCreateBlockHeaders) (*types.MsgCreateBlockHeadersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hashes, err := m.Keeper.CreateBlockHeaders(ctx, msg.SpaceId, msg.StartHeight, msg.Headers, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgCreateBlockHeadersResponse{Hashes: make([]string, len(hashes))}
+	for i, hash := range hashes {
+		height := msg.StartHeight + uint64(i)
+		res.Hashes[i] = hash.String()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCreateRecord,
+				sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(height, 10)),
+				sdk.NewAttribute(types.AttributeKeyHeaderHash, hash.String()),
+				sdk.NewAttribute(types.AttributeKeyHeaderStatus, m.Keeper.GetHeaderFinality(ctx, msg.SpaceId, height).Status.String()),
+			),
+		)
+
+		if commit := msg.Headers[i].Commit; commit != nil && len(commit.NextValidators) > 0 {
+			m.emitUpdateValidatorSetEvent(ctx, msg.SpaceId, height, len(commit.NextValidators))
+		}
+	}
+
+	return res, nil
+}
+
+// AddSubmitter authorizes a block header submitter of a space
+func (m msgServer) AddSubmitter(goCtx context.Context, msg *types.MsgAddSubmitter) (*types.MsgAddSubmitterResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	submitter := types.Submitter{
+		SpaceId:     msg.SpaceId,
+		Address:     msg.Submitter,
+		MinHeight:   msg.MinHeight,
+		MaxHeight:   msg.MaxHeight,
+		MaxPerBlock: msg.MaxPerBlock,
+	}
+	if err := m.Keeper.AddSubmitter(ctx, submitter, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddSubmitter,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgAddSubmitterResponse{}, nil
+}
+
+// RemoveSubmitter revokes a block header submitter of a space
+func (m msgServer) RemoveSubmitter(goCtx context.Context, msg *types.MsgRemoveSubmitter) (*types.MsgRemoveSubmitterResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	submitter, err := sdk.AccAddressFromBech32(msg.Submitter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.RemoveSubmitter(ctx, msg.SpaceId, submitter, sender); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveSubmitter,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRemoveSubmitterResponse{}, nil
+}
+
+// ChallengeBlockHeader challenges a pending block header
+func (m msgServer) ChallengeBlockHeader(goCtx context.Context, msg *types.MsgChallengeBlockHeader) (*types.MsgChallengeBlockHeaderResponse, error) {
+	challenger, err := sdk.AccAddressFromBech32(msg.Challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.Keeper.ChallengeBlockHeader(ctx, msg.SpaceId, msg.Height, msg.ConflictingHeader, msg.Evidence, challenger); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChallengeHeader,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(msg.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyChallenger, msg.Challenger),
 		),
 	})
 
-	return &types.MsgCreateBlockHeaderResponse{}, nil
+	return &types.MsgChallengeBlockHeaderResponse{}, nil
+}
+
+// ResolveChallenge resolves a challenged block header
+func (m msgServer) ResolveChallenge(goCtx context.Context, msg *types.MsgResolveChallenge) (*types.MsgResolveChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hash, err := m.Keeper.ResolveChallenge(ctx, msg.SpaceId, msg.Height, msg.Upheld)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResolveChallenge,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(msg.SpaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(msg.Height, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeaderHash, hash.String()),
+			sdk.NewAttribute(types.AttributeKeyUpheld, strconv.FormatBool(msg.Upheld)),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgResolveChallengeResponse{}, nil
+}
+
+func (m msgServer) emitUpdateValidatorSetEvent(ctx sdk.Context, spaceId, height uint64, validators int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateValidatorSet,
+			sdk.NewAttribute(types.AttributeKeySpaceId, strconv.FormatUint(spaceId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordHeight, strconv.FormatUint(height, 10)),
+			sdk.NewAttribute(types.AttributeKeyValidators, strconv.Itoa(validators)),
+		),
+	)
 }
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// ParamKeyTable for side-chain module
+func ParamKeyTable() paramstypes.KeyTable {
+	return paramstypes.NewKeyTable().RegisterParamSet(&types.Params{})
+}
+
+// HeaderRetention returns the number of the latest heights of a space whose block headers cannot be pruned
+func (k Keeper) HeaderRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyHeaderRetention, &res)
+	return
+}
+
+// SpaceDeposit returns the refundable deposit to create a space
+func (k Keeper) SpaceDeposit(ctx sdk.Context) (res sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeySpaceDeposit, &res)
+	return
+}
+
+// HeaderFee returns the fee paid for each submitted block header
+func (k Keeper) HeaderFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramSpace.Get(ctx, types.KeyHeaderFee, &res)
+	return
+}
+
+// HeaderFeeRecipient returns the recipient of the block header fees, empty for the fee collector
+func (k Keeper) HeaderFeeRecipient(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyHeaderFeeRecipient, &res)
+	return
+}
+
+// MaxHeaderBytes returns the maximum size of the opaque block header in bytes, 0 for no limit
+func (k Keeper) MaxHeaderBytes(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxHeaderBytes, &res)
+	return
+}
+
+// MaxSpacesPerOwner returns the maximum number of the spaces owned by an account, 0 for no limit
+func (k Keeper) MaxSpacesPerOwner(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxSpacesPerOwner, &res)
+	return
+}
+
+// MaxFutureHeightGap returns the maximum gap between the submitted height and the latest height of a space, 0 for no limit
+func (k Keeper) MaxFutureHeightGap(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxFutureHeightGap, &res)
+	return
+}
+
+// AllowNonMonotonic returns whether the block headers below the latest height of a space can be submitted
+func (k Keeper) AllowNonMonotonic(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyAllowNonMonotonic, &res)
+	return
+}
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params to the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// VerifyInclusionProof verifies that the leaf is included in the state or tx root of the structured block header
+// at the given height in the space with the Merkle proof. The block header must be finalized, as the pending
+// and challenged headers may be replaced
+func (k Keeper) VerifyInclusionProof(
+	ctx sdk.Context,
+	spaceId, height uint64,
+	rootType types.RootType,
+	leaf []byte,
+	proof types.InclusionProof,
+) error {
+	root, err := k.GetHeaderRoot(ctx, spaceId, height, rootType)
+	if err != nil {
+		return err
+	}
+
+	if status := k.GetHeaderFinality(ctx, spaceId, height).Status; status != types.HeaderStatusFinalized {
+		return sdkerrors.Wrapf(types.ErrBlockHeader, "block header at height (%d) in space (%d) is %s", height, spaceId, status)
+	}
+
+	computedRoot, err := proof.ComputeRoot(leaf)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(root, computedRoot) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInclusionProof,
+			"computed root (%X) mismatches the %s (%s) at height (%d) in space (%d)", computedRoot, rootType, root, height, spaceId,
+		)
+	}
+
+	return nil
+}
+
+// GetHeaderRoot returns the state or tx root of the structured block header at the given height in the space
+func (k Keeper) GetHeaderRoot(ctx sdk.Context, spaceId, height uint64, rootType types.RootType) (tmbytes.HexBytes, error) {
+	if !k.HasBlockHeader(ctx, spaceId, height) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "block header does not exist at height (%d) in space (%d)", height, spaceId)
+	}
+
+	structuredHeader, found := k.GetStructuredHeader(ctx, spaceId, height)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "block header at height (%d) in space (%d) is not structured", height, spaceId)
+	}
+
+	var root string
+	switch rootType {
+	case types.RootTypeState:
+		root = structuredHeader.StateRoot
+	case types.RootTypeTx:
+		root = structuredHeader.TxRoot
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInclusionProof, "invalid root type (%d)", rootType)
+	}
+
+	bz, err := hex.DecodeString(root)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "invalid %s (%s)", rootType, root)
+	}
+
+	return bz, nil
+}
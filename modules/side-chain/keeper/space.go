@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/hex"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -10,21 +11,35 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/aadhi0612/iritamod/modules/side-chain/types"
 )
 
 // CreateSpace creates a new space
-func (k Keeper) CreateSpace(ctx sdk.Context, name, uri string, sender sdk.AccAddress) (uint64, error) {
+func (k Keeper) CreateSpace(ctx sdk.Context, name, uri string, challengePeriod uint64, sender sdk.AccAddress) (uint64, error) {
+	if err := k.checkSpaceLimit(ctx, sender); err != nil {
+		return 0, err
+	}
+
+	deposit := k.SpaceDeposit(ctx)
+	if !deposit.IsZero() {
+		if err := k.bank.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit); err != nil {
+			return 0, err
+		}
+	}
+
 	// increment the max space id and save it
 	k.incrSpaceSequence(ctx)
 	spaceId := k.GetSpaceSequence(ctx)
 
 	space := types.Space{
-		Id:    spaceId,
-		Name:  name,
-		Uri:   uri,
-		Owner: sender.String(),
+		Id:              spaceId,
+		Name:            name,
+		Uri:             uri,
+		Owner:           sender.String(),
+		ChallengePeriod: challengePeriod,
+		Deposit:         deposit,
 	}
 
 	k.setSpace(ctx, spaceId, space)
@@ -32,38 +47,77 @@ func (k Keeper) CreateSpace(ctx sdk.Context, name, uri string, sender sdk.AccAdd
 	return spaceId, nil
 }
 
-// TransferSpace transfer the space ownership
-func (k Keeper) TransferSpace(ctx sdk.Context, spaceId uint64, from, to sdk.AccAddress) error {
+// CreateBlockHeader creates a layer2 block header record, returning the header hash.
+// The sender must be the space owner or an authorized submitter.
+// The structured header, if provided, must link to the hash of the parent header when recorded.
+// The header is pending until the challenge period of the space passes, if any.
+// Only the active space accepts the block headers.
+// The header must be committed by the validators of the space if the validator set is registered,
+// and the validator set is updated to the next validators of the commit, if any
+func (k Keeper) CreateBlockHeader(
+	ctx sdk.Context,
+	spaceId, height uint64,
+	header string,
+	structuredHeader *types.StructuredHeader,
+	commit *types.HeaderCommit,
+	sender sdk.AccAddress,
+) (tmbytes.HexBytes, error) {
 	space, err := k.GetSpace(ctx, spaceId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if !k.HasSpaceOfOwner(ctx, from, spaceId) {
-		return sdkerrors.Wrapf(types.ErrInvalidSpaceOwner, "space (%d) is not owned by (%s)", spaceId, from.String())
+	if space.Status != types.SpaceStatusActive {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is %s", spaceId, space.Status)
 	}
 
-	space.Owner = to.String()
+	if err := k.authorizeSubmission(ctx, spaceId, height, sender); err != nil {
+		return nil, err
+	}
 
-	k.setSpace(ctx, spaceId, space)
-	k.deleteSpaceOfOwner(ctx, spaceId, from)
-	k.setSpaceOfOwner(ctx, spaceId, to)
+	if err := k.checkSubmissionLimits(ctx, spaceId, height, header); err != nil {
+		return nil, err
+	}
 
-	return nil
-}
+	if k.HasBlockHeader(ctx, spaceId, height) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockHeader, "block header already exists at height (%d) in space (%d)", height, spaceId)
+	}
 
-// CreateBlockHeader creates a layer2 block header record
-func (k Keeper) CreateBlockHeader(ctx sdk.Context, spaceId, height uint64, header string, sender sdk.AccAddress) error {
-	if !k.HasSpaceOfOwner(ctx, sender, spaceId) {
-		return sdkerrors.Wrapf(types.ErrInvalidSpaceOwner, "space (%d) is not owned by (%s)", spaceId, sender.String())
+	hash := types.GetHeaderHash(header, structuredHeader)
+
+	if structuredHeader != nil {
+		if err := k.verifyHeaderLinkage(ctx, spaceId, height, *structuredHeader, hash); err != nil {
+			return nil, err
+		}
 	}
 
-	if k.HasBlockHeader(ctx, spaceId, height) {
-		return sdkerrors.Wrapf(types.ErrBlockHeader, "block header already exists at height (%d) in space (%d)", height, spaceId)
+	if err := k.verifyHeaderCommit(ctx, spaceId, height, hash, commit); err != nil {
+		return nil, err
+	}
+
+	if err := k.payHeaderFee(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	if structuredHeader != nil {
+		k.setStructuredHeader(ctx, spaceId, height, *structuredHeader)
+	}
+
+	if commit != nil && len(commit.NextValidators) > 0 {
+		k.setValidatorSet(ctx, types.ValidatorSet{
+			SpaceId:    spaceId,
+			Height:     height,
+			Validators: commit.NextValidators,
+		})
 	}
 
 	k.setBlockHeader(ctx, spaceId, height, header)
 	k.setBlockHeaderTxHash(ctx, spaceId, height, tmhash.Sum(ctx.TxBytes()))
+	k.setBlockHeaderHash(ctx, spaceId, height, hash)
+
+	if space.ChallengePeriod > 0 {
+		k.markBlockHeaderPending(ctx, spaceId, height, space.ChallengePeriod)
+	}
 
 	// update the latest side chain height
 	latestHeight, exist := k.GetSpaceLatestHeight(ctx, spaceId)
@@ -71,6 +125,62 @@ func (k Keeper) CreateBlockHeader(ctx sdk.Context, spaceId, height uint64, heade
 		k.setSpaceLatestHeight(ctx, spaceId, height)
 	}
 
+	return hash, nil
+}
+
+// CreateBlockHeaders creates the layer2 block headers at the contiguous heights starting from the start height,
+// returning the header hashes. No header is created if any of them fails
+func (k Keeper) CreateBlockHeaders(
+	ctx sdk.Context,
+	spaceId, startHeight uint64,
+	headers []types.BatchBlockHeader,
+	sender sdk.AccAddress,
+) ([]tmbytes.HexBytes, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	hashes := make([]tmbytes.HexBytes, len(headers))
+	for i, header := range headers {
+		hash, err := k.CreateBlockHeader(
+			cacheCtx, spaceId, startHeight+uint64(i), header.Header, header.StructuredHeader, header.Commit, sender,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hashes[i] = hash
+	}
+
+	writeCache()
+	return hashes, nil
+}
+
+// verifyHeaderLinkage verifies that the structured header links to the hash of the parent header,
+// and the structured child header, if any, links to the hash of the given header
+func (k Keeper) verifyHeaderLinkage(
+	ctx sdk.Context,
+	spaceId, height uint64,
+	structuredHeader types.StructuredHeader,
+	hash tmbytes.HexBytes,
+) error {
+	if height > 1 {
+		if parentHash, found := k.GetBlockHeaderHash(ctx, spaceId, height-1); found {
+			if !equalHexHash(structuredHeader.ParentHash, parentHash) {
+				return sdkerrors.Wrapf(
+					types.ErrParentHashMismatch,
+					"header at height (%d) in space (%d) must link to the parent hash (%s)", height, spaceId, parentHash,
+				)
+			}
+		}
+	}
+
+	if child, found := k.GetStructuredHeader(ctx, spaceId, height+1); found && len(child.ParentHash) > 0 {
+		if !equalHexHash(child.ParentHash, hash) {
+			return sdkerrors.Wrapf(
+				types.ErrParentHashMismatch,
+				"header hash (%s) does not match the parent hash of the child header at height (%d) in space (%d)", hash, height+1, spaceId,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -176,6 +286,15 @@ func (k Keeper) GetBlockHeaders(ctx sdk.Context) []types.BlockHeader {
 		BlockHeader.SpaceId = spaceId
 		BlockHeader.Height = height
 		BlockHeader.Header = string(iterator.Value())
+		if structuredHeader, found := k.GetStructuredHeader(ctx, spaceId, height); found {
+			BlockHeader.StructuredHeader = &structuredHeader
+		}
+		if hash, found := k.GetBlockHeaderHash(ctx, spaceId, height); found {
+			BlockHeader.Hash = hash.String()
+		}
+		finality := k.GetHeaderFinality(ctx, spaceId, height)
+		BlockHeader.Status = finality.Status
+		BlockHeader.FinalizeHeight = finality.FinalizeHeight
 		headers = append(headers, BlockHeader)
 	}
 
@@ -209,6 +328,69 @@ func (k Keeper) HasBlockHeader(ctx sdk.Context, spaceId, height uint64) bool {
 func (k Keeper) setBlockHeader(ctx sdk.Context, spaceId, blockHeight uint64, header string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.BlockHeaderStoreKey(spaceId, blockHeight), []byte(header))
+	store.Set(types.BlockHeaderHeightStoreKey(spaceId, blockHeight), types.Placeholder)
+}
+
+// GetBlockHeaderRecord returns the block header record with the structured header, hash and finality
+func (k Keeper) GetBlockHeaderRecord(ctx sdk.Context, spaceId, height uint64) (types.BlockHeader, error) {
+	header, err := k.GetBlockHeader(ctx, spaceId, height)
+	if err != nil {
+		return types.BlockHeader{}, err
+	}
+
+	record := types.BlockHeader{
+		SpaceId: spaceId,
+		Height:  height,
+		Header:  header,
+	}
+
+	if k.HasBlockHeaderTxHash(ctx, spaceId, height) {
+		if record.TxHash, err = k.GetBlockHeaderTxHash(ctx, spaceId, height); err != nil {
+			return types.BlockHeader{}, err
+		}
+	}
+	if structuredHeader, found := k.GetStructuredHeader(ctx, spaceId, height); found {
+		record.StructuredHeader = &structuredHeader
+	}
+	if hash, found := k.GetBlockHeaderHash(ctx, spaceId, height); found {
+		record.Hash = hash.String()
+	}
+
+	finality := k.GetHeaderFinality(ctx, spaceId, height)
+	record.Status = finality.Status
+	record.FinalizeHeight = finality.FinalizeHeight
+
+	return record, nil
+}
+
+// IterateBlockHeaderHeights iterates through the heights of the block headers of the space in ascending order
+func (k Keeper) IterateBlockHeaderHeights(ctx sdk.Context, spaceId uint64, op func(height uint64) (stop bool)) {
+	iterator := k.getBlockHeaderHeightStore(ctx, spaceId).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if stop := op(sdk.BigEndianToUint64(iterator.Key())); stop {
+			break
+		}
+	}
+}
+
+// GetHeaderGaps returns the missing height ranges between the first and the latest block header of the space
+func (k Keeper) GetHeaderGaps(ctx sdk.Context, spaceId uint64) (firstHeight uint64, gaps []types.HeightRange) {
+	gaps = make([]types.HeightRange, 0)
+
+	var prevHeight uint64
+	k.IterateBlockHeaderHeights(ctx, spaceId, func(height uint64) bool {
+		if firstHeight == 0 {
+			firstHeight = height
+		} else if height > prevHeight+1 {
+			gaps = append(gaps, types.HeightRange{Start: prevHeight + 1, End: height - 1})
+		}
+		prevHeight = height
+		return false
+	})
+
+	return firstHeight, gaps
 }
 
 func (k Keeper) HasBlockHeaderTxHash(ctx sdk.Context, spaceId, blockHeight uint64) bool {
@@ -235,6 +417,46 @@ func (k Keeper) setBlockHeaderTxHashString(ctx sdk.Context, spaceId, blockHeight
 	store.Set(types.BlockHeaderTxHashStoreKey(spaceId, blockHeight), []byte(txHashStr))
 }
 
+// GetBlockHeaderHash returns the hash of the block header at the given height.
+// NOTE: history data didn't record the block header hash
+func (k Keeper) GetBlockHeaderHash(ctx sdk.Context, spaceId, blockHeight uint64) (tmbytes.HexBytes, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockHeaderHashStoreKey(spaceId, blockHeight))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+func (k Keeper) setBlockHeaderHash(ctx sdk.Context, spaceId, blockHeight uint64, hash tmbytes.HexBytes) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockHeaderHashStoreKey(spaceId, blockHeight), hash)
+}
+
+// GetStructuredHeader returns the structured header at the given height if provided
+func (k Keeper) GetStructuredHeader(ctx sdk.Context, spaceId, blockHeight uint64) (types.StructuredHeader, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StructuredHeaderStoreKey(spaceId, blockHeight))
+	if bz == nil {
+		return types.StructuredHeader{}, false
+	}
+
+	var structuredHeader types.StructuredHeader
+	k.cdc.MustUnmarshal(bz, &structuredHeader)
+	return structuredHeader, true
+}
+
+func (k Keeper) setStructuredHeader(ctx sdk.Context, spaceId, blockHeight uint64, structuredHeader types.StructuredHeader) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&structuredHeader)
+	store.Set(types.StructuredHeaderStoreKey(spaceId, blockHeight), bz)
+}
+
+func (k Keeper) deleteStructuredHeader(ctx sdk.Context, spaceId, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StructuredHeaderStoreKey(spaceId, blockHeight))
+}
+
 func (k Keeper) GetSpaceLatestHeights(ctx sdk.Context) []types.SpaceLatestHeight {
 	latestHeights := make([]types.SpaceLatestHeight, 0)
 	store := ctx.KVStore(k.storeKey)
@@ -275,14 +497,126 @@ func (k Keeper) setSpaceLatestHeight(ctx sdk.Context, spaceId, blockHeight uint6
 	store.Set(types.KeyPrefixSpaceLatestHeightStoreKey(spaceId), sdk.Uint64ToBigEndian(blockHeight))
 }
 
+// GetSpacePrunedHeights returns the pruned heights of all the spaces
+func (k Keeper) GetSpacePrunedHeights(ctx sdk.Context) []types.SpaceLatestHeight {
+	prunedHeights := make([]types.SpaceLatestHeight, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSpacePrunedHeight)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		prunedHeights = append(prunedHeights, types.SpaceLatestHeight{
+			SpaceId: sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixSpacePrunedHeight):]),
+			Height:  sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return prunedHeights
+}
+
+// GetSpacePrunedHeight returns the height under which the block headers of the space have been pruned
+func (k Keeper) GetSpacePrunedHeight(ctx sdk.Context, spaceId uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SpacePrunedHeightStoreKey(spaceId))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) setSpacePrunedHeight(ctx sdk.Context, spaceId, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SpacePrunedHeightStoreKey(spaceId), sdk.Uint64ToBigEndian(height))
+}
+
 func (k Keeper) getSpaceStore(ctx sdk.Context) prefix.Store {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, types.KeyPrefixSpace)
 }
 
 // getSpaceOfOwnerStore returns a prefix store of <0x02><owner><delimiter>
+func (k Keeper) getBlockHeaderHeightStore(ctx sdk.Context, spaceId uint64) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockHeaderHeightBySpaceStoreKey(spaceId))
+}
+
 func (k Keeper) getSpaceOfOwnerStore(ctx sdk.Context, owner sdk.AccAddress) prefix.Store {
 	store := ctx.KVStore(k.storeKey)
 	key := types.SpaceOfOwnerByOwnerStoreKey(owner)
 	return prefix.NewStore(store, key)
 }
+
+// equalHexHash returns true if the hex encoded hash equals to the given hash, false otherwise
+func equalHexHash(hexHash string, hash tmbytes.HexBytes) bool {
+	bz, err := hex.DecodeString(hexHash)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(bz, hash)
+}
+
+// payHeaderFee pays the block header fee from the sender to the configured recipient
+func (k Keeper) payHeaderFee(ctx sdk.Context, sender sdk.AccAddress) error {
+	fee := k.HeaderFee(ctx)
+	if fee.IsZero() {
+		return nil
+	}
+
+	recipient := k.HeaderFeeRecipient(ctx)
+	if len(recipient) == 0 {
+		return k.bank.SendCoinsFromAccountToModule(ctx, sender, authtypes.FeeCollectorName, fee)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+
+	return k.bank.SendCoins(ctx, sender, recipientAddr, fee)
+}
+
+// checkSpaceLimit checks that the owner does not own the maximum number of spaces
+func (k Keeper) checkSpaceLimit(ctx sdk.Context, owner sdk.AccAddress) error {
+	maxSpaces := k.MaxSpacesPerOwner(ctx)
+	if maxSpaces == 0 {
+		return nil
+	}
+
+	var count uint64
+	iterator := k.getSpaceOfOwnerStore(ctx, owner).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if count++; count >= maxSpaces {
+			return sdkerrors.Wrapf(types.ErrSpaceLimit, "(%s) cannot own more than (%d) spaces", owner, maxSpaces)
+		}
+	}
+
+	return nil
+}
+
+// checkSubmissionLimits checks the block header against the header size and submission height limits
+func (k Keeper) checkSubmissionLimits(ctx sdk.Context, spaceId, height uint64, header string) error {
+	if maxBytes := k.MaxHeaderBytes(ctx); maxBytes > 0 && uint64(len(header)) > maxBytes {
+		return sdkerrors.Wrapf(types.ErrBlockHeader, "size of the header cannot be greater than (%d) bytes", maxBytes)
+	}
+
+	if prunedHeight, found := k.GetSpacePrunedHeight(ctx, spaceId); found && height <= prunedHeight {
+		return sdkerrors.Wrapf(types.ErrBlockHeader, "height (%d) must be greater than the pruned height (%d)", height, prunedHeight)
+	}
+
+	latestHeight, exist := k.GetSpaceLatestHeight(ctx, spaceId)
+	if !exist {
+		return nil
+	}
+
+	if height <= latestHeight && !k.AllowNonMonotonic(ctx) {
+		return sdkerrors.Wrapf(types.ErrBlockHeader, "height (%d) must be greater than the latest height (%d)", height, latestHeight)
+	}
+
+	if maxGap := k.MaxFutureHeightGap(ctx); maxGap > 0 && height > latestHeight && height-latestHeight > maxGap {
+		return sdkerrors.Wrapf(
+			types.ErrBlockHeader, "height (%d) cannot be more than (%d) ahead of the latest height (%d)", height, maxGap, latestHeight,
+		)
+	}
+
+	return nil
+}
@@ -1,16 +1,29 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	paramskeeper "github.com/aadhi0612/iritamod/modules/params/keeper"
+	paramstypes "github.com/aadhi0612/iritamod/modules/params/types"
+	sidechain "github.com/aadhi0612/iritamod/modules/side-chain"
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
 )
 
 func (s *TestSuite) TestCreateSpace() {
 	spaceName := "NewSpace"
 	spaceUri := "NewSpaceUri"
-	spaceId, err := s.keeper.CreateSpace(s.ctx, spaceName, spaceUri, accAvata)
+	spaceId, err := s.keeper.CreateSpace(s.ctx, spaceName, spaceUri, 0, accAvata)
 	s.Require().NoErrorf(err, "failed to create space")
 	s.Require().Equal(spaceId, uint64(2))
 
@@ -27,18 +40,75 @@ func (s *TestSuite) TestTransferSpace() {
 	s.Require().NoErrorf(err, "failed to get space")
 	s.Require().Equal(accAvata.String(), space.Owner)
 
-	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata)
-	s.Require().NoErrorf(err, "failed to transfer space")
+	s.ctx = s.ctx.WithBlockHeight(10)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accXvata, accAvata, 20)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata, 10)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accBob, 20)
+	s.Require().NoErrorf(err, "failed to propose space transfer")
+
+	// the ownership is not transferred until accepted
+	space, err = s.keeper.GetSpace(s.ctx, avataSpaceId)
+	s.Require().NoErrorf(err, "failed to get space")
+	s.Require().Equal(accAvata.String(), space.Owner)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata, 20)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx, avataSpaceId, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.CancelSpaceTransfer(s.ctx, avataSpaceId, accBob)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.CancelSpaceTransfer(s.ctx, avataSpaceId, accAvata)
+	s.Require().NoErrorf(err, "failed to cancel space transfer")
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx, avataSpaceId, accBob)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	// the transfer expires if not accepted before the expiry height
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata, 20)
+	s.Require().NoErrorf(err, "failed to propose space transfer")
+
+	resp, err := s.keeper.SpaceTransfers(sdk.WrapSDKContext(s.ctx), &types.QuerySpaceTransfersRequest{Recipient: accXvata.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Transfers, 1)
+
+	sidechain.EndBlocker(s.ctx.WithBlockHeight(20), s.keeper)
+	_, found := s.keeper.GetSpaceTransfer(s.ctx, avataSpaceId)
+	s.Require().False(found)
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx.WithBlockHeight(21), avataSpaceId, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata, 20)
+	s.Require().NoErrorf(err, "failed to propose space transfer")
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx.WithBlockHeight(21), avataSpaceId, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceTransfer)
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx.WithBlockHeight(20), avataSpaceId, accXvata)
+	s.Require().NoErrorf(err, "failed to accept space transfer")
 
 	space, err = s.keeper.GetSpace(s.ctx, avataSpaceId)
 	s.Require().NoErrorf(err, "failed to get space")
 	s.Require().Equal(accXvata.String(), space.Owner)
+	s.Require().True(s.keeper.HasSpaceOfOwner(s.ctx, accXvata, avataSpaceId))
+	s.Require().False(s.keeper.HasSpaceOfOwner(s.ctx, accAvata, avataSpaceId))
+
+	_, found = s.keeper.GetSpaceTransfer(s.ctx, avataSpaceId)
+	s.Require().False(found)
 }
 
 func (s *TestSuite) TestCreateBlockHeader() {
 	height := uint64(1000)
 	header := "block header"
-	err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, height, header, accAvata)
+	_, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, height, header, nil, nil, accAvata)
 	s.Require().NoErrorf(err, "failed to create block header")
 
 	resHeader, err := s.keeper.GetBlockHeader(s.ctx, avataSpaceId, height)
@@ -56,3 +126,704 @@ func (s *TestSuite) TestCreateBlockHeader() {
 
 	fmt.Println(txHash)
 }
+
+func (s *TestSuite) TestCreateStructuredBlockHeader() {
+	timestamp := time.Now().UTC()
+	stateRoot := tmbytes.HexBytes(tmhash.Sum([]byte("state root"))).String()
+	txRoot := tmbytes.HexBytes(tmhash.Sum([]byte("tx root"))).String()
+
+	header1 := types.StructuredHeader{StateRoot: stateRoot, TxRoot: txRoot, Timestamp: timestamp}
+	hash1, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "", &header1, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create structured block header")
+	s.Require().Equal(header1.Hash(), hash1)
+
+	resHeader, found := s.keeper.GetStructuredHeader(s.ctx, avataSpaceId, 1)
+	s.Require().True(found)
+	s.Require().Equal(header1, resHeader)
+
+	resHash, found := s.keeper.GetBlockHeaderHash(s.ctx, avataSpaceId, 1)
+	s.Require().True(found)
+	s.Require().Equal(hash1, resHash)
+
+	// the header must link to the parent hash
+	header2 := types.StructuredHeader{ParentHash: txRoot, StateRoot: stateRoot, TxRoot: txRoot, Timestamp: timestamp}
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 2, "", &header2, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrParentHashMismatch)
+
+	header2.ParentHash = hash1.String()
+	hash2, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 2, "", &header2, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create structured block header")
+
+	// the header filling a gap must be linked by the child header
+	header4 := types.StructuredHeader{ParentHash: txRoot, StateRoot: stateRoot, TxRoot: txRoot, Timestamp: timestamp}
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 4, "", &header4, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create structured block header")
+
+	header3 := types.StructuredHeader{ParentHash: hash2.String(), StateRoot: stateRoot, TxRoot: txRoot, Timestamp: timestamp}
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 3, "", &header3, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrParentHashMismatch)
+}
+
+func (s *TestSuite) TestStructuredBlockHeaderHash() {
+	timestamp := time.Now().UTC()
+	root := tmbytes.HexBytes(tmhash.Sum([]byte("root"))).String()
+	header := types.StructuredHeader{StateRoot: root, TxRoot: root, Timestamp: timestamp}
+
+	// the opaque header carried along with the structured header is committed to by the hash
+	s.Require().Equal(header.Hash(), types.GetHeaderHash("", &header))
+	s.Require().NotEqual(header.Hash(), types.GetHeaderHash("opaque header", &header))
+	s.Require().NotEqual(types.GetHeaderHash("opaque header", &header), types.GetHeaderHash("swapped header", &header))
+
+	hash, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "opaque header", &header, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create structured block header")
+	s.Require().Equal(types.GetHeaderHash("opaque header", &header), hash)
+
+	conflictingHeader := types.ConflictingHeader{Header: "swapped header", StructuredHeader: &header}
+	s.Require().NotEqual(hash, conflictingHeader.Hash())
+}
+
+func (s *TestSuite) TestSubmitter() {
+	header := "block header"
+
+	_, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, header, nil, nil, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	submitter := types.Submitter{SpaceId: avataSpaceId, Address: accXvata.String(), MinHeight: 1, MaxHeight: 10, MaxPerBlock: 2}
+	err = s.keeper.AddSubmitter(s.ctx, submitter, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	err = s.keeper.AddSubmitter(s.ctx, submitter, accAvata)
+	s.Require().NoErrorf(err, "failed to add submitter")
+
+	resSubmitter, found := s.keeper.GetSubmitter(s.ctx, avataSpaceId, accXvata)
+	s.Require().True(found)
+	s.Require().Equal(submitter, resSubmitter)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 11, header, nil, nil, accXvata)
+	s.Require().ErrorIs(err, types.ErrSubmitterLimit)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, header, nil, nil, accXvata)
+	s.Require().NoErrorf(err, "failed to create block header by submitter")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 2, header, nil, nil, accXvata)
+	s.Require().NoErrorf(err, "failed to create block header by submitter")
+
+	// the submissions per block are limited
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 3, header, nil, nil, accXvata)
+	s.Require().ErrorIs(err, types.ErrSubmitterLimit)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), avataSpaceId, 3, header, nil, nil, accXvata)
+	s.Require().NoErrorf(err, "failed to create block header by submitter")
+
+	err = s.keeper.RemoveSubmitter(s.ctx, avataSpaceId, accXvata, accAvata)
+	s.Require().NoErrorf(err, "failed to remove submitter")
+
+	err = s.keeper.RemoveSubmitter(s.ctx, avataSpaceId, accXvata, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSubmitter)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 4, header, nil, nil, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	// the submitters are revoked on transfer
+	err = s.keeper.AddSubmitter(s.ctx, submitter, accAvata)
+	s.Require().NoErrorf(err, "failed to add submitter")
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accBob, 10)
+	s.Require().NoErrorf(err, "failed to transfer space")
+	err = s.keeper.AcceptSpaceTransfer(s.ctx, avataSpaceId, accBob)
+	s.Require().NoErrorf(err, "failed to accept space transfer")
+	s.Require().False(s.keeper.HasSubmitter(s.ctx, avataSpaceId, accXvata))
+}
+
+func (s *TestSuite) TestChallengeBlockHeader() {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address())
+
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner)
+	s.Require().NoError(acc.SetPubKey(ownerKey.PubKey()))
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+
+	challengePeriod := uint64(10)
+	spaceId, err := s.keeper.CreateSpace(s.ctx, "Challenge Space", "", challengePeriod, owner)
+	s.Require().NoErrorf(err, "failed to create space")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 1, "header 1", nil, nil, owner)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 2, "header 2", nil, nil, owner)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	finality := s.keeper.GetHeaderFinality(s.ctx, spaceId, 1)
+	s.Require().Equal(types.HeaderStatusPending, finality.Status)
+	s.Require().Equal(uint64(s.ctx.BlockHeight())+challengePeriod, finality.FinalizeHeight)
+
+	// the headers of the space without challenge period are finalized at once
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+	s.Require().Equal(types.HeaderStatusFinalized, s.keeper.GetHeaderFinality(s.ctx, avataSpaceId, 1).Status)
+
+	err = s.keeper.ChallengeBlockHeader(s.ctx, avataSpaceId, 1, nil, "fraud evidence", accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+
+	// the unverifiable evidence alone can not challenge the header
+	err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 2, nil, "fraud evidence", accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+	s.Require().Equal(types.HeaderStatusPending, s.keeper.GetHeaderFinality(s.ctx, spaceId, 2).Status)
+
+	_, found := s.keeper.GetChallenge(s.ctx, spaceId, 2)
+	s.Require().False(found)
+
+	// the conflicting header must be signed by the owner or a submitter
+	conflictingHeader := types.ConflictingHeader{Header: "conflicting header 1", Signer: owner.String()}
+	conflictingHeader.Signature = []byte("invalid signature")
+	err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 1, &conflictingHeader, "", accXvata)
+	s.Require().Error(err)
+
+	signBytes := types.HeaderSignBytes(s.ctx.ChainID(), spaceId, 1, conflictingHeader.Hash())
+	conflictingHeader.Signature, err = ownerKey.Sign(signBytes)
+	s.Require().NoError(err)
+
+	// the conflicting header must differ from the recorded one
+	sameHeader := types.ConflictingHeader{Header: "header 1", Signer: owner.String()}
+	sameHeader.Signature, err = ownerKey.Sign(types.HeaderSignBytes(s.ctx.ChainID(), spaceId, 1, sameHeader.Hash()))
+	s.Require().NoError(err)
+	err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 1, &sameHeader, "", accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+
+	err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 1, &conflictingHeader, "fraud evidence", accXvata)
+	s.Require().NoErrorf(err, "failed to challenge block header")
+	s.Require().Equal(types.HeaderStatusChallenged, s.keeper.GetHeaderFinality(s.ctx, spaceId, 1).Status)
+
+	challenge, found := s.keeper.GetChallenge(s.ctx, spaceId, 1)
+	s.Require().True(found)
+	s.Require().Equal(accXvata.String(), challenge.Challenger)
+	s.Require().Equal("fraud evidence", challenge.Evidence)
+
+	err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 1, nil, "fraud evidence", accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+
+	// the unchallenged header is finalized after the challenge period
+	sidechain.EndBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+int64(challengePeriod)-1), s.keeper)
+	s.Require().Equal(types.HeaderStatusPending, s.keeper.GetHeaderFinality(s.ctx, spaceId, 2).Status)
+
+	sidechain.EndBlocker(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+int64(challengePeriod)), s.keeper)
+	s.Require().Equal(types.HeaderStatusFinalized, s.keeper.GetHeaderFinality(s.ctx, spaceId, 2).Status)
+	s.Require().Equal(types.HeaderStatusChallenged, s.keeper.GetHeaderFinality(s.ctx, spaceId, 1).Status)
+
+	res, err := s.keeper.BlockHeader(sdk.WrapSDKContext(s.ctx), &types.QueryBlockHeaderRequest{SpaceId: spaceId, Height: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(types.HeaderStatusChallenged, res.Status)
+	s.Require().NotNil(res.Challenge)
+}
+
+func (s *TestSuite) TestResolveChallenge() {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address())
+
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner)
+	s.Require().NoError(acc.SetPubKey(ownerKey.PubKey()))
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+
+	spaceId, err := s.keeper.CreateSpace(s.ctx, "Challenge Space", "", 10, owner)
+	s.Require().NoErrorf(err, "failed to create space")
+
+	challenge := func(height uint64, header string) types.ConflictingHeader {
+		conflictingHeader := types.ConflictingHeader{Header: header, Signer: owner.String()}
+		conflictingHeader.Signature, err = ownerKey.Sign(types.HeaderSignBytes(s.ctx.ChainID(), spaceId, height, conflictingHeader.Hash()))
+		s.Require().NoError(err)
+
+		err = s.keeper.ChallengeBlockHeader(s.ctx, spaceId, height, &conflictingHeader, "", accXvata)
+		s.Require().NoErrorf(err, "failed to challenge block header")
+
+		return conflictingHeader
+	}
+
+	for _, height := range []uint64{1, 2, 3} {
+		_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, height, fmt.Sprintf("header %d", height), nil, nil, owner)
+		s.Require().NoErrorf(err, "failed to create block header")
+	}
+
+	challenge(1, "conflicting header 1")
+	conflictingHeader := challenge(2, "conflicting header 2")
+
+	// only the challenged headers can be resolved
+	_, err = s.keeper.ResolveChallenge(s.ctx, spaceId, 3, true)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+
+	// the rejected challenge keeps the challenged header
+	hash, err := s.keeper.ResolveChallenge(s.ctx, spaceId, 1, false)
+	s.Require().NoErrorf(err, "failed to resolve challenge")
+	s.Require().Equal(types.GetHeaderHash("header 1", nil), hash)
+	s.Require().Equal(types.HeaderStatusFinalized, s.keeper.GetHeaderFinality(s.ctx, spaceId, 1).Status)
+
+	header, err := s.keeper.GetBlockHeader(s.ctx, spaceId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal("header 1", header)
+
+	_, found := s.keeper.GetChallenge(s.ctx, spaceId, 1)
+	s.Require().False(found)
+
+	_, err = s.keeper.ResolveChallenge(s.ctx, spaceId, 1, true)
+	s.Require().ErrorIs(err, types.ErrInvalidChallenge)
+
+	// the upheld challenge replaces the challenged header with the conflicting header
+	hash, err = s.keeper.ResolveChallenge(s.ctx, spaceId, 2, true)
+	s.Require().NoErrorf(err, "failed to resolve challenge")
+	s.Require().Equal(conflictingHeader.Hash(), hash)
+	s.Require().Equal(types.HeaderStatusFinalized, s.keeper.GetHeaderFinality(s.ctx, spaceId, 2).Status)
+
+	header, err = s.keeper.GetBlockHeader(s.ctx, spaceId, 2)
+	s.Require().NoError(err)
+	s.Require().Equal("conflicting header 2", header)
+
+	recordedHash, found := s.keeper.GetBlockHeaderHash(s.ctx, spaceId, 2)
+	s.Require().True(found)
+	s.Require().Equal(hash, recordedHash)
+
+	_, found = s.keeper.GetChallenge(s.ctx, spaceId, 2)
+	s.Require().False(found)
+
+	// the space keeps making progress
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 4, "header 4", nil, nil, owner)
+	s.Require().NoErrorf(err, "failed to create block header")
+}
+
+func (s *TestSuite) TestCreateBlockHeaders() {
+	headers := []types.BatchBlockHeader{{Header: "header 1"}, {Header: "header 2"}, {Header: "header 3"}}
+	hashes, err := s.keeper.CreateBlockHeaders(s.ctx, avataSpaceId, 1, headers, accAvata)
+	s.Require().NoErrorf(err, "failed to create block headers")
+	s.Require().Len(hashes, 3)
+
+	// the batch is created atomically
+	headers = []types.BatchBlockHeader{{Header: "header 5"}, {Header: "header 6"}, {Header: "header 7"}}
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 7, "header 7", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	_, err = s.keeper.CreateBlockHeaders(s.ctx, avataSpaceId, 5, headers, accAvata)
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+	s.Require().False(s.keeper.HasBlockHeader(s.ctx, avataSpaceId, 5))
+
+	_, err = s.keeper.CreateBlockHeaders(s.ctx, avataSpaceId, 10, headers, accAvata)
+	s.Require().NoErrorf(err, "failed to create block headers")
+
+	firstHeight, gaps := s.keeper.GetHeaderGaps(s.ctx, avataSpaceId)
+	s.Require().Equal(uint64(1), firstHeight)
+	s.Require().Equal([]types.HeightRange{{Start: 4, End: 6}, {Start: 8, End: 9}}, gaps)
+
+	// the headers are ordered by height numerically
+	res, err := s.keeper.BlockHeaders(sdk.WrapSDKContext(s.ctx), &types.QueryBlockHeadersRequest{
+		SpaceId:     avataSpaceId,
+		StartHeight: 3,
+		EndHeight:   11,
+		Pagination:  &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.BlockHeaders, 2)
+	s.Require().Equal(uint64(3), res.BlockHeaders[0].Height)
+	s.Require().Equal(uint64(7), res.BlockHeaders[1].Height)
+
+	res, err = s.keeper.BlockHeaders(sdk.WrapSDKContext(s.ctx), &types.QueryBlockHeadersRequest{
+		SpaceId:     avataSpaceId,
+		StartHeight: 3,
+		EndHeight:   11,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.BlockHeaders, 2)
+	s.Require().Equal(uint64(10), res.BlockHeaders[0].Height)
+	s.Require().Equal(uint64(11), res.BlockHeaders[1].Height)
+	s.Require().Equal("header 6", res.BlockHeaders[1].Header)
+}
+
+func (s *TestSuite) TestSpaceLifecycle() {
+	err := s.keeper.UpdateSpace(s.ctx, avataSpaceId, "Updated Space", types.DoNotModify, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	err = s.keeper.UpdateSpace(s.ctx, avataSpaceId, "Updated Space", types.DoNotModify, accAvata)
+	s.Require().NoErrorf(err, "failed to update space")
+
+	space, err := s.keeper.GetSpace(s.ctx, avataSpaceId)
+	s.Require().NoError(err)
+	s.Require().Equal("Updated Space", space.Name)
+	s.Require().Equal(avataSpaceUri, space.Uri)
+
+	// the frozen space rejects the block headers
+	err = s.keeper.FreezeSpace(s.ctx, avataSpaceId, accAvata)
+	s.Require().NoErrorf(err, "failed to freeze space")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	err = s.keeper.FreezeSpace(s.ctx, avataSpaceId, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	err = s.keeper.UnfreezeSpace(s.ctx, avataSpaceId, accAvata)
+	s.Require().NoErrorf(err, "failed to unfreeze space")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	// the archived space is read only
+	err = s.keeper.AddSubmitter(s.ctx, types.Submitter{SpaceId: avataSpaceId, Address: accXvata.String()}, accAvata)
+	s.Require().NoErrorf(err, "failed to add submitter")
+
+	err = s.keeper.ArchiveSpace(s.ctx, avataSpaceId, accAvata)
+	s.Require().NoErrorf(err, "failed to archive space")
+	s.Require().False(s.keeper.HasSubmitter(s.ctx, avataSpaceId, accXvata))
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 2, "header 2", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	err = s.keeper.UnfreezeSpace(s.ctx, avataSpaceId, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	err = s.keeper.UpdateSpace(s.ctx, avataSpaceId, types.DoNotModify, "", accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	err = s.keeper.TransferSpace(s.ctx, avataSpaceId, accAvata, accXvata, 10)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceStatus)
+
+	header, err := s.keeper.GetBlockHeader(s.ctx, avataSpaceId, 1)
+	s.Require().NoError(err)
+	s.Require().Equal("header 1", header)
+}
+
+func (s *TestSuite) TestPruneBlockHeaders() {
+	headers := make([]types.BatchBlockHeader, 10)
+	for i := range headers {
+		headers[i] = types.BatchBlockHeader{Header: fmt.Sprintf("header %d", i+1)}
+	}
+	_, err := s.keeper.CreateBlockHeaders(s.ctx, avataSpaceId, 1, headers, accAvata)
+	s.Require().NoErrorf(err, "failed to create block headers")
+
+	// pruning is disabled by default
+	_, err = s.keeper.PruneBlockHeaders(s.ctx, avataSpaceId, 5, accAvata)
+	s.Require().ErrorIs(err, types.ErrPruneNotAllowed)
+
+	s.keeper.SetParams(s.ctx, types.NewParams(
+		4,
+		types.DefaultSpaceDeposit,
+		types.DefaultHeaderFee,
+		types.DefaultHeaderFeeRecipient,
+		types.DefaultMaxHeaderBytes,
+		types.DefaultMaxSpacesPerOwner,
+		types.DefaultMaxFutureHeightGap,
+		types.DefaultAllowNonMonotonic,
+	))
+
+	_, err = s.keeper.PruneBlockHeaders(s.ctx, avataSpaceId, 8, accAvata)
+	s.Require().ErrorIs(err, types.ErrPruneNotAllowed)
+
+	_, err = s.keeper.PruneBlockHeaders(s.ctx, avataSpaceId, 5, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	pruned, err := s.keeper.PruneBlockHeaders(s.ctx, avataSpaceId, 7, accAvata)
+	s.Require().NoErrorf(err, "failed to prune block headers")
+	s.Require().Equal(uint64(6), pruned)
+
+	s.Require().False(s.keeper.HasBlockHeader(s.ctx, avataSpaceId, 6))
+	_, found := s.keeper.GetBlockHeaderHash(s.ctx, avataSpaceId, 6)
+	s.Require().False(found)
+	s.Require().True(s.keeper.HasBlockHeader(s.ctx, avataSpaceId, 7))
+
+	firstHeight, gaps := s.keeper.GetHeaderGaps(s.ctx, avataSpaceId)
+	s.Require().Equal(uint64(7), firstHeight)
+	s.Require().Empty(gaps)
+
+	// the pruned heights can not be resubmitted even if non-monotonic submission is allowed
+	prunedHeight, found := s.keeper.GetSpacePrunedHeight(s.ctx, avataSpaceId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(6), prunedHeight)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 6, "rewritten header 6", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+	s.Require().False(s.keeper.HasBlockHeader(s.ctx, avataSpaceId, 6))
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 11, "header 11", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	// pruning below the pruned height keeps the watermark
+	_, err = s.keeper.PruneBlockHeaders(s.ctx, avataSpaceId, 3, accAvata)
+	s.Require().NoErrorf(err, "failed to prune block headers")
+
+	prunedHeight, _ = s.keeper.GetSpacePrunedHeight(s.ctx, avataSpaceId)
+	s.Require().Equal(uint64(6), prunedHeight)
+	s.Require().Contains(s.keeper.ExportGenesis(s.ctx).SpacePrunedHeights, types.SpaceLatestHeight{SpaceId: avataSpaceId, Height: 6})
+}
+
+func (s *TestSuite) TestValidatorSet() {
+	privKeys := make([]*ed25519.PrivKey, 4)
+	validators := make([]types.Validator, 4)
+	for i := range privKeys {
+		privKeys[i] = ed25519.GenPrivKey()
+		validator, err := types.NewValidator(privKeys[i].PubKey(), 10)
+		s.Require().NoError(err)
+		validators[i] = validator
+	}
+
+	sign := func(height uint64, header string, nextValidators []types.Validator, signers ...int) *types.HeaderCommit {
+		signBytes := types.ValidatorSignBytes(s.ctx.ChainID(), avataSpaceId, height, types.GetHeaderHash(header, nil), nextValidators)
+
+		commit := &types.HeaderCommit{NextValidators: nextValidators}
+		for _, i := range signers {
+			sig, err := privKeys[i].Sign(signBytes)
+			s.Require().NoError(err)
+			commit.Signatures = append(commit.Signatures, types.ValidatorSignature{PubKey: validators[i].PubKey, Signature: sig})
+		}
+		return commit
+	}
+
+	// the commit is rejected without the validator set registered
+	_, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, sign(1, "header 1", nil, 0), accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidValidatorSet)
+
+	err = s.keeper.RegisterValidatorSet(s.ctx, avataSpaceId, validators, accXvata)
+	s.Require().ErrorIs(err, types.ErrInvalidSpaceOwner)
+
+	err = s.keeper.RegisterValidatorSet(s.ctx, avataSpaceId, validators, accAvata)
+	s.Require().NoErrorf(err, "failed to register validator set")
+
+	err = s.keeper.RegisterValidatorSet(s.ctx, avataSpaceId, validators, accAvata)
+	s.Require().ErrorIs(err, types.ErrInvalidValidatorSet)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrInsufficientSignatures)
+
+	// 2/3 of the power is not enough
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, sign(1, "header 1", nil, 0, 1), accAvata)
+	s.Require().ErrorIs(err, types.ErrInsufficientSignatures)
+
+	// the signatures must be on the header
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, sign(1, "header 2", nil, 0, 1, 2), accAvata)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the signatures are bound to the chain id
+	_, err = s.keeper.CreateBlockHeader(s.ctx.WithChainID("other-chain"), avataSpaceId, 1, "header 1", nil, sign(1, "header 1", nil, 0, 1, 2), accAvata)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "header 1", nil, sign(1, "header 1", nil, 0, 1, 2), accAvata)
+	s.Require().NoErrorf(err, "failed to create signed block header")
+
+	// the validator set is updated by the header
+	nextValidators := validators[1:]
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 2, "header 2", nil, sign(2, "header 2", nextValidators, 1, 2, 3), accAvata)
+	s.Require().NoErrorf(err, "failed to create validator set change header")
+
+	valSet, found := s.keeper.GetValidatorSet(s.ctx, avataSpaceId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(2), valSet.Height)
+	s.Require().Equal(nextValidators, valSet.Validators)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 3, "header 3", nil, sign(3, "header 3", nil, 0, 1, 2), accAvata)
+	s.Require().ErrorIs(err, types.ErrInsufficientSignatures)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 3, "header 3", nil, sign(3, "header 3", nil, 1, 2, 3), accAvata)
+	s.Require().NoErrorf(err, "failed to create signed block header")
+}
+
+func (s *TestSuite) TestSpaceDeposit() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	headerFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	params := s.keeper.GetParams(s.ctx)
+	params.SpaceDeposit = deposit
+	params.HeaderFee = headerFee
+	params.HeaderFeeRecipient = accBob.String()
+	s.keeper.SetParams(s.ctx, params)
+
+	// the sender has no balance for the deposit
+	_, err := s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accAvata)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.app.BankKeeper.InitGenesis(s.ctx, &banktypes.GenesisState{
+		Params: s.app.BankKeeper.GetParams(s.ctx),
+		Balances: []banktypes.Balance{
+			{Address: accAvata.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 115))},
+		},
+	})
+
+	spaceId, err := s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accAvata)
+	s.Require().NoErrorf(err, "failed to create space with deposit")
+
+	space, err := s.keeper.GetSpace(s.ctx, spaceId)
+	s.Require().NoError(err)
+	s.Require().Equal(deposit, space.Deposit)
+
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(deposit, s.app.BankKeeper.GetAllBalances(s.ctx, moduleAddr))
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 1, "header 1", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header with fee")
+	s.Require().Equal(headerFee, s.app.BankKeeper.GetAllBalances(s.ctx, accBob))
+
+	// the balance of 5 is insufficient for the header fee
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 2, "header 2", nil, nil, accAvata)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	err = s.keeper.ArchiveSpace(s.ctx, spaceId, accAvata)
+	s.Require().NoErrorf(err, "failed to archive space")
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, moduleAddr).IsZero())
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 105), s.app.BankKeeper.GetBalance(s.ctx, accAvata, sdk.DefaultBondDenom))
+
+	space, err = s.keeper.GetSpace(s.ctx, spaceId)
+	s.Require().NoError(err)
+	s.Require().True(space.Deposit.IsZero())
+}
+
+func (s *TestSuite) TestVerifyInclusion() {
+	leaves := [][]byte{[]byte("tx 1"), []byte("tx 2"), []byte("tx 3"), []byte("tx 4"), []byte("tx 5")}
+
+	for _, algorithm := range []types.HashAlgorithm{types.HashAlgorithmSHA256, types.HashAlgorithmSM3} {
+		s.SetupTest()
+
+		txRoot, proofs := types.ProofsFromLeaves(algorithm, leaves)
+		stateRoot, stateProofs := types.ProofsFromLeaves(algorithm, leaves[:1])
+
+		header := types.StructuredHeader{
+			StateRoot: hex.EncodeToString(stateRoot),
+			TxRoot:    hex.EncodeToString(txRoot),
+			Timestamp: time.Now().UTC(),
+		}
+		_, err := s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 1, "", &header, nil, accAvata)
+		s.Require().NoErrorf(err, "failed to create structured block header")
+
+		for i, proof := range proofs {
+			err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 1, types.RootTypeTx, leaves[i], proof)
+			s.Require().NoErrorf(err, "failed to verify leaf (%d) with %s", i, algorithm)
+		}
+
+		err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 1, types.RootTypeState, leaves[0], stateProofs[0])
+		s.Require().NoErrorf(err, "failed to verify state leaf with %s", algorithm)
+
+		// the leaf is not included in the state root
+		err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 1, types.RootTypeState, leaves[1], stateProofs[0])
+		s.Require().ErrorIs(err, types.ErrInvalidInclusionProof)
+
+		// the proof is of another leaf
+		err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 1, types.RootTypeTx, leaves[0], proofs[1])
+		s.Require().ErrorIs(err, types.ErrInvalidInclusionProof)
+
+		// the aunts mismatch the tree
+		invalidProof := proofs[0]
+		invalidProof.Aunts = invalidProof.Aunts[1:]
+		err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 1, types.RootTypeTx, leaves[0], invalidProof)
+		s.Require().ErrorIs(err, types.ErrInvalidInclusionProof)
+
+		err = s.keeper.VerifyInclusionProof(s.ctx, avataSpaceId, 2, types.RootTypeTx, leaves[0], proofs[0])
+		s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+		resp, err := s.keeper.VerifyInclusion(sdk.WrapSDKContext(s.ctx), &types.QueryVerifyInclusionRequest{
+			SpaceId:  avataSpaceId,
+			Height:   1,
+			RootType: types.RootTypeTx,
+			Leaf:     leaves[4],
+			Proof:    proofs[4],
+		})
+		s.Require().NoError(err)
+		s.Require().True(resp.Verified)
+		s.Require().Equal(types.HeaderStatusFinalized, resp.Status)
+	}
+}
+
+func (s *TestSuite) TestVerifyInclusionFinality() {
+	ownerKey := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerKey.PubKey().Address())
+
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner)
+	s.Require().NoError(acc.SetPubKey(ownerKey.PubKey()))
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+
+	spaceId, err := s.keeper.CreateSpace(s.ctx, "Challenge Space", "", 10, owner)
+	s.Require().NoErrorf(err, "failed to create space")
+
+	leaves := [][]byte{[]byte("tx 1"), []byte("tx 2")}
+	txRoot, proofs := types.ProofsFromLeaves(types.HashAlgorithmSHA256, leaves)
+
+	header := types.StructuredHeader{
+		StateRoot: hex.EncodeToString(txRoot),
+		TxRoot:    hex.EncodeToString(txRoot),
+		Timestamp: time.Now().UTC(),
+	}
+	_, err = s.keeper.CreateBlockHeader(s.ctx, spaceId, 1, "", &header, nil, owner)
+	s.Require().NoErrorf(err, "failed to create structured block header")
+
+	// the proof against the pending header is not final
+	err = s.keeper.VerifyInclusionProof(s.ctx, spaceId, 1, types.RootTypeTx, leaves[0], proofs[0])
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+	conflictingHeader := types.ConflictingHeader{Header: "conflicting header", Signer: owner.String()}
+	conflictingHeader.Signature, err = ownerKey.Sign(types.HeaderSignBytes(s.ctx.ChainID(), spaceId, 1, conflictingHeader.Hash()))
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.ChallengeBlockHeader(s.ctx, spaceId, 1, &conflictingHeader, "", accXvata))
+
+	// nor the proof against the challenged header
+	err = s.keeper.VerifyInclusionProof(s.ctx, spaceId, 1, types.RootTypeTx, leaves[0], proofs[0])
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+	resp, err := s.keeper.VerifyInclusion(sdk.WrapSDKContext(s.ctx), &types.QueryVerifyInclusionRequest{
+		SpaceId:  spaceId,
+		Height:   1,
+		RootType: types.RootTypeTx,
+		Leaf:     leaves[0],
+		Proof:    proofs[0],
+	})
+	s.Require().NoError(err)
+	s.Require().True(resp.Verified)
+	s.Require().Equal(types.HeaderStatusChallenged, resp.Status)
+
+	// the proof against the header kept by the resolution is final
+	_, err = s.keeper.ResolveChallenge(s.ctx, spaceId, 1, false)
+	s.Require().NoErrorf(err, "failed to resolve challenge")
+
+	err = s.keeper.VerifyInclusionProof(s.ctx, spaceId, 1, types.RootTypeTx, leaves[0], proofs[0])
+	s.Require().NoErrorf(err, "failed to verify leaf")
+}
+
+func (s *TestSuite) TestSubmissionLimits() {
+	paramsKeeper := paramskeeper.NewKeeper(s.app.ParamsKeeper)
+	_, err := paramsKeeper.UpdateParams(s.ctx, []paramstypes.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyMaxHeaderBytes), Value: `"8"`},
+		{Subspace: types.ModuleName, Key: string(types.KeyMaxSpacesPerOwner), Value: `"2"`},
+		{Subspace: types.ModuleName, Key: string(types.KeyMaxFutureHeightGap), Value: `"10"`},
+		{Subspace: types.ModuleName, Key: string(types.KeyAllowNonMonotonic), Value: `false`},
+	})
+	s.Require().NoErrorf(err, "failed to update params")
+
+	params := s.keeper.GetParams(s.ctx)
+	s.Require().Equal(uint64(8), params.MaxHeaderBytes)
+	s.Require().Equal(uint64(2), params.MaxSpacesPerOwner)
+	s.Require().Equal(uint64(10), params.MaxFutureHeightGap)
+	s.Require().False(params.AllowNonMonotonic)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 5, "header 5 is too large", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+	// the first header is not limited by the height gap
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 5, "header 5", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 4, "header 4", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 16, "header16", nil, nil, accAvata)
+	s.Require().ErrorIs(err, types.ErrBlockHeader)
+
+	_, err = s.keeper.CreateBlockHeader(s.ctx, avataSpaceId, 15, "header15", nil, nil, accAvata)
+	s.Require().NoErrorf(err, "failed to create block header")
+
+	_, err = s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accAvata)
+	s.Require().NoErrorf(err, "failed to create space")
+
+	_, err = s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accAvata)
+	s.Require().ErrorIs(err, types.ErrSpaceLimit)
+
+	// the recipient owning the maximum spaces cannot accept a transfer
+	spaceId, err := s.keeper.CreateSpace(s.ctx, avataSpaceName, avataSpaceUri, 0, accXvata)
+	s.Require().NoErrorf(err, "failed to create space")
+
+	err = s.keeper.TransferSpace(s.ctx, spaceId, accXvata, accAvata, 10)
+	s.Require().NoErrorf(err, "failed to propose space transfer")
+
+	err = s.keeper.AcceptSpaceTransfer(s.ctx, spaceId, accAvata)
+	s.Require().ErrorIs(err, types.ErrSpaceLimit)
+}
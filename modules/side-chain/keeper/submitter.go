@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// AddSubmitter authorizes the submitter to submit block headers of the space on behalf of the owner.
+// The limits of an existing submitter are overwritten
+func (k Keeper) AddSubmitter(ctx sdk.Context, submitter types.Submitter, sender sdk.AccAddress) error {
+	space, err := k.getOwnedSpace(ctx, submitter.SpaceId, sender)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is archived", submitter.SpaceId)
+	}
+
+	if err := types.ValidateHeightRange(submitter.MinHeight, submitter.MaxHeight); err != nil {
+		return err
+	}
+
+	k.setSubmitter(ctx, submitter)
+	return nil
+}
+
+// RemoveSubmitter revokes the submitter of the space
+func (k Keeper) RemoveSubmitter(ctx sdk.Context, spaceId uint64, submitter, sender sdk.AccAddress) error {
+	if !k.HasSpaceOfOwner(ctx, sender, spaceId) {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceOwner, "space (%d) is not owned by (%s)", spaceId, sender.String())
+	}
+
+	if !k.HasSubmitter(ctx, spaceId, submitter) {
+		return sdkerrors.Wrapf(types.ErrInvalidSubmitter, "(%s) is not a submitter of space (%d)", submitter.String(), spaceId)
+	}
+
+	k.deleteSubmitter(ctx, spaceId, submitter)
+	return nil
+}
+
+// authorizeSubmission checks if the sender is allowed to submit the block header at the given height,
+// which is either the space owner or a submitter within its limits
+func (k Keeper) authorizeSubmission(ctx sdk.Context, spaceId, height uint64, sender sdk.AccAddress) error {
+	if k.HasSpaceOfOwner(ctx, sender, spaceId) {
+		return nil
+	}
+
+	submitter, found := k.GetSubmitter(ctx, spaceId, sender)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceOwner, "space (%d) is not owned by (%s) nor is it a submitter", spaceId, sender.String())
+	}
+
+	if submitter.MinHeight != 0 && height < submitter.MinHeight {
+		return sdkerrors.Wrapf(types.ErrSubmitterLimit, "height (%d) is lower than the min height (%d) of the submitter", height, submitter.MinHeight)
+	}
+
+	if submitter.MaxHeight != 0 && height > submitter.MaxHeight {
+		return sdkerrors.Wrapf(types.ErrSubmitterLimit, "height (%d) is higher than the max height (%d) of the submitter", height, submitter.MaxHeight)
+	}
+
+	if submitter.MaxPerBlock != 0 {
+		blockHeight, count := k.getSubmitterUsage(ctx, spaceId, sender)
+		if blockHeight != ctx.BlockHeight() {
+			count = 0
+		}
+
+		if count >= submitter.MaxPerBlock {
+			return sdkerrors.Wrapf(types.ErrSubmitterLimit, "submitter cannot submit more than (%d) headers in a block", submitter.MaxPerBlock)
+		}
+
+		k.setSubmitterUsage(ctx, spaceId, sender, ctx.BlockHeight(), count+1)
+	}
+
+	return nil
+}
+
+func (k Keeper) GetSubmitter(ctx sdk.Context, spaceId uint64, submitter sdk.AccAddress) (types.Submitter, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SubmitterStoreKey(spaceId, submitter))
+	if bz == nil {
+		return types.Submitter{}, false
+	}
+
+	var s types.Submitter
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+func (k Keeper) HasSubmitter(ctx sdk.Context, spaceId uint64, submitter sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.SubmitterStoreKey(spaceId, submitter))
+}
+
+func (k Keeper) GetSubmitters(ctx sdk.Context) []types.Submitter {
+	submitters := make([]types.Submitter, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSubmitter)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var submitter types.Submitter
+		k.cdc.MustUnmarshal(iterator.Value(), &submitter)
+		submitters = append(submitters, submitter)
+	}
+	return submitters
+}
+
+func (k Keeper) setSubmitter(ctx sdk.Context, submitter types.Submitter) {
+	store := ctx.KVStore(k.storeKey)
+	addr, _ := sdk.AccAddressFromBech32(submitter.Address)
+	bz := k.cdc.MustMarshal(&submitter)
+	store.Set(types.SubmitterStoreKey(submitter.SpaceId, addr), bz)
+}
+
+func (k Keeper) deleteSubmitter(ctx sdk.Context, spaceId uint64, submitter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubmitterStoreKey(spaceId, submitter))
+	store.Delete(types.SubmitterUsageStoreKey(spaceId, submitter))
+}
+
+// deleteSubmitters revokes all submitters of the space
+func (k Keeper) deleteSubmitters(ctx sdk.Context, spaceId uint64) {
+	submitterStore := k.getSubmitterStore(ctx, spaceId)
+	iterator := submitterStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var submitters []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		var submitter types.Submitter
+		k.cdc.MustUnmarshal(iterator.Value(), &submitter)
+		addr, _ := sdk.AccAddressFromBech32(submitter.Address)
+		submitters = append(submitters, addr)
+	}
+
+	for _, submitter := range submitters {
+		k.deleteSubmitter(ctx, spaceId, submitter)
+	}
+}
+
+// getSubmitterUsage returns the block height of the latest submission and the number of headers
+// submitted in that block by the submitter
+func (k Keeper) getSubmitterUsage(ctx sdk.Context, spaceId uint64, submitter sdk.AccAddress) (int64, uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SubmitterUsageStoreKey(spaceId, submitter))
+	if len(bz) != 16 {
+		return 0, 0
+	}
+	return int64(binary.BigEndian.Uint64(bz[:8])), binary.BigEndian.Uint64(bz[8:])
+}
+
+func (k Keeper) setSubmitterUsage(ctx sdk.Context, spaceId uint64, submitter sdk.AccAddress, blockHeight int64, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], uint64(blockHeight))
+	binary.BigEndian.PutUint64(bz[8:], count)
+	store.Set(types.SubmitterUsageStoreKey(spaceId, submitter), bz)
+}
+
+// getSubmitterStore returns a prefix store of <0x09><space_id><delimiter>
+func (k Keeper) getSubmitterStore(ctx sdk.Context, spaceId uint64) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.SubmitterBySpaceStoreKey(spaceId))
+}
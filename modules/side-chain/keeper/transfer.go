@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// TransferSpace proposes to transfer the space ownership to the recipient, which takes effect
+// when accepted by the recipient no later than the expiry height
+func (k Keeper) TransferSpace(ctx sdk.Context, spaceId uint64, from, to sdk.AccAddress, expiryHeight uint64) error {
+	space, err := k.getOwnedSpace(ctx, spaceId, from)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is archived", spaceId)
+	}
+
+	if expiryHeight <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "expiry height (%d) must be greater than the current height (%d)", expiryHeight, ctx.BlockHeight())
+	}
+
+	if _, found := k.GetSpaceTransfer(ctx, spaceId); found {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "space (%d) has a pending transfer, which must be cancelled first", spaceId)
+	}
+
+	k.setSpaceTransfer(ctx, types.SpaceTransfer{
+		SpaceId:      spaceId,
+		Sender:       from.String(),
+		Recipient:    to.String(),
+		ExpiryHeight: expiryHeight,
+	})
+
+	return nil
+}
+
+// AcceptSpaceTransfer accepts the pending transfer of the space by the recipient, which becomes the space owner.
+// The submitters authorized by the previous owner are revoked
+func (k Keeper) AcceptSpaceTransfer(ctx sdk.Context, spaceId uint64, recipient sdk.AccAddress) error {
+	transfer, found := k.GetSpaceTransfer(ctx, spaceId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "space (%d) has no pending transfer", spaceId)
+	}
+
+	if transfer.Recipient != recipient.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "transfer of space (%d) is not to (%s)", spaceId, recipient)
+	}
+
+	if uint64(ctx.BlockHeight()) > transfer.ExpiryHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "transfer of space (%d) expired at height (%d)", spaceId, transfer.ExpiryHeight)
+	}
+
+	from, err := sdk.AccAddressFromBech32(transfer.Sender)
+	if err != nil {
+		return err
+	}
+
+	space, err := k.getOwnedSpace(ctx, spaceId, from)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is archived", spaceId)
+	}
+
+	if err := k.checkSpaceLimit(ctx, recipient); err != nil {
+		return err
+	}
+
+	space.Owner = recipient.String()
+
+	k.setSpace(ctx, spaceId, space)
+	k.deleteSpaceOfOwner(ctx, spaceId, from)
+	k.setSpaceOfOwner(ctx, spaceId, recipient)
+	k.deleteSpaceTransfer(ctx, transfer)
+
+	// the submitters authorized by the previous owner are revoked
+	k.deleteSubmitters(ctx, spaceId)
+
+	return nil
+}
+
+// CancelSpaceTransfer cancels the pending transfer of the space by the sender
+func (k Keeper) CancelSpaceTransfer(ctx sdk.Context, spaceId uint64, sender sdk.AccAddress) error {
+	transfer, found := k.GetSpaceTransfer(ctx, spaceId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "space (%d) has no pending transfer", spaceId)
+	}
+
+	if transfer.Sender != sender.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceTransfer, "transfer of space (%d) is not from (%s)", spaceId, sender)
+	}
+
+	k.deleteSpaceTransfer(ctx, transfer)
+	return nil
+}
+
+// ExpireSpaceTransfer removes the expired space transfer
+func (k Keeper) ExpireSpaceTransfer(ctx sdk.Context, transfer types.SpaceTransfer) {
+	k.deleteSpaceTransfer(ctx, transfer)
+}
+
+// IterateExpiredSpaceTransfers iterates through the pending space transfers whose expiry height
+// is less than or equal to the given height
+func (k Keeper) IterateExpiredSpaceTransfers(
+	ctx sdk.Context,
+	height uint64,
+	op func(transfer types.SpaceTransfer) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixSpaceTransferExpiry, types.SpaceTransferByExpiryHeightStoreKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, spaceId := types.SplitSpaceTransferExpiryStoreKey(iterator.Key())
+
+		transfer, found := k.GetSpaceTransfer(ctx, spaceId)
+		if !found {
+			continue
+		}
+
+		if stop := op(transfer); stop {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetSpaceTransfer(ctx sdk.Context, spaceId uint64) (types.SpaceTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SpaceTransferStoreKey(spaceId))
+	if bz == nil {
+		return types.SpaceTransfer{}, false
+	}
+
+	var transfer types.SpaceTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+func (k Keeper) GetSpaceTransfers(ctx sdk.Context) []types.SpaceTransfer {
+	transfers := make([]types.SpaceTransfer, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSpaceTransfer)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.SpaceTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func (k Keeper) setSpaceTransfer(ctx sdk.Context, transfer types.SpaceTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&transfer)
+	store.Set(types.SpaceTransferStoreKey(transfer.SpaceId), bz)
+	store.Set(types.SpaceTransferExpiryStoreKey(transfer.ExpiryHeight, transfer.SpaceId), types.Placeholder)
+}
+
+func (k Keeper) deleteSpaceTransfer(ctx sdk.Context, transfer types.SpaceTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SpaceTransferStoreKey(transfer.SpaceId))
+	store.Delete(types.SpaceTransferExpiryStoreKey(transfer.ExpiryHeight, transfer.SpaceId))
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// RegisterValidatorSet registers the initial validator set of the side chain in the space.
+// Once registered, the block headers must be signed by more than 2/3 of the validator power,
+// and the validator set can only be updated by the signed block headers
+func (k Keeper) RegisterValidatorSet(ctx sdk.Context, spaceId uint64, validators []types.Validator, sender sdk.AccAddress) error {
+	space, err := k.getOwnedSpace(ctx, spaceId, sender)
+	if err != nil {
+		return err
+	}
+
+	if space.Status == types.SpaceStatusArchived {
+		return sdkerrors.Wrapf(types.ErrInvalidSpaceStatus, "space (%d) is archived", spaceId)
+	}
+
+	if k.HasValidatorSet(ctx, spaceId) {
+		return sdkerrors.Wrapf(types.ErrInvalidValidatorSet, "validator set of space (%d) is already registered", spaceId)
+	}
+
+	if err := types.ValidateValidators(validators); err != nil {
+		return err
+	}
+
+	k.setValidatorSet(ctx, types.ValidatorSet{
+		SpaceId:    spaceId,
+		Validators: validators,
+	})
+
+	return nil
+}
+
+// verifyHeaderCommit verifies that the block header is signed by more than 2/3 of the validator power
+// of the space, if the validator set is registered
+func (k Keeper) verifyHeaderCommit(
+	ctx sdk.Context,
+	spaceId, height uint64,
+	hash tmbytes.HexBytes,
+	commit *types.HeaderCommit,
+) error {
+	valSet, found := k.GetValidatorSet(ctx, spaceId)
+	if !found {
+		if commit != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidValidatorSet, "validator set of space (%d) is not registered", spaceId)
+		}
+		return nil
+	}
+
+	if commit == nil {
+		return sdkerrors.Wrapf(types.ErrInsufficientSignatures, "block header of space (%d) must be signed by the validators", spaceId)
+	}
+
+	var totalPower int64
+	powers := make(map[string]int64, len(valSet.Validators))
+	for _, v := range valSet.Validators {
+		powers[v.PubKey] = v.Power
+		totalPower += v.Power
+	}
+
+	signBytes := types.ValidatorSignBytes(ctx.ChainID(), spaceId, height, hash, commit.NextValidators)
+
+	var signedPower int64
+	seen := make(map[string]bool, len(commit.Signatures))
+	for _, sig := range commit.Signatures {
+		power, ok := powers[sig.PubKey]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInsufficientSignatures, "(%s) is not a validator of space (%d)", sig.PubKey, spaceId)
+		}
+
+		if seen[sig.PubKey] {
+			return sdkerrors.Wrapf(types.ErrInsufficientSignatures, "duplicate signature of validator (%s)", sig.PubKey)
+		}
+		seen[sig.PubKey] = true
+
+		pubKey, err := types.Validator{PubKey: sig.PubKey}.ConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		if !pubKey.VerifySignature(signBytes, sig.Signature) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid signature of validator (%s)", sig.PubKey)
+		}
+
+		signedPower += power
+	}
+
+	if signedPower*3 <= totalPower*2 {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientSignatures,
+			"signed power (%d) must be more than 2/3 of the total power (%d)", signedPower, totalPower,
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) GetValidatorSet(ctx sdk.Context, spaceId uint64) (types.ValidatorSet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorSetStoreKey(spaceId))
+	if bz == nil {
+		return types.ValidatorSet{}, false
+	}
+
+	var valSet types.ValidatorSet
+	k.cdc.MustUnmarshal(bz, &valSet)
+	return valSet, true
+}
+
+func (k Keeper) HasValidatorSet(ctx sdk.Context, spaceId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ValidatorSetStoreKey(spaceId))
+}
+
+func (k Keeper) GetValidatorSets(ctx sdk.Context) []types.ValidatorSet {
+	valSets := make([]types.ValidatorSet, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixValidatorSet)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var valSet types.ValidatorSet
+		k.cdc.MustUnmarshal(iterator.Value(), &valSet)
+		valSets = append(valSets, valSet)
+	}
+
+	return valSets
+}
+
+func (k Keeper) setValidatorSet(ctx sdk.Context, valSet types.ValidatorSet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&valSet)
+	store.Set(types.ValidatorSetStoreKey(valSet.SpaceId), bz)
+}
@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+//   - Index the existing block headers by height:
+//     0x0e | spaceId | blockHeight => Placeholder
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixBlockHeader)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ret := bytes.Split(bytes.TrimPrefix(iterator.Key(), types.KeyPrefixBlockHeader), types.Delimiter)
+		if len(ret) != 2 {
+			return sdkerrors.Wrapf(types.ErrBlockHeader, "invalid block header key (%X)", iterator.Key())
+		}
+
+		spaceId, err := strconv.ParseUint(string(ret[0]), 10, 64)
+		if err != nil {
+			return err
+		}
+		height, err := strconv.ParseUint(string(ret[1]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.BlockHeaderHeightStoreKey(spaceId, height), types.Placeholder)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/aadhi0612/iritamod/modules/side-chain/migrations/v2"
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	sideChainKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(sideChainKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(sideChainKey)
+
+	headers := []struct {
+		spaceId uint64
+		height  uint64
+	}{
+		{1, 1},
+		{1, 10},
+		{1, 2},
+		{2, 5},
+	}
+
+	// the v1 store, without the height index
+	for _, h := range headers {
+		store.Set(types.BlockHeaderStoreKey(h.spaceId, h.height), []byte("header"))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, sideChainKey))
+
+	for _, h := range headers {
+		require.Equal(t, types.Placeholder, store.Get(types.BlockHeaderHeightStoreKey(h.spaceId, h.height)))
+		require.Equal(t, []byte("header"), store.Get(types.BlockHeaderStoreKey(h.spaceId, h.height)))
+	}
+
+	// the height index is ordered numerically
+	var heights []uint64
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockHeaderHeightBySpaceStoreKey(1))
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, sdk.BigEndianToUint64(iterator.Key()[len(types.BlockHeaderHeightBySpaceStoreKey(1)):]))
+	}
+	iterator.Close()
+
+	require.Equal(t, []uint64{1, 2, 10}, heights)
+}
+
+func TestStoreMigrationInvalidKey(t *testing.T) {
+	sideChainKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(sideChainKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(sideChainKey)
+
+	store.Set(append(append([]byte{}, types.KeyPrefixBlockHeader...), []byte("invalid")...), []byte("header"))
+
+	require.Error(t, v2.MigrateStore(ctx, sideChainKey))
+}
@@ -0,0 +1,23 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration includes:
+//
+//   - Set the params absent from the param store to the default values.
+func MigrateStore(ctx sdk.Context, paramSpace paramstypes.Subspace) error {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !paramSpace.Has(ctx, pair.Key) {
+			paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	return nil
+}
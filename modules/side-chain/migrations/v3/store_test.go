@@ -0,0 +1,55 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/aadhi0612/iritamod/modules/side-chain/keeper"
+	v3 "github.com/aadhi0612/iritamod/modules/side-chain/migrations/v3"
+	"github.com/aadhi0612/iritamod/modules/side-chain/types"
+	"github.com/aadhi0612/iritamod/simapp"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeEncodingConfig()
+
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramstypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(keeper.ParamKeyTable())
+
+	// the v2 param store, with the header retention only
+	paramSpace.Set(ctx, types.KeyHeaderRetention, uint64(100))
+
+	require.NoError(t, v3.MigrateStore(ctx, paramSpace))
+
+	expParams := types.DefaultParams()
+	expParams.HeaderRetention = 100
+
+	for _, pair := range expParams.ParamSetPairs() {
+		require.True(t, paramSpace.Has(ctx, pair.Key))
+	}
+
+	// the empty coins are decoded as nil
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, expParams.String(), params.String())
+}
@@ -21,13 +21,39 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateSpace{}, "iritamod/side-chain/v1/MsgCreateSpace", nil)
 	cdc.RegisterConcrete(&MsgTransferSpace{}, "iritamod/side-chain/v1/MsgTransferSpace", nil)
+	cdc.RegisterConcrete(&MsgAcceptSpaceTransfer{}, "iritamod/side-chain/v1/MsgAcceptSpaceTransfer", nil)
+	cdc.RegisterConcrete(&MsgCancelSpaceTransfer{}, "iritamod/side-chain/v1/MsgCancelSpaceTransfer", nil)
+	cdc.RegisterConcrete(&MsgUpdateSpace{}, "iritamod/side-chain/v1/MsgUpdateSpace", nil)
+	cdc.RegisterConcrete(&MsgFreezeSpace{}, "iritamod/side-chain/v1/MsgFreezeSpace", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeSpace{}, "iritamod/side-chain/v1/MsgUnfreezeSpace", nil)
+	cdc.RegisterConcrete(&MsgArchiveSpace{}, "iritamod/side-chain/v1/MsgArchiveSpace", nil)
+	cdc.RegisterConcrete(&MsgPruneBlockHeaders{}, "iritamod/side-chain/v1/MsgPruneBlockHeaders", nil)
+	cdc.RegisterConcrete(&MsgRegisterValidatorSet{}, "iritamod/side-chain/v1/MsgRegisterValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgCreateBlockHeader{}, "iritamod/side-chain/v1/MsgCreateRecord", nil)
+	cdc.RegisterConcrete(&MsgCreateBlockHeaders{}, "iritamod/side-chain/v1/MsgCreateBlockHeaders", nil)
+	cdc.RegisterConcrete(&MsgAddSubmitter{}, "iritamod/side-chain/v1/MsgAddSubmitter", nil)
+	cdc.RegisterConcrete(&MsgRemoveSubmitter{}, "iritamod/side-chain/v1/MsgRemoveSubmitter", nil)
+	cdc.RegisterConcrete(&MsgChallengeBlockHeader{}, "iritamod/side-chain/v1/MsgChallengeBlockHeader", nil)
+	cdc.RegisterConcrete(&MsgResolveChallenge{}, "iritamod/side-chain/v1/MsgResolveChallenge", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateSpace{},
 		&MsgTransferSpace{},
+		&MsgAcceptSpaceTransfer{},
+		&MsgCancelSpaceTransfer{},
+		&MsgUpdateSpace{},
+		&MsgFreezeSpace{},
+		&MsgUnfreezeSpace{},
+		&MsgArchiveSpace{},
+		&MsgPruneBlockHeaders{},
+		&MsgRegisterValidatorSet{},
 		&MsgCreateBlockHeader{},
+		&MsgCreateBlockHeaders{},
+		&MsgAddSubmitter{},
+		&MsgRemoveSubmitter{},
+		&MsgChallengeBlockHeader{},
+		&MsgResolveChallenge{},
 	)
 }
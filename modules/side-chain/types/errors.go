@@ -3,8 +3,19 @@ package types
 import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 var (
-	ErrInvalidSpaceId       = sdkerrors.Register(ModuleName, 2, "invalid space id")
-	ErrInvalidSpaceOwner    = sdkerrors.Register(ModuleName, 3, "invalid space owner")
-	ErrBlockHeader          = sdkerrors.Register(ModuleName, 4, "block header error")
-	ErrInvalidSideChainUser = sdkerrors.Register(ModuleName, 5, "invalid side chain user")
+	ErrInvalidSpaceId         = sdkerrors.Register(ModuleName, 2, "invalid space id")
+	ErrInvalidSpaceOwner      = sdkerrors.Register(ModuleName, 3, "invalid space owner")
+	ErrBlockHeader            = sdkerrors.Register(ModuleName, 4, "block header error")
+	ErrInvalidSideChainUser   = sdkerrors.Register(ModuleName, 5, "invalid side chain user")
+	ErrParentHashMismatch     = sdkerrors.Register(ModuleName, 6, "parent hash mismatch")
+	ErrInvalidSubmitter       = sdkerrors.Register(ModuleName, 7, "invalid block header submitter")
+	ErrSubmitterLimit         = sdkerrors.Register(ModuleName, 8, "block header submitter limit exceeded")
+	ErrInvalidChallenge       = sdkerrors.Register(ModuleName, 9, "invalid block header challenge")
+	ErrInvalidSpaceStatus     = sdkerrors.Register(ModuleName, 10, "invalid space status")
+	ErrPruneNotAllowed        = sdkerrors.Register(ModuleName, 11, "block header pruning not allowed")
+	ErrInvalidValidatorSet    = sdkerrors.Register(ModuleName, 12, "invalid validator set")
+	ErrInsufficientSignatures = sdkerrors.Register(ModuleName, 13, "insufficient validator signatures")
+	ErrInvalidInclusionProof  = sdkerrors.Register(ModuleName, 14, "invalid inclusion proof")
+	ErrInvalidSpaceTransfer   = sdkerrors.Register(ModuleName, 15, "invalid space transfer")
+	ErrSpaceLimit             = sdkerrors.Register(ModuleName, 16, "space limit exceeded")
 )
@@ -1,13 +1,38 @@
 package types
 
 const (
-	EventTypeCreateSpace   = "create_space"
-	EventTypeTransferSpace = "transfer_space"
-	EventTypeCreateRecord  = "create_record"
+	EventTypeCreateSpace         = "create_space"
+	EventTypeTransferSpace       = "transfer_space"
+	EventTypeAcceptSpaceTransfer = "accept_space_transfer"
+	EventTypeCancelSpaceTransfer = "cancel_space_transfer"
+	EventTypeExpireSpaceTransfer = "expire_space_transfer"
+	EventTypeUpdateSpace         = "update_space"
+	EventTypeFreezeSpace         = "freeze_space"
+	EventTypeUnfreezeSpace       = "unfreeze_space"
+	EventTypeArchiveSpace        = "archive_space"
+	EventTypePruneHeaders        = "prune_block_headers"
+
+	EventTypeRegisterValidatorSet = "register_validator_set"
+	EventTypeUpdateValidatorSet   = "update_validator_set"
+	EventTypeCreateRecord         = "create_record"
+	EventTypeAddSubmitter         = "add_submitter"
+	EventTypeRemoveSubmitter      = "remove_submitter"
+	EventTypeChallengeHeader      = "challenge_block_header"
+	EventTypeResolveChallenge     = "resolve_challenge"
+	EventTypeFinalizeHeader       = "finalize_block_header"
 
 	AttributeKeySender       = "sender"
 	AttributeKeyOwner        = "owner"
 	AttributeKeyRecipient    = "recipient"
 	AttributeKeySpaceId      = "space_id"
 	AttributeKeyRecordHeight = "record_height"
+	AttributeKeyHeaderHash   = "header_hash"
+	AttributeKeySubmitter    = "submitter"
+	AttributeKeyChallenger   = "challenger"
+	AttributeKeyUpheld       = "upheld"
+	AttributeKeyHeaderStatus = "header_status"
+	AttributeKeyPruneHeight  = "prune_height"
+	AttributeKeyPruned       = "pruned"
+	AttributeKeyValidators   = "validators"
+	AttributeKeyExpiryHeight = "expiry_height"
 )
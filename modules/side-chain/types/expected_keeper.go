@@ -7,6 +7,7 @@ import (
 
 type PermKeeper interface {
 	HasSideChainUserRole(ctx sdk.Context, signer sdk.AccAddress) bool
+	IsRootAdmin(ctx sdk.Context, address sdk.AccAddress) bool
 }
 
 type AccountKeeper interface {
@@ -14,3 +15,9 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
 }
+
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
@@ -12,23 +12,39 @@ import (
 func NewGenesisState(spaceSequence uint64,
 	spaces []Space,
 	blockHeaders []BlockHeader,
-	spaceLatestHeights []SpaceLatestHeight) *GenesisState {
+	spaceLatestHeights []SpaceLatestHeight,
+	submitters []Submitter,
+	challenges []Challenge,
+	params Params,
+	validatorSets []ValidatorSet,
+	spaceTransfers []SpaceTransfer,
+	spacePrunedHeights []SpaceLatestHeight) *GenesisState {
 	return &GenesisState{
 		SpaceSequence:      spaceSequence,
 		Spaces:             spaces,
 		BlockHeaders:       blockHeaders,
 		SpaceLatestHeights: spaceLatestHeights,
+		Submitters:         submitters,
+		Challenges:         challenges,
+		Params:             params,
+		ValidatorSets:      validatorSets,
+		SpaceTransfers:     spaceTransfers,
+		SpacePrunedHeights: spacePrunedHeights,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(0, []Space{}, []BlockHeader{}, []SpaceLatestHeight{})
+	return NewGenesisState(0, []Space{}, []BlockHeader{}, []SpaceLatestHeight{}, []Submitter{}, []Challenge{}, DefaultParams(), []ValidatorSet{}, []SpaceTransfer{}, []SpaceLatestHeight{})
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
 // expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	if uint64(len(data.Spaces)) != data.SpaceSequence {
 		return sdkerrors.Wrapf(ErrInvalidSpaceId, "space count mismatch, wanted (%d) but got (%d)", data.SpaceSequence, len(data.Spaces))
 	}
@@ -44,6 +60,14 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 
+		if _, ok := SpaceStatus_name[int32(space.Status)]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidSpaceStatus, "invalid status (%d) of space (%d)", space.Status, space.Id)
+		}
+
+		if err := space.Deposit.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit of space (%d): %s", space.Id, err)
+		}
+
 		if seenSpaceIds[space.Id] {
 			return sdkerrors.Wrapf(ErrInvalidSpaceId, "duplicate space (%d) during validation", space.Id)
 		}
@@ -63,6 +87,26 @@ func ValidateGenesis(data GenesisState) error {
 			return sdkerrors.Wrapf(ErrBlockHeader, "duplicate block header (%s) during validation", seenBlockHeader)
 		}
 		seenBlockHeaderMap[seenBlockHeader] = true
+
+		if header.StructuredHeader != nil {
+			if err := header.StructuredHeader.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if header.Hash != "" {
+			if err := validateHexHash("header hash", header.Hash); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := HeaderStatus_name[int32(header.Status)]; !ok {
+			return sdkerrors.Wrapf(ErrBlockHeader, "invalid status (%d) of block header (%s)", header.Status, seenBlockHeader)
+		}
+
+		if header.Status == HeaderStatusPending && header.FinalizeHeight == 0 {
+			return sdkerrors.Wrapf(ErrBlockHeader, "finalize height of pending block header (%s) cannot be zero", seenBlockHeader)
+		}
 	}
 
 	// validate SpaceLatestHeight
@@ -77,5 +121,106 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	// validate Submitter
+	seenSubmitters := make(map[string]bool)
+	for _, submitter := range data.Submitters {
+		if !seenSpaceIds[submitter.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidSpaceId, "unknown space (%d) during validation", submitter.SpaceId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(submitter.Address); err != nil {
+			return err
+		}
+
+		if err := ValidateHeightRange(submitter.MinHeight, submitter.MaxHeight); err != nil {
+			return err
+		}
+
+		seenSubmitter := fmt.Sprintf("%d-%s", submitter.SpaceId, submitter.Address)
+		if seenSubmitters[seenSubmitter] {
+			return sdkerrors.Wrapf(ErrInvalidSubmitter, "duplicate submitter (%s) during validation", seenSubmitter)
+		}
+		seenSubmitters[seenSubmitter] = true
+	}
+
+	// validate ValidatorSet
+	seenValidatorSets := make(map[uint64]bool)
+	for _, valSet := range data.ValidatorSets {
+		if !seenSpaceIds[valSet.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidSpaceId, "unknown space (%d) during validation", valSet.SpaceId)
+		}
+
+		if err := ValidateValidators(valSet.Validators); err != nil {
+			return err
+		}
+
+		if seenValidatorSets[valSet.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "duplicate validator set of space (%d) during validation", valSet.SpaceId)
+		}
+		seenValidatorSets[valSet.SpaceId] = true
+	}
+
+	// validate Challenge
+	seenChallenges := make(map[string]bool)
+	for _, challenge := range data.Challenges {
+		seenBlockHeader := fmt.Sprintf("%d-%d", challenge.SpaceId, challenge.Height)
+		if !seenBlockHeaderMap[seenBlockHeader] {
+			return sdkerrors.Wrapf(ErrBlockHeader, "unknown block header (%s) during validation", seenBlockHeader)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(challenge.Challenger); err != nil {
+			return err
+		}
+
+		if challenge.ConflictingHeader != nil {
+			if err := challenge.ConflictingHeader.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if seenChallenges[seenBlockHeader] {
+			return sdkerrors.Wrapf(ErrInvalidChallenge, "duplicate challenge (%s) during validation", seenBlockHeader)
+		}
+		seenChallenges[seenBlockHeader] = true
+	}
+
+	// validate SpaceTransfer
+	seenSpaceTransfers := make(map[uint64]bool)
+	for _, transfer := range data.SpaceTransfers {
+		if !seenSpaceIds[transfer.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidSpaceId, "unknown space (%d) during validation", transfer.SpaceId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(transfer.Sender); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(transfer.Recipient); err != nil {
+			return err
+		}
+
+		if transfer.ExpiryHeight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSpaceTransfer, "expiry height of the transfer of space (%d) must be greater than 0", transfer.SpaceId)
+		}
+
+		if seenSpaceTransfers[transfer.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidSpaceTransfer, "duplicate transfer of space (%d) during validation", transfer.SpaceId)
+		}
+		seenSpaceTransfers[transfer.SpaceId] = true
+	}
+
+	// validate SpacePrunedHeight
+	seenPrunedHeights := make(map[uint64]bool)
+	for _, prunedHeight := range data.SpacePrunedHeights {
+		if !seenSpaceIds[prunedHeight.SpaceId] {
+			return sdkerrors.Wrapf(ErrInvalidSpaceId, "unknown space (%d) during validation", prunedHeight.SpaceId)
+		}
+
+		if seenPrunedHeights[prunedHeight.SpaceId] {
+			return sdkerrors.Wrapf(ErrBlockHeader, "duplicate pruned height of space (%d) during validation", prunedHeight.SpaceId)
+		}
+		seenPrunedHeights[prunedHeight.SpaceId] = true
+	}
+
 	return nil
 }
@@ -28,6 +28,12 @@ type GenesisState struct {
 	Spaces             []Space             `protobuf:"bytes,2,rep,name=spaces,proto3" json:"spaces"`
 	BlockHeaders       []BlockHeader       `protobuf:"bytes,3,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers"`
 	SpaceLatestHeights []SpaceLatestHeight `protobuf:"bytes,4,rep,name=space_latest_heights,json=spaceLatestHeights,proto3" json:"space_latest_heights"`
+	Submitters         []Submitter         `protobuf:"bytes,5,rep,name=submitters,proto3" json:"submitters"`
+	Challenges         []Challenge         `protobuf:"bytes,6,rep,name=challenges,proto3" json:"challenges"`
+	Params             Params              `protobuf:"bytes,7,opt,name=params,proto3" json:"params"`
+	ValidatorSets      []ValidatorSet      `protobuf:"bytes,8,rep,name=validator_sets,json=validatorSets,proto3" json:"validator_sets"`
+	SpaceTransfers     []SpaceTransfer     `protobuf:"bytes,9,rep,name=space_transfers,json=spaceTransfers,proto3" json:"space_transfers"`
+	SpacePrunedHeights []SpaceLatestHeight `protobuf:"bytes,10,rep,name=space_pruned_heights,json=spacePrunedHeights,proto3" json:"space_pruned_heights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +97,48 @@ func (m *GenesisState) GetSpaceLatestHeights() []SpaceLatestHeight {
 	return nil
 }
 
+func (m *GenesisState) GetSubmitters() []Submitter {
+	if m != nil {
+		return m.Submitters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallenges() []Challenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidatorSets() []ValidatorSet {
+	if m != nil {
+		return m.ValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpaceTransfers() []SpaceTransfer {
+	if m != nil {
+		return m.SpaceTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpacePrunedHeights() []SpaceLatestHeight {
+	if m != nil {
+		return m.SpacePrunedHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "iritamod.side_chain.v1.GenesisState")
 }
@@ -98,27 +146,36 @@ func init() {
 func init() { proto.RegisterFile("side-chain/v1/genesis.proto", fileDescriptor_fe79f655ddf8c3a2) }
 
 var fileDescriptor_fe79f655ddf8c3a2 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xb6, 0x74, 0x31, 0xb7, 0xbd, 0x8b, 0xa1, 0x48, 0xa8, 0x38, 0x16, 0x45, 0xa8,
-	0x0b, 0x67, 0xa8, 0x82, 0x1b, 0x77, 0xdd, 0xd8, 0x85, 0x88, 0xb4, 0x3b, 0x37, 0x61, 0x92, 0x1c,
-	0x92, 0xd1, 0x36, 0x53, 0x7b, 0xa6, 0x05, 0xdf, 0xc2, 0x97, 0xf0, 0x5d, 0xba, 0xec, 0xd2, 0x95,
-	0x48, 0xf2, 0x22, 0x92, 0x49, 0xa4, 0x2a, 0xea, 0x2e, 0xf9, 0xcf, 0x77, 0xbe, 0xf9, 0x99, 0x21,
-	0xbb, 0xa8, 0x22, 0x38, 0x09, 0x13, 0xa9, 0x52, 0xb1, 0x1a, 0x88, 0x18, 0x52, 0x40, 0x85, 0x7c,
-	0xbe, 0xd0, 0x46, 0xd3, 0x1d, 0xb5, 0x50, 0x46, 0xce, 0x74, 0xc4, 0x0b, 0xca, 0xb7, 0x14, 0x5f,
-	0x0d, 0xba, 0x9d, 0x58, 0xc7, 0xda, 0x22, 0xa2, 0xf8, 0x2a, 0xe9, 0x2e, 0xfb, 0xaa, 0xda, 0xfe,
-	0x95, 0xf3, 0x83, 0xe7, 0x1a, 0x69, 0x5d, 0x96, 0xfe, 0x89, 0x91, 0x06, 0xe8, 0x11, 0xf9, 0x8f,
-	0x73, 0x19, 0x82, 0x8f, 0xf0, 0xb0, 0x84, 0x34, 0x04, 0xcf, 0xed, 0xb9, 0xfd, 0xc6, 0xb8, 0x6d,
-	0xd3, 0x49, 0x15, 0xd2, 0x0b, 0xd2, 0xb4, 0x01, 0x7a, 0xb5, 0x5e, 0xbd, 0xff, 0xef, 0x74, 0x8f,
-	0xff, 0x5c, 0x8b, 0x4f, 0x0a, 0x6a, 0xd8, 0x58, 0xbf, 0xee, 0x3b, 0xe3, 0x6a, 0x85, 0x5e, 0x93,
-	0x76, 0x30, 0xd5, 0xe1, 0xbd, 0x9f, 0x80, 0x8c, 0x60, 0x81, 0x5e, 0xdd, 0x3a, 0x0e, 0x7f, 0x73,
-	0x0c, 0x0b, 0x78, 0x64, 0xd9, 0xca, 0xd4, 0x0a, 0xb6, 0x11, 0x52, 0x49, 0x3a, 0x65, 0xe7, 0xa9,
-	0x34, 0x80, 0xc6, 0x4f, 0x40, 0xc5, 0x89, 0x41, 0xaf, 0x61, 0xb5, 0xc7, 0x7f, 0x56, 0xbb, 0xb2,
-	0x2b, 0x23, 0xbb, 0x51, 0xc9, 0x29, 0x7e, 0x1f, 0xe0, 0xf0, 0x66, 0x9d, 0x31, 0x77, 0x93, 0x31,
-	0xf7, 0x2d, 0x63, 0xee, 0x53, 0xce, 0x9c, 0x4d, 0xce, 0x9c, 0x97, 0x9c, 0x39, 0xb7, 0xe7, 0xb1,
-	0x32, 0xc9, 0x32, 0xe0, 0xa1, 0x9e, 0x89, 0x40, 0xc9, 0xf4, 0x4e, 0x81, 0x54, 0xe2, 0xe3, 0x48,
-	0x31, 0xd3, 0xd1, 0x72, 0x0a, 0xf8, 0xe9, 0xe6, 0x85, 0x79, 0x9c, 0x03, 0x06, 0x4d, 0xfb, 0x00,
-	0x67, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0xec, 0x95, 0x62, 0x53, 0xed, 0x01, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x63, 0x1a, 0x0c, 0x5c, 0x9b, 0x20, 0x9d, 0x2a, 0x64, 0x15, 0x71, 0x84, 0x3f, 0x95,
+	0xc2, 0x80, 0x4d, 0x82, 0xd4, 0x05, 0xa6, 0x30, 0xb4, 0x03, 0x42, 0xa1, 0xa9, 0x18, 0x58, 0xac,
+	0xb3, 0xfd, 0x62, 0x9f, 0xb0, 0x7d, 0xc6, 0xef, 0xd9, 0x12, 0x23, 0xdf, 0x80, 0x8f, 0xd5, 0xb1,
+	0x23, 0x13, 0x42, 0xc9, 0x17, 0x41, 0x3e, 0xdb, 0xc4, 0x20, 0x9c, 0xa5, 0x5b, 0xf2, 0xde, 0xef,
+	0xf9, 0xf9, 0xd5, 0x3d, 0x3a, 0x72, 0x1f, 0x45, 0x00, 0xcf, 0xfd, 0x88, 0x8b, 0xd4, 0x29, 0x67,
+	0x4e, 0x08, 0x29, 0xa0, 0x40, 0x3b, 0xcb, 0xa5, 0x92, 0xf4, 0x9e, 0xc8, 0x85, 0xe2, 0x89, 0x0c,
+	0xec, 0x8a, 0x72, 0x35, 0x65, 0x97, 0xb3, 0xa3, 0xc3, 0x50, 0x86, 0x52, 0x23, 0x4e, 0xf5, 0xab,
+	0xa6, 0x8f, 0xd8, 0xdf, 0xaa, 0xed, 0xbf, 0xfa, 0xfc, 0xf1, 0x37, 0x93, 0x1c, 0x9c, 0xd6, 0xfe,
+	0x95, 0xe2, 0x0a, 0xe8, 0x31, 0x19, 0x63, 0xc6, 0x7d, 0x70, 0x11, 0xbe, 0x14, 0x90, 0xfa, 0x60,
+	0x19, 0x13, 0x63, 0x3a, 0x3c, 0x1f, 0xe9, 0xe9, 0xaa, 0x19, 0xd2, 0x57, 0xc4, 0xd4, 0x03, 0xb4,
+	0x6e, 0x4c, 0xf6, 0xa6, 0xfb, 0xf3, 0x07, 0xf6, 0xff, 0xd7, 0xb2, 0x57, 0x15, 0xb5, 0x18, 0x5e,
+	0xfe, 0x7c, 0x38, 0x38, 0x6f, 0x22, 0xf4, 0x1d, 0x19, 0x79, 0xb1, 0xf4, 0x3f, 0xbb, 0x11, 0xf0,
+	0x00, 0x72, 0xb4, 0xf6, 0xb4, 0xe3, 0x49, 0x9f, 0x63, 0x51, 0xc1, 0x67, 0x9a, 0x6d, 0x4c, 0x07,
+	0xde, 0x76, 0x84, 0x94, 0x93, 0xc3, 0x7a, 0xe7, 0x98, 0x2b, 0x40, 0xe5, 0x46, 0x20, 0xc2, 0x48,
+	0xa1, 0x35, 0xd4, 0xda, 0x67, 0x3b, 0x57, 0x7b, 0xab, 0x23, 0x67, 0x3a, 0xd1, 0xc8, 0x29, 0xfe,
+	0x7b, 0x80, 0xf4, 0x94, 0x10, 0x2c, 0xbc, 0x44, 0x28, 0x55, 0xed, 0x7b, 0x53, 0x8b, 0x1f, 0xf5,
+	0x8a, 0x5b, 0xb2, 0x11, 0x76, 0xa2, 0x95, 0xc8, 0x8f, 0x78, 0x1c, 0x43, 0x1a, 0x02, 0x5a, 0xe6,
+	0x6e, 0xd1, 0x9b, 0x96, 0x6c, 0x45, 0xdb, 0x28, 0x7d, 0x4d, 0xcc, 0x8c, 0xe7, 0x3c, 0x41, 0xeb,
+	0xd6, 0xc4, 0x98, 0xee, 0xcf, 0x59, 0x9f, 0x64, 0xa9, 0xa9, 0xb6, 0x82, 0x3a, 0x43, 0xdf, 0x93,
+	0x71, 0xc9, 0x63, 0x11, 0x70, 0x25, 0x73, 0x17, 0x41, 0xa1, 0x75, 0x5b, 0xaf, 0xf2, 0xb4, 0xcf,
+	0xf2, 0xa1, 0xa5, 0x57, 0xd0, 0xde, 0xd3, 0xa8, 0xec, 0xcc, 0x90, 0x5e, 0x90, 0xbb, 0x75, 0x0b,
+	0x2a, 0xe7, 0x29, 0x7e, 0xaa, 0xee, 0xe9, 0x8e, 0x76, 0x1e, 0xef, 0x2c, 0xe0, 0xa2, 0xa1, 0x1b,
+	0xe9, 0x18, 0xbb, 0xc3, 0x4e, 0xb7, 0x59, 0x5e, 0xa4, 0x10, 0xfc, 0xe9, 0x96, 0x5c, 0xa7, 0xdb,
+	0xa5, 0x76, 0xd5, 0x07, 0xb8, 0x58, 0x5e, 0xae, 0x99, 0x71, 0xb5, 0x66, 0xc6, 0xaf, 0x35, 0x33,
+	0xbe, 0x6f, 0xd8, 0xe0, 0x6a, 0xc3, 0x06, 0x3f, 0x36, 0x6c, 0xf0, 0xf1, 0x24, 0x14, 0x2a, 0x2a,
+	0x3c, 0xdb, 0x97, 0x89, 0xc3, 0x79, 0x10, 0x89, 0x17, 0x27, 0xb3, 0xb9, 0xd3, 0x7e, 0xd2, 0x49,
+	0x64, 0x50, 0xc4, 0x80, 0x9d, 0x57, 0xe5, 0xa8, 0xaf, 0x19, 0xa0, 0x67, 0xea, 0xc7, 0xf5, 0xf2,
+	0xf7, 0x00, 0x3d, 0xe7, 0x54, 0xf2, 0xc9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +198,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpacePrunedHeights) > 0 {
+		for iNdEx := len(m.SpacePrunedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpacePrunedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SpaceTransfers) > 0 {
+		for iNdEx := len(m.SpaceTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpaceTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for iNdEx := len(m.ValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Submitters) > 0 {
+		for iNdEx := len(m.Submitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SpaceLatestHeights) > 0 {
 		for iNdEx := len(m.SpaceLatestHeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -229,6 +366,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Submitters) > 0 {
+		for _, e := range m.Submitters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorSets) > 0 {
+		for _, e := range m.ValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpaceTransfers) > 0 {
+		for _, e := range m.SpaceTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpacePrunedHeights) > 0 {
+		for _, e := range m.SpacePrunedHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -388,6 +557,209 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitters = append(m.Submitters, Submitter{})
+			if err := m.Submitters[len(m.Submitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, Challenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSets = append(m.ValidatorSets, ValidatorSet{})
+			if err := m.ValidatorSets[len(m.ValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceTransfers = append(m.SpaceTransfers, SpaceTransfer{})
+			if err := m.SpaceTransfers[len(m.SpaceTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpacePrunedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpacePrunedHeights = append(m.SpacePrunedHeights, SpaceLatestHeight{})
+			if err := m.SpacePrunedHeights[len(m.SpacePrunedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate validates the structured header.
+// The parent hash is optional for the header which has no parent recorded
+func (h StructuredHeader) Validate() error {
+	if len(h.ParentHash) > 0 {
+		if err := validateHexHash("parent hash", h.ParentHash); err != nil {
+			return err
+		}
+	}
+
+	if err := validateHexHash("state root", h.StateRoot); err != nil {
+		return err
+	}
+
+	if err := validateHexHash("tx root", h.TxRoot); err != nil {
+		return err
+	}
+
+	if h.Timestamp.IsZero() {
+		return sdkerrors.Wrapf(ErrBlockHeader, "timestamp cannot be empty")
+	}
+
+	return nil
+}
+
+// Hash returns the hash of the structured header, which is the SHA-256 hash of its protobuf encoding
+func (h StructuredHeader) Hash() tmbytes.HexBytes {
+	bz, err := h.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// GetHeaderHash returns the hash of the block header. The structured header is hashed if provided,
+// otherwise the opaque header. The opaque header carried along with the structured header is committed
+// to by hashing it together with the structured header hash
+func GetHeaderHash(header string, structuredHeader *StructuredHeader) tmbytes.HexBytes {
+	if structuredHeader == nil {
+		return tmhash.Sum([]byte(header))
+	}
+
+	if len(header) == 0 {
+		return structuredHeader.Hash()
+	}
+
+	return tmhash.Sum(append(structuredHeader.Hash(), header...))
+}
+
+// Validate validates the block header in the batch
+func (h BatchBlockHeader) Validate() error {
+	if h.StructuredHeader != nil {
+		if err := h.StructuredHeader.Validate(); err != nil {
+			return err
+		}
+	} else if len(h.Header) == 0 {
+		return sdkerrors.Wrapf(ErrBlockHeader, "header cannot be empty string")
+	}
+
+	if h.Commit != nil {
+		return h.Commit.Validate()
+	}
+
+	return nil
+}
+
+// HeaderSignBytes returns the bytes to be signed by the space owner or submitter for the block header,
+// which are used to prove a conflicting header
+func HeaderSignBytes(chainID string, spaceId, height uint64, hash tmbytes.HexBytes) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID string `json:"chain_id"`
+		SpaceId string `json:"space_id"`
+		Height  string `json:"height"`
+		Hash    string `json:"hash"`
+	}{
+		ChainID: chainID,
+		SpaceId: strconv.FormatUint(spaceId, 10),
+		Height:  strconv.FormatUint(height, 10),
+		Hash:    hash.String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// Validate validates the conflicting header
+func (h ConflictingHeader) Validate() error {
+	if h.StructuredHeader != nil {
+		if err := h.StructuredHeader.Validate(); err != nil {
+			return err
+		}
+	} else if len(h.Header) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidChallenge, "conflicting header cannot be empty string")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if len(h.Signature) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidChallenge, "signature of the conflicting header cannot be empty")
+	}
+
+	return nil
+}
+
+// Hash returns the hash of the conflicting header
+func (h ConflictingHeader) Hash() tmbytes.HexBytes {
+	return GetHeaderHash(h.Header, h.StructuredHeader)
+}
+
+func validateHexHash(name, hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrBlockHeader, "%s must be hex encoded", name)
+	}
+
+	if len(bz) != tmhash.Size {
+		return sdkerrors.Wrapf(ErrBlockHeader, "%s must be %d bytes", name, tmhash.Size)
+	}
+
+	return nil
+}
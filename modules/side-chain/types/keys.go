@@ -25,6 +25,30 @@ var (
 	// BlockHeader storekey prefix
 	KeyPrefixBlockHeader       = []byte{0x04}
 	KeyPrefixBlockHeaderTxHash = []byte{0x05}
+	KeyPrefixBlockHeaderHash   = []byte{0x07}
+	KeyPrefixStructuredHeader  = []byte{0x08}
+
+	// Submitter storekey prefix
+	KeyPrefixSubmitter      = []byte{0x09}
+	KeyPrefixSubmitterUsage = []byte{0x0a}
+
+	// Finality storekey prefix
+	KeyPrefixHeaderFinality = []byte{0x0b}
+	KeyPrefixPendingHeader  = []byte{0x0c}
+	KeyPrefixChallenge      = []byte{0x0d}
+
+	// BlockHeader height index storekey prefix
+	KeyPrefixBlockHeaderHeight = []byte{0x0e}
+
+	// ValidatorSet storekey prefix
+	KeyPrefixValidatorSet = []byte{0x0f}
+
+	// SpaceTransfer storekey prefix
+	KeyPrefixSpaceTransfer       = []byte{0x10}
+	KeyPrefixSpaceTransferExpiry = []byte{0x11}
+
+	// Space pruned height storekey prefix
+	KeyPrefixSpacePrunedHeight = []byte{0x12}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -112,3 +136,156 @@ func KeyPrefixSpaceLatestHeightStoreKey(spaceId uint64) []byte {
 	copy(key[len(KeyPrefixSpaceLatestHeight):], spaceIdStr)
 	return key
 }
+
+// BlockHeaderHashStoreKey returns the byte representation of the block header hash key
+// Items are stored with the following key: values
+// <0x07><space_id><delimiter><block_height>
+func BlockHeaderHashStoreKey(spaceId, blockHeight uint64) []byte {
+	return spaceHeightStoreKey(KeyPrefixBlockHeaderHash, spaceId, blockHeight)
+}
+
+// StructuredHeaderStoreKey returns the byte representation of the structured header key
+// Items are stored with the following key: values
+// <0x08><space_id><delimiter><block_height>
+func StructuredHeaderStoreKey(spaceId, blockHeight uint64) []byte {
+	return spaceHeightStoreKey(KeyPrefixStructuredHeader, spaceId, blockHeight)
+}
+
+// spaceHeightStoreKey returns the key of <prefix><space_id><delimiter><block_height>
+func spaceHeightStoreKey(prefix []byte, spaceId, blockHeight uint64) []byte {
+	spaceIdStr := strconv.FormatUint(spaceId, 10)
+	blockHeightStr := strconv.FormatUint(blockHeight, 10)
+	key := make([]byte, len(prefix)+len(spaceIdStr)+len(Delimiter)+len(blockHeightStr))
+	copy(key, prefix)
+	copy(key[len(prefix):], spaceIdStr)
+	copy(key[len(prefix)+len(spaceIdStr):], Delimiter)
+	copy(key[len(prefix)+len(spaceIdStr)+len(Delimiter):], blockHeightStr)
+	return key
+}
+
+// SubmitterStoreKey returns the byte representation of the space submitter key
+// Items are stored with the following key: values
+// <0x09><space_id><delimiter><submitter>
+func SubmitterStoreKey(spaceId uint64, submitter sdk.AccAddress) []byte {
+	return append(SubmitterBySpaceStoreKey(spaceId), address.MustLengthPrefix(submitter)...)
+}
+
+// SubmitterBySpaceStoreKey returns the key prefix of the submitters of the space
+// <0x09><space_id><delimiter>
+func SubmitterBySpaceStoreKey(spaceId uint64) []byte {
+	spaceIdStr := strconv.FormatUint(spaceId, 10)
+	key := make([]byte, len(KeyPrefixSubmitter)+len(spaceIdStr)+len(Delimiter))
+	copy(key, KeyPrefixSubmitter)
+	copy(key[len(KeyPrefixSubmitter):], spaceIdStr)
+	copy(key[len(KeyPrefixSubmitter)+len(spaceIdStr):], Delimiter)
+	return key
+}
+
+// SubmitterUsageStoreKey returns the byte representation of the submitter usage key, which
+// records the number of headers submitted by the submitter in the latest block
+// Items are stored with the following key: values
+// <0x0a><space_id><delimiter><submitter>
+func SubmitterUsageStoreKey(spaceId uint64, submitter sdk.AccAddress) []byte {
+	key := SubmitterStoreKey(spaceId, submitter)
+	return append(append([]byte{}, KeyPrefixSubmitterUsage...), key[len(KeyPrefixSubmitter):]...)
+}
+
+// HeaderFinalityStoreKey returns the byte representation of the header finality key
+// Items are stored with the following key: values
+// <0x0b><space_id><delimiter><block_height>
+func HeaderFinalityStoreKey(spaceId, blockHeight uint64) []byte {
+	return spaceHeightStoreKey(KeyPrefixHeaderFinality, spaceId, blockHeight)
+}
+
+// PendingHeaderStoreKey returns the byte representation of the pending header key,
+// which orders the pending headers by the finalize height
+// Items are stored with the following key: values
+// <0x0c><finalize_height><space_id><block_height>
+func PendingHeaderStoreKey(finalizeHeight, spaceId, blockHeight uint64) []byte {
+	key := PendingHeaderByFinalizeHeightStoreKey(finalizeHeight)
+	key = append(key, sdk.Uint64ToBigEndian(spaceId)...)
+	return append(key, sdk.Uint64ToBigEndian(blockHeight)...)
+}
+
+// PendingHeaderByFinalizeHeightStoreKey returns the key prefix of the headers pending until the finalize height
+// <0x0c><finalize_height>
+func PendingHeaderByFinalizeHeightStoreKey(finalizeHeight uint64) []byte {
+	return append(append([]byte{}, KeyPrefixPendingHeader...), sdk.Uint64ToBigEndian(finalizeHeight)...)
+}
+
+// SplitPendingHeaderStoreKey splits the pending header key into the finalize height, space id and block height
+func SplitPendingHeaderStoreKey(key []byte) (finalizeHeight, spaceId, blockHeight uint64) {
+	key = key[len(KeyPrefixPendingHeader):]
+	return sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:16]), sdk.BigEndianToUint64(key[16:24])
+}
+
+// ChallengeStoreKey returns the byte representation of the header challenge key
+// Items are stored with the following key: values
+// <0x0d><space_id><delimiter><block_height>
+func ChallengeStoreKey(spaceId, blockHeight uint64) []byte {
+	return spaceHeightStoreKey(KeyPrefixChallenge, spaceId, blockHeight)
+}
+
+// BlockHeaderHeightStoreKey returns the byte representation of the block header height index key,
+// which orders the block headers of the space by the height
+// Items are stored with the following key: values
+// <0x0e><space_id><block_height>
+func BlockHeaderHeightStoreKey(spaceId, blockHeight uint64) []byte {
+	return append(BlockHeaderHeightBySpaceStoreKey(spaceId), sdk.Uint64ToBigEndian(blockHeight)...)
+}
+
+// BlockHeaderHeightBySpaceStoreKey returns the key prefix of the block header height index of the space
+// <0x0e><space_id>
+func BlockHeaderHeightBySpaceStoreKey(spaceId uint64) []byte {
+	return append(append([]byte{}, KeyPrefixBlockHeaderHeight...), sdk.Uint64ToBigEndian(spaceId)...)
+}
+
+// ValidatorSetStoreKey returns the byte representation of the validator set key
+// Items are stored with the following key: values
+// <0x0f><space_id>
+func ValidatorSetStoreKey(spaceId uint64) []byte {
+	spaceIdStr := strconv.FormatUint(spaceId, 10)
+	key := make([]byte, len(KeyPrefixValidatorSet)+len(spaceIdStr))
+	copy(key, KeyPrefixValidatorSet)
+	copy(key[len(KeyPrefixValidatorSet):], spaceIdStr)
+	return key
+}
+
+// SpaceTransferStoreKey returns the byte representation of the pending space transfer key
+// Items are stored with the following key: values
+// <0x10><space_id>
+func SpaceTransferStoreKey(spaceId uint64) []byte {
+	spaceIdStr := strconv.FormatUint(spaceId, 10)
+	key := make([]byte, len(KeyPrefixSpaceTransfer)+len(spaceIdStr))
+	copy(key, KeyPrefixSpaceTransfer)
+	copy(key[len(KeyPrefixSpaceTransfer):], spaceIdStr)
+	return key
+}
+
+// SpaceTransferExpiryStoreKey returns the byte representation of the space transfer expiry key,
+// which orders the pending space transfers by the expiry height
+// Items are stored with the following key: values
+// <0x11><expiry_height><space_id>
+func SpaceTransferExpiryStoreKey(expiryHeight, spaceId uint64) []byte {
+	return append(SpaceTransferByExpiryHeightStoreKey(expiryHeight), sdk.Uint64ToBigEndian(spaceId)...)
+}
+
+// SpaceTransferByExpiryHeightStoreKey returns the key prefix of the space transfers expiring at the height
+// <0x11><expiry_height>
+func SpaceTransferByExpiryHeightStoreKey(expiryHeight uint64) []byte {
+	return append(append([]byte{}, KeyPrefixSpaceTransferExpiry...), sdk.Uint64ToBigEndian(expiryHeight)...)
+}
+
+// SplitSpaceTransferExpiryStoreKey splits the space transfer expiry key into the expiry height and space id
+func SplitSpaceTransferExpiryStoreKey(key []byte) (expiryHeight, spaceId uint64) {
+	key = key[len(KeyPrefixSpaceTransferExpiry):]
+	return sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:16])
+}
+
+// SpacePrunedHeightStoreKey returns the byte representation of the space pruned height key,
+// under which the block headers of the space have been pruned
+// Items are stored with the following key: values
+// <0x12><space_id>
+func SpacePrunedHeightStoreKey(spaceId uint64) []byte {
+	return append(append([]byte{}, KeyPrefixSpacePrunedHeight...), sdk.Uint64ToBigEndian(spaceId)...)
+}
@@ -6,23 +6,54 @@ import (
 )
 
 const (
-	TypeMsgCreateSpace   = "create_space"
-	TypeMsgTransferSpace = "transfer_space"
-	TypeMsgCreateRecord  = "create_record"
+	TypeMsgCreateSpace      = "create_space"
+	TypeMsgTransferSpace    = "transfer_space"
+	TypeMsgAcceptTransfer   = "accept_space_transfer"
+	TypeMsgCancelTransfer   = "cancel_space_transfer"
+	TypeMsgUpdateSpace      = "update_space"
+	TypeMsgFreezeSpace      = "freeze_space"
+	TypeMsgUnfreezeSpace    = "unfreeze_space"
+	TypeMsgArchiveSpace     = "archive_space"
+	TypeMsgPruneHeaders     = "prune_block_headers"
+	TypeMsgRegisterValSet   = "register_validator_set"
+	TypeMsgCreateRecord     = "create_record"
+	TypeMsgCreateRecords    = "create_records"
+	TypeMsgAddSubmitter     = "add_submitter"
+	TypeMsgRemoveSubmitter  = "remove_submitter"
+	TypeMsgChallengeHeader  = "challenge_block_header"
+	TypeMsgResolveChallenge = "resolve_challenge"
+
+	MaxBatchHeaders = 100 // maximum number of block headers in a batch
+
+	DoNotModify = "[do-not-modify]" // value used to indicate not to modify a field
 )
 
 var (
 	_ sdk.Msg = &MsgCreateSpace{}
 	_ sdk.Msg = &MsgTransferSpace{}
+	_ sdk.Msg = &MsgAcceptSpaceTransfer{}
+	_ sdk.Msg = &MsgCancelSpaceTransfer{}
+	_ sdk.Msg = &MsgUpdateSpace{}
+	_ sdk.Msg = &MsgFreezeSpace{}
+	_ sdk.Msg = &MsgUnfreezeSpace{}
+	_ sdk.Msg = &MsgArchiveSpace{}
+	_ sdk.Msg = &MsgPruneBlockHeaders{}
+	_ sdk.Msg = &MsgRegisterValidatorSet{}
 	_ sdk.Msg = &MsgCreateBlockHeader{}
+	_ sdk.Msg = &MsgCreateBlockHeaders{}
+	_ sdk.Msg = &MsgAddSubmitter{}
+	_ sdk.Msg = &MsgRemoveSubmitter{}
+	_ sdk.Msg = &MsgChallengeBlockHeader{}
+	_ sdk.Msg = &MsgResolveChallenge{}
 )
 
 // NewMsgCreateSpace is a constructor function for MsgCreateSpace
-func NewMsgCreateSpace(name, uri, sender string) *MsgCreateSpace {
+func NewMsgCreateSpace(name, uri string, challengePeriod uint64, sender string) *MsgCreateSpace {
 	return &MsgCreateSpace{
-		Name:   name,
-		Uri:    uri,
-		Sender: sender,
+		Name:            name,
+		Uri:             uri,
+		ChallengePeriod: challengePeriod,
+		Sender:          sender,
 	}
 }
 
@@ -52,11 +83,12 @@ func (msg MsgCreateSpace) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgTransferSpace is a constructor function for MsgTransferSpace
-func NewMsgTransferSpace(spaceId uint64, recipient string, sender string) *MsgTransferSpace {
+func NewMsgTransferSpace(spaceId uint64, recipient string, expiryHeight uint64, sender string) *MsgTransferSpace {
 	return &MsgTransferSpace{
-		SpaceId:   spaceId,
-		Recipient: recipient,
-		Sender:    sender,
+		SpaceId:      spaceId,
+		Recipient:    recipient,
+		Sender:       sender,
+		ExpiryHeight: expiryHeight,
 	}
 }
 
@@ -73,6 +105,14 @@ func (msg MsgTransferSpace) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
 	}
 
+	if msg.Sender == msg.Recipient {
+		return sdkerrors.Wrapf(ErrInvalidSpaceTransfer, "recipient cannot be the sender")
+	}
+
+	if msg.ExpiryHeight == 0 {
+		return sdkerrors.Wrapf(ErrInvalidSpaceTransfer, "expiry height must be greater than 0")
+	}
+
 	if err := ValidateSpaceId(msg.SpaceId); err != nil {
 		return err
 	}
@@ -93,6 +133,276 @@ func (msg MsgTransferSpace) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgAcceptSpaceTransfer is a constructor function for MsgAcceptSpaceTransfer
+func NewMsgAcceptSpaceTransfer(spaceId uint64, sender string) *MsgAcceptSpaceTransfer {
+	return &MsgAcceptSpaceTransfer{
+		SpaceId: spaceId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgAcceptSpaceTransfer) Route() string { return RouterKey }
+
+func (msg MsgAcceptSpaceTransfer) Type() string { return TypeMsgAcceptTransfer }
+
+func (msg MsgAcceptSpaceTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgAcceptSpaceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAcceptSpaceTransfer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCancelSpaceTransfer is a constructor function for MsgCancelSpaceTransfer
+func NewMsgCancelSpaceTransfer(spaceId uint64, sender string) *MsgCancelSpaceTransfer {
+	return &MsgCancelSpaceTransfer{
+		SpaceId: spaceId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgCancelSpaceTransfer) Route() string { return RouterKey }
+
+func (msg MsgCancelSpaceTransfer) Type() string { return TypeMsgCancelTransfer }
+
+func (msg MsgCancelSpaceTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgCancelSpaceTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelSpaceTransfer) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUpdateSpace is a constructor function for MsgUpdateSpace
+func NewMsgUpdateSpace(spaceId uint64, name, uri string, sender string) *MsgUpdateSpace {
+	return &MsgUpdateSpace{
+		SpaceId: spaceId,
+		Name:    name,
+		Uri:     uri,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgUpdateSpace) Route() string { return RouterKey }
+
+func (msg MsgUpdateSpace) Type() string { return TypeMsgUpdateSpace }
+
+func (msg MsgUpdateSpace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgUpdateSpace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateSpace) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgFreezeSpace is a constructor function for MsgFreezeSpace
+func NewMsgFreezeSpace(spaceId uint64, sender string) *MsgFreezeSpace {
+	return &MsgFreezeSpace{
+		SpaceId: spaceId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgFreezeSpace) Route() string { return RouterKey }
+
+func (msg MsgFreezeSpace) Type() string { return TypeMsgFreezeSpace }
+
+func (msg MsgFreezeSpace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgFreezeSpace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFreezeSpace) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUnfreezeSpace is a constructor function for MsgUnfreezeSpace
+func NewMsgUnfreezeSpace(spaceId uint64, sender string) *MsgUnfreezeSpace {
+	return &MsgUnfreezeSpace{
+		SpaceId: spaceId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgUnfreezeSpace) Route() string { return RouterKey }
+
+func (msg MsgUnfreezeSpace) Type() string { return TypeMsgUnfreezeSpace }
+
+func (msg MsgUnfreezeSpace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgUnfreezeSpace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnfreezeSpace) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgArchiveSpace is a constructor function for MsgArchiveSpace
+func NewMsgArchiveSpace(spaceId uint64, sender string) *MsgArchiveSpace {
+	return &MsgArchiveSpace{
+		SpaceId: spaceId,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgArchiveSpace) Route() string { return RouterKey }
+
+func (msg MsgArchiveSpace) Type() string { return TypeMsgArchiveSpace }
+
+func (msg MsgArchiveSpace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgArchiveSpace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgArchiveSpace) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgRegisterValidatorSet is a constructor function for MsgRegisterValidatorSet
+func NewMsgRegisterValidatorSet(spaceId uint64, validators []Validator, sender string) *MsgRegisterValidatorSet {
+	return &MsgRegisterValidatorSet{
+		SpaceId:    spaceId,
+		Validators: validators,
+		Sender:     sender,
+	}
+}
+
+func (msg MsgRegisterValidatorSet) Route() string { return RouterKey }
+
+func (msg MsgRegisterValidatorSet) Type() string { return TypeMsgRegisterValSet }
+
+func (msg MsgRegisterValidatorSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := ValidateValidators(msg.Validators); err != nil {
+		return err
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgRegisterValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterValidatorSet) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgPruneBlockHeaders is a constructor function for MsgPruneBlockHeaders
+func NewMsgPruneBlockHeaders(spaceId, height uint64, sender string) *MsgPruneBlockHeaders {
+	return &MsgPruneBlockHeaders{
+		SpaceId: spaceId,
+		Height:  height,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgPruneBlockHeaders) Route() string { return RouterKey }
+
+func (msg MsgPruneBlockHeaders) Type() string { return TypeMsgPruneHeaders }
+
+func (msg MsgPruneBlockHeaders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.Height == 0 {
+		return sdkerrors.Wrapf(ErrBlockHeader, "height cannot be zero")
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgPruneBlockHeaders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPruneBlockHeaders) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 // NewMsgCreateBlockHeader is a constructor function for MsgCreateNFTs
 func NewMsgCreateBlockHeader(spaceId, height uint64, header string, sender string) *MsgCreateBlockHeader {
 	return &MsgCreateBlockHeader{
@@ -103,6 +413,17 @@ func NewMsgCreateBlockHeader(spaceId, height uint64, header string, sender strin
 	}
 }
 
+// NewMsgCreateStructuredBlockHeader is a constructor function for MsgCreateBlockHeader carrying a structured header
+func NewMsgCreateStructuredBlockHeader(spaceId, height uint64, header string, structuredHeader StructuredHeader, sender string) *MsgCreateBlockHeader {
+	return &MsgCreateBlockHeader{
+		SpaceId:          spaceId,
+		Height:           height,
+		Header:           header,
+		Sender:           sender,
+		StructuredHeader: &structuredHeader,
+	}
+}
+
 func (msg MsgCreateBlockHeader) Route() string { return RouterKey }
 
 func (msg MsgCreateBlockHeader) Type() string { return TypeMsgCreateRecord }
@@ -116,10 +437,20 @@ func (msg MsgCreateBlockHeader) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrBlockHeader, "height cannot be zero")
 	}
 
-	if len(msg.Header) == 0 {
+	if msg.StructuredHeader != nil {
+		if err := msg.StructuredHeader.Validate(); err != nil {
+			return err
+		}
+	} else if len(msg.Header) == 0 {
 		return sdkerrors.Wrapf(ErrBlockHeader, "header cannot be empty string")
 	}
 
+	if msg.Commit != nil {
+		if err := msg.Commit.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := ValidateSpaceId(msg.SpaceId); err != nil {
 		return err
 	}
@@ -138,3 +469,230 @@ func (msg MsgCreateBlockHeader) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgCreateBlockHeaders is a constructor function for MsgCreateBlockHeaders
+func NewMsgCreateBlockHeaders(spaceId, startHeight uint64, headers []BatchBlockHeader, sender string) *MsgCreateBlockHeaders {
+	return &MsgCreateBlockHeaders{
+		SpaceId:     spaceId,
+		StartHeight: startHeight,
+		Headers:     headers,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgCreateBlockHeaders) Route() string { return RouterKey }
+
+func (msg MsgCreateBlockHeaders) Type() string { return TypeMsgCreateRecords }
+
+func (msg MsgCreateBlockHeaders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.StartHeight == 0 {
+		return sdkerrors.Wrapf(ErrBlockHeader, "start height cannot be zero")
+	}
+
+	if len(msg.Headers) == 0 || len(msg.Headers) > MaxBatchHeaders {
+		return sdkerrors.Wrapf(ErrBlockHeader, "number of headers must be 1 ~ %d", MaxBatchHeaders)
+	}
+
+	if msg.StartHeight+uint64(len(msg.Headers))-1 < msg.StartHeight {
+		return sdkerrors.Wrapf(ErrBlockHeader, "height overflows")
+	}
+
+	for _, header := range msg.Headers {
+		if err := header.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgCreateBlockHeaders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateBlockHeaders) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgAddSubmitter is a constructor function for MsgAddSubmitter
+func NewMsgAddSubmitter(spaceId uint64, submitter string, minHeight, maxHeight, maxPerBlock uint64, sender string) *MsgAddSubmitter {
+	return &MsgAddSubmitter{
+		SpaceId:     spaceId,
+		Submitter:   submitter,
+		MinHeight:   minHeight,
+		MaxHeight:   maxHeight,
+		MaxPerBlock: maxPerBlock,
+		Sender:      sender,
+	}
+}
+
+func (msg MsgAddSubmitter) Route() string { return RouterKey }
+
+func (msg MsgAddSubmitter) Type() string { return TypeMsgAddSubmitter }
+
+func (msg MsgAddSubmitter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address (%s)", err)
+	}
+
+	if err := ValidateSpaceId(msg.SpaceId); err != nil {
+		return err
+	}
+
+	return ValidateHeightRange(msg.MinHeight, msg.MaxHeight)
+}
+
+func (msg MsgAddSubmitter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddSubmitter) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgRemoveSubmitter is a constructor function for MsgRemoveSubmitter
+func NewMsgRemoveSubmitter(spaceId uint64, submitter string, sender string) *MsgRemoveSubmitter {
+	return &MsgRemoveSubmitter{
+		SpaceId:   spaceId,
+		Submitter: submitter,
+		Sender:    sender,
+	}
+}
+
+func (msg MsgRemoveSubmitter) Route() string { return RouterKey }
+
+func (msg MsgRemoveSubmitter) Type() string { return TypeMsgRemoveSubmitter }
+
+func (msg MsgRemoveSubmitter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Submitter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address (%s)", err)
+	}
+
+	return ValidateSpaceId(msg.SpaceId)
+}
+
+func (msg MsgRemoveSubmitter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveSubmitter) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgChallengeBlockHeader is a constructor function for MsgChallengeBlockHeader
+func NewMsgChallengeBlockHeader(
+	spaceId, height uint64,
+	conflictingHeader *ConflictingHeader,
+	evidence string,
+	challenger string,
+) *MsgChallengeBlockHeader {
+	return &MsgChallengeBlockHeader{
+		SpaceId:           spaceId,
+		Height:            height,
+		ConflictingHeader: conflictingHeader,
+		Evidence:          evidence,
+		Challenger:        challenger,
+	}
+}
+
+func (msg MsgChallengeBlockHeader) Route() string { return RouterKey }
+
+func (msg MsgChallengeBlockHeader) Type() string { return TypeMsgChallengeHeader }
+
+func (msg MsgChallengeBlockHeader) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid challenger address (%s)", err)
+	}
+
+	if err := ValidateSpaceId(msg.SpaceId); err != nil {
+		return err
+	}
+
+	if msg.Height == 0 {
+		return sdkerrors.Wrapf(ErrBlockHeader, "height cannot be zero")
+	}
+
+	if msg.ConflictingHeader == nil {
+		return sdkerrors.Wrapf(ErrInvalidChallenge, "conflicting header must be provided")
+	}
+
+	return msg.ConflictingHeader.Validate()
+}
+
+func (msg MsgChallengeBlockHeader) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgChallengeBlockHeader) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Challenger)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgResolveChallenge is a constructor function for MsgResolveChallenge
+func NewMsgResolveChallenge(spaceId, height uint64, upheld bool, sender string) *MsgResolveChallenge {
+	return &MsgResolveChallenge{
+		SpaceId: spaceId,
+		Height:  height,
+		Upheld:  upheld,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgResolveChallenge) Route() string { return RouterKey }
+
+func (msg MsgResolveChallenge) Type() string { return TypeMsgResolveChallenge }
+
+func (msg MsgResolveChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if err := ValidateSpaceId(msg.SpaceId); err != nil {
+		return err
+	}
+
+	if msg.Height == 0 {
+		return sdkerrors.Wrapf(ErrBlockHeader, "height cannot be zero")
+	}
+
+	return nil
+}
+
+func (msg MsgResolveChallenge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResolveChallenge) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// side-chain params default values
+const (
+	DefaultHeaderRetention    uint64 = 0         // pruning is disabled by default
+	DefaultMaxHeaderBytes     uint64 = 64 * 1024 // 64 KiB
+	DefaultMaxSpacesPerOwner  uint64 = 0         // no limit by default
+	DefaultMaxFutureHeightGap uint64 = 0         // no limit by default
+	DefaultAllowNonMonotonic         = true      // the missing headers can be filled by default
+)
+
+// side-chain params default values
+var (
+	DefaultSpaceDeposit       = sdk.Coins{} // no deposit by default
+	DefaultHeaderFee          = sdk.Coins{} // no header fee by default
+	DefaultHeaderFeeRecipient = ""          // the header fees are paid to the fee collector by default
+)
+
+// Parameter store keys
+var (
+	KeyHeaderRetention    = []byte("HeaderRetention")
+	KeySpaceDeposit       = []byte("SpaceDeposit")
+	KeyHeaderFee          = []byte("HeaderFee")
+	KeyHeaderFeeRecipient = []byte("HeaderFeeRecipient")
+	KeyMaxHeaderBytes     = []byte("MaxHeaderBytes")
+	KeyMaxSpacesPerOwner  = []byte("MaxSpacesPerOwner")
+	KeyMaxFutureHeightGap = []byte("MaxFutureHeightGap")
+	KeyAllowNonMonotonic  = []byte("AllowNonMonotonic")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new Params instance
+func NewParams(
+	headerRetention uint64,
+	spaceDeposit, headerFee sdk.Coins,
+	headerFeeRecipient string,
+	maxHeaderBytes, maxSpacesPerOwner, maxFutureHeightGap uint64,
+	allowNonMonotonic bool,
+) Params {
+	return Params{
+		HeaderRetention:    headerRetention,
+		SpaceDeposit:       spaceDeposit,
+		HeaderFee:          headerFee,
+		HeaderFeeRecipient: headerFeeRecipient,
+		MaxHeaderBytes:     maxHeaderBytes,
+		MaxSpacesPerOwner:  maxSpacesPerOwner,
+		MaxFutureHeightGap: maxFutureHeightGap,
+		AllowNonMonotonic:  allowNonMonotonic,
+	}
+}
+
+// ParamSetPairs implements paramtypes.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHeaderRetention, &p.HeaderRetention, validateHeaderRetention),
+		paramtypes.NewParamSetPair(KeySpaceDeposit, &p.SpaceDeposit, validateCoins),
+		paramtypes.NewParamSetPair(KeyHeaderFee, &p.HeaderFee, validateCoins),
+		paramtypes.NewParamSetPair(KeyHeaderFeeRecipient, &p.HeaderFeeRecipient, validateHeaderFeeRecipient),
+		paramtypes.NewParamSetPair(KeyMaxHeaderBytes, &p.MaxHeaderBytes, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxSpacesPerOwner, &p.MaxSpacesPerOwner, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxFutureHeightGap, &p.MaxFutureHeightGap, validateUint64),
+		paramtypes.NewParamSetPair(KeyAllowNonMonotonic, &p.AllowNonMonotonic, validateBool),
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(
+		DefaultHeaderRetention,
+		DefaultSpaceDeposit,
+		DefaultHeaderFee,
+		DefaultHeaderFeeRecipient,
+		DefaultMaxHeaderBytes,
+		DefaultMaxSpacesPerOwner,
+		DefaultMaxFutureHeightGap,
+		DefaultAllowNonMonotonic,
+	)
+}
+
+// Validate validates a set of params
+func (p Params) Validate() error {
+	if err := validateHeaderRetention(p.HeaderRetention); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.SpaceDeposit); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.HeaderFee); err != nil {
+		return err
+	}
+
+	if err := validateHeaderFeeRecipient(p.HeaderFeeRecipient); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MaxHeaderBytes); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MaxSpacesPerOwner); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.MaxFutureHeightGap); err != nil {
+		return err
+	}
+
+	return validateBool(p.AllowNonMonotonic)
+}
+
+func validateHeaderRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateHeaderFeeRecipient(i interface{}) error {
+	recipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(recipient) > 0 {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return fmt.Errorf("invalid header fee recipient: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
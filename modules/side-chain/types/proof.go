@@ -0,0 +1,171 @@
+package types
+
+import (
+	"crypto/sha256"
+	"hash"
+	"math/bits"
+
+	"github.com/tjfoc/gmsm/sm3"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MaxProofAunts = 100 // maximum number of the aunts of an inclusion proof
+)
+
+var (
+	leafPrefix  = []byte{0x00} // prefix of the leaf hash
+	innerPrefix = []byte{0x01} // prefix of the inner node hash
+)
+
+// Validate validates the inclusion proof
+func (p InclusionProof) Validate() error {
+	if _, ok := HashAlgorithm_name[int32(p.Algorithm)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInclusionProof, "invalid hash algorithm (%d)", p.Algorithm)
+	}
+
+	if p.Total == 0 {
+		return sdkerrors.Wrap(ErrInvalidInclusionProof, "total must be greater than 0")
+	}
+
+	if p.Index >= p.Total {
+		return sdkerrors.Wrapf(ErrInvalidInclusionProof, "index (%d) must be less than total (%d)", p.Index, p.Total)
+	}
+
+	if len(p.Aunts) > MaxProofAunts {
+		return sdkerrors.Wrapf(ErrInvalidInclusionProof, "number of aunts cannot be greater than %d", MaxProofAunts)
+	}
+
+	size := p.Algorithm.newHash().Size()
+	for _, aunt := range p.Aunts {
+		if len(aunt) != size {
+			return sdkerrors.Wrapf(ErrInvalidInclusionProof, "size of the aunt hash must be %d in bytes", size)
+		}
+	}
+
+	return nil
+}
+
+// ComputeRoot computes the Merkle root from the given leaf with the proof
+func (p InclusionProof) ComputeRoot(leaf []byte) ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	root := computeHashFromAunts(p.Algorithm, p.Index, p.Total, leafHash(p.Algorithm, leaf), p.Aunts)
+	if root == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidInclusionProof, "number of aunts mismatches the tree")
+	}
+
+	return root, nil
+}
+
+// ProofsFromLeaves computes the Merkle root of the given leaves, along with the inclusion proof of each leaf
+func ProofsFromLeaves(algorithm HashAlgorithm, leaves [][]byte) ([]byte, []InclusionProof) {
+	leafHashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		leafHashes[i] = leafHash(algorithm, leaf)
+	}
+
+	root, trails := proofTrails(algorithm, leafHashes)
+
+	proofs := make([]InclusionProof, len(leaves))
+	for i, aunts := range trails {
+		proofs[i] = InclusionProof{
+			Algorithm: algorithm,
+			Index:     uint64(i),
+			Total:     uint64(len(leaves)),
+			Aunts:     aunts,
+		}
+	}
+
+	return root, proofs
+}
+
+// newHash returns a new hash of the algorithm
+func (a HashAlgorithm) newHash() hash.Hash {
+	if a == HashAlgorithmSM3 {
+		return sm3.New()
+	}
+	return sha256.New()
+}
+
+// sum returns the hash of the concatenated data
+func (a HashAlgorithm) sum(data ...[]byte) []byte {
+	h := a.newHash()
+	for _, bz := range data {
+		h.Write(bz)
+	}
+	return h.Sum(nil)
+}
+
+func leafHash(algorithm HashAlgorithm, leaf []byte) []byte {
+	return algorithm.sum(leafPrefix, leaf)
+}
+
+func innerHash(algorithm HashAlgorithm, left, right []byte) []byte {
+	return algorithm.sum(innerPrefix, left, right)
+}
+
+// computeHashFromAunts computes the root hash recursively, where the last aunt is the sibling
+// of the top subtree. Nil is returned if the number of aunts mismatches the tree
+func computeHashFromAunts(algorithm HashAlgorithm, index, total uint64, leafHash []byte, aunts [][]byte) []byte {
+	if total == 1 {
+		if len(aunts) != 0 {
+			return nil
+		}
+		return leafHash
+	}
+
+	if len(aunts) == 0 {
+		return nil
+	}
+
+	numLeft := splitPoint(total)
+	if index < numLeft {
+		leftHash := computeHashFromAunts(algorithm, index, numLeft, leafHash, aunts[:len(aunts)-1])
+		if leftHash == nil {
+			return nil
+		}
+		return innerHash(algorithm, leftHash, aunts[len(aunts)-1])
+	}
+
+	rightHash := computeHashFromAunts(algorithm, index-numLeft, total-numLeft, leafHash, aunts[:len(aunts)-1])
+	if rightHash == nil {
+		return nil
+	}
+	return innerHash(algorithm, aunts[len(aunts)-1], rightHash)
+}
+
+// proofTrails returns the root hash of the given leaf hashes and the aunts of each leaf
+func proofTrails(algorithm HashAlgorithm, leafHashes [][]byte) ([]byte, [][][]byte) {
+	switch len(leafHashes) {
+	case 0:
+		return algorithm.sum(), nil
+	case 1:
+		return leafHashes[0], [][][]byte{{}}
+	}
+
+	numLeft := splitPoint(uint64(len(leafHashes)))
+	leftRoot, leftTrails := proofTrails(algorithm, leafHashes[:numLeft])
+	rightRoot, rightTrails := proofTrails(algorithm, leafHashes[numLeft:])
+
+	for i := range leftTrails {
+		leftTrails[i] = append(leftTrails[i], rightRoot)
+	}
+	for i := range rightTrails {
+		rightTrails[i] = append(rightTrails[i], leftRoot)
+	}
+
+	return innerHash(algorithm, leftRoot, rightRoot), append(leftTrails, rightTrails...)
+}
+
+// splitPoint returns the largest power of 2 less than the given number
+func splitPoint(n uint64) uint64 {
+	k := uint64(1) << (bits.Len64(n) - 1)
+	if k == n {
+		k >>= 1
+	}
+	return k
+}
@@ -30,6 +30,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QuerySpaceRequest is the request type for the Query/Space RPC
 type QuerySpaceRequest struct {
 	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
@@ -39,7 +121,7 @@ func (m *QuerySpaceRequest) Reset()         { *m = QuerySpaceRequest{} }
 func (m *QuerySpaceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpaceRequest) ProtoMessage()    {}
 func (*QuerySpaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{0}
+	return fileDescriptor_14da640d0a011456, []int{2}
 }
 func (m *QuerySpaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +167,7 @@ func (m *QuerySpaceResponse) Reset()         { *m = QuerySpaceResponse{} }
 func (m *QuerySpaceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpaceResponse) ProtoMessage()    {}
 func (*QuerySpaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{1}
+	return fileDescriptor_14da640d0a011456, []int{3}
 }
 func (m *QuerySpaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,7 +220,7 @@ func (m *QuerySpaceOfOwnerRequest) Reset()         { *m = QuerySpaceOfOwnerReque
 func (m *QuerySpaceOfOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpaceOfOwnerRequest) ProtoMessage()    {}
 func (*QuerySpaceOfOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{2}
+	return fileDescriptor_14da640d0a011456, []int{4}
 }
 func (m *QuerySpaceOfOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +273,7 @@ func (m *QuerySpaceOfOwnerResponse) Reset()         { *m = QuerySpaceOfOwnerResp
 func (m *QuerySpaceOfOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpaceOfOwnerResponse) ProtoMessage()    {}
 func (*QuerySpaceOfOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{3}
+	return fileDescriptor_14da640d0a011456, []int{5}
 }
 func (m *QuerySpaceOfOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,6 +316,96 @@ func (m *QuerySpaceOfOwnerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryValidatorSetRequest is the request type for the Query/ValidatorSet RPC
+type QueryValidatorSetRequest struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+}
+
+func (m *QueryValidatorSetRequest) Reset()         { *m = QueryValidatorSetRequest{} }
+func (m *QueryValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetRequest) ProtoMessage()    {}
+func (*QueryValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{6}
+}
+func (m *QueryValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetRequest.Merge(m, src)
+}
+func (m *QueryValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSetRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSetRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+// QueryValidatorSetResponse is the response type for the Query/ValidatorSet RPC
+type QueryValidatorSetResponse struct {
+	ValidatorSet ValidatorSet `protobuf:"bytes,1,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set"`
+}
+
+func (m *QueryValidatorSetResponse) Reset()         { *m = QueryValidatorSetResponse{} }
+func (m *QueryValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetResponse) ProtoMessage()    {}
+func (*QueryValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{7}
+}
+func (m *QueryValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetResponse.Merge(m, src)
+}
+func (m *QueryValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSetResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSetResponse) GetValidatorSet() ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return ValidatorSet{}
+}
+
 // QueryBlockHeaderRequest is the request type for the Query/Record RPC
 type QueryBlockHeaderRequest struct {
 	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
@@ -244,7 +416,7 @@ func (m *QueryBlockHeaderRequest) Reset()         { *m = QueryBlockHeaderRequest
 func (m *QueryBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockHeaderRequest) ProtoMessage()    {}
 func (*QueryBlockHeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{4}
+	return fileDescriptor_14da640d0a011456, []int{8}
 }
 func (m *QueryBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,15 +461,20 @@ func (m *QueryBlockHeaderRequest) GetHeight() uint64 {
 
 // QueryBlockHeaderResponse is the response type for the Query/Record RPC
 type QueryBlockHeaderResponse struct {
-	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	TxHash           string            `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Header           string            `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	StructuredHeader *StructuredHeader `protobuf:"bytes,3,opt,name=structured_header,json=structuredHeader,proto3" json:"structured_header,omitempty"`
+	Hash             string            `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status           HeaderStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=iritamod.side_chain.v1.HeaderStatus" json:"status,omitempty"`
+	FinalizeHeight   uint64            `protobuf:"varint,6,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
+	Challenge        *Challenge        `protobuf:"bytes,7,opt,name=challenge,proto3" json:"challenge,omitempty"`
 }
 
 func (m *QueryBlockHeaderResponse) Reset()         { *m = QueryBlockHeaderResponse{} }
 func (m *QueryBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockHeaderResponse) ProtoMessage()    {}
 func (*QueryBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14da640d0a011456, []int{5}
+	return fileDescriptor_14da640d0a011456, []int{9}
 }
 func (m *QueryBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,558 +517,4228 @@ func (m *QueryBlockHeaderResponse) GetHeader() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QuerySpaceRequest)(nil), "iritamod.side_chain.v1.QuerySpaceRequest")
-	proto.RegisterType((*QuerySpaceResponse)(nil), "iritamod.side_chain.v1.QuerySpaceResponse")
-	proto.RegisterType((*QuerySpaceOfOwnerRequest)(nil), "iritamod.side_chain.v1.QuerySpaceOfOwnerRequest")
-	proto.RegisterType((*QuerySpaceOfOwnerResponse)(nil), "iritamod.side_chain.v1.QuerySpaceOfOwnerResponse")
-	proto.RegisterType((*QueryBlockHeaderRequest)(nil), "iritamod.side_chain.v1.QueryBlockHeaderRequest")
-	proto.RegisterType((*QueryBlockHeaderResponse)(nil), "iritamod.side_chain.v1.QueryBlockHeaderResponse")
+func (m *QueryBlockHeaderResponse) GetStructuredHeader() *StructuredHeader {
+	if m != nil {
+		return m.StructuredHeader
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("side-chain/v1/query.proto", fileDescriptor_14da640d0a011456) }
-
-var fileDescriptor_14da640d0a011456 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8e, 0xdb, 0x26, 0xfd, 0xbb, 0xe9, 0x7f, 0x60, 0x55, 0xb5, 0x89, 0x45, 0x4d, 0x65, 0x24,
-	0xd4, 0x82, 0xf0, 0x36, 0xa9, 0x84, 0xa0, 0x5c, 0x50, 0x4e, 0x41, 0x20, 0xb5, 0x98, 0x1b, 0x97,
-	0x68, 0x13, 0x2f, 0xf6, 0xd2, 0xc4, 0xeb, 0x66, 0x37, 0xa5, 0x55, 0x95, 0x0b, 0x4f, 0x00, 0x82,
-	0x37, 0xe0, 0x0d, 0x78, 0x8a, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xc2, 0x33, 0x70, 0x46, 0x9e,
-	0xdd, 0x28, 0x0e, 0x4d, 0x48, 0xb9, 0x79, 0x76, 0xbe, 0x99, 0xef, 0x9b, 0x6f, 0x46, 0x46, 0x65,
-	0xc9, 0x03, 0x76, 0xbf, 0x15, 0x51, 0x1e, 0x93, 0x93, 0x0a, 0x39, 0xee, 0xb1, 0xee, 0x99, 0x97,
-	0x74, 0x85, 0x12, 0x78, 0x9d, 0x77, 0xb9, 0xa2, 0x1d, 0x11, 0x78, 0x29, 0xa6, 0x01, 0x18, 0xef,
-	0xa4, 0x62, 0xaf, 0x85, 0x22, 0x14, 0x00, 0x21, 0xe9, 0x97, 0x46, 0xdb, 0x37, 0x43, 0x21, 0xc2,
-	0x36, 0x23, 0x34, 0xe1, 0x84, 0xc6, 0xb1, 0x50, 0x54, 0x71, 0x11, 0x4b, 0x93, 0x75, 0x26, 0x69,
-	0xc6, 0x91, 0xc9, 0x6f, 0xb6, 0x84, 0xec, 0x08, 0xa9, 0xf9, 0x49, 0x42, 0x43, 0x1e, 0x43, 0xbd,
-	0x4e, 0xbb, 0x1e, 0xba, 0xf1, 0x22, 0xcd, 0xbc, 0x4c, 0x68, 0x8b, 0xf9, 0xec, 0xb8, 0xc7, 0xa4,
-	0xc2, 0x65, 0xf4, 0x9f, 0x4c, 0xe3, 0x06, 0x0f, 0x4a, 0xd6, 0x96, 0xb5, 0xbd, 0xe4, 0x2f, 0x43,
-	0xfc, 0x34, 0x70, 0x63, 0x84, 0xb3, 0x78, 0x99, 0x88, 0x58, 0x32, 0xbc, 0x87, 0xf2, 0x00, 0x00,
-	0x74, 0xb1, 0xba, 0xe9, 0x4d, 0x1f, 0xd0, 0xd3, 0x55, 0x1a, 0x8b, 0x6f, 0xa3, 0xff, 0xdb, 0x54,
-	0x31, 0xa9, 0x1a, 0x11, 0xe3, 0x61, 0xa4, 0x4a, 0x0b, 0x40, 0xb5, 0xaa, 0x1f, 0xeb, 0xf0, 0xe6,
-	0x1e, 0xa1, 0xd2, 0x98, 0xef, 0xe0, 0xf5, 0xc1, 0xdb, 0x98, 0x75, 0x47, 0x32, 0xd7, 0x50, 0x5e,
-	0xa4, 0x31, 0xb0, 0xae, 0xf8, 0x3a, 0xc0, 0x8f, 0x10, 0x1a, 0x4f, 0x09, 0x3d, 0x8b, 0xd5, 0xb2,
-	0xa7, 0x5d, 0xf0, 0xf4, 0x16, 0x0e, 0x69, 0x38, 0x9a, 0xd5, 0xcf, 0x80, 0xdd, 0x4f, 0x16, 0x2a,
-	0x4f, 0x61, 0x33, 0x43, 0x3e, 0x46, 0x05, 0x10, 0x2e, 0x4b, 0xd6, 0xd6, 0xe2, 0xdc, 0x29, 0x6b,
-	0x4b, 0x17, 0xdf, 0x6f, 0xe5, 0x7c, 0x53, 0x82, 0xf7, 0xa7, 0xa8, 0xb2, 0xa7, 0xa9, 0xd2, 0x64,
-	0x13, 0xb2, 0x9e, 0xa3, 0x0d, 0x50, 0x55, 0x6b, 0x8b, 0xd6, 0x51, 0x9d, 0xd1, 0x60, 0x6c, 0xc1,
-	0xec, 0x4d, 0xe1, 0x75, 0x54, 0x98, 0xf0, 0xd5, 0x44, 0xee, 0x33, 0xe3, 0xe8, 0x44, 0x37, 0x33,
-	0xe2, 0x06, 0x5a, 0x56, 0xa7, 0x8d, 0x88, 0xca, 0xc8, 0x78, 0x5a, 0x50, 0xa7, 0x75, 0x2a, 0x23,
-	0xdd, 0x2c, 0x85, 0x42, 0xb3, 0x15, 0xdf, 0x44, 0xd5, 0x5f, 0x8b, 0x28, 0x0f, 0xdd, 0xf0, 0x07,
-	0x0b, 0xe5, 0x61, 0x70, 0xbc, 0x33, 0xcb, 0x97, 0x2b, 0x87, 0x66, 0xdf, 0xbd, 0x0e, 0x54, 0x6b,
-	0x73, 0x2b, 0xef, 0xbe, 0xfe, 0xfc, 0xb8, 0x70, 0x0f, 0xef, 0x90, 0x51, 0x0d, 0xf9, 0xe3, 0xf4,
-	0xc1, 0x69, 0x72, 0x3e, 0x32, 0xa4, 0x8f, 0x3f, 0x5b, 0x68, 0x35, 0xbb, 0x4a, 0xbc, 0x3b, 0x9f,
-	0x6f, 0xf2, 0xc6, 0xec, 0xca, 0x3f, 0x54, 0x18, 0xa1, 0x1e, 0x08, 0xdd, 0xc6, 0x77, 0xe6, 0x09,
-	0x85, 0x7b, 0xed, 0xe3, 0x2f, 0x16, 0x2a, 0x66, 0x96, 0x81, 0xc9, 0x5f, 0x29, 0xaf, 0x1e, 0x81,
-	0xbd, 0x7b, 0xfd, 0x02, 0x23, 0xf1, 0x09, 0x48, 0xdc, 0xc7, 0x0f, 0x67, 0x49, 0x6c, 0xa6, 0x45,
-	0x7a, 0xc7, 0x59, 0x47, 0xc9, 0xb9, 0x3e, 0xa2, 0x7e, 0xed, 0xf0, 0x62, 0xe0, 0x58, 0x97, 0x03,
-	0xc7, 0xfa, 0x31, 0x70, 0xac, 0xf7, 0x43, 0x27, 0x77, 0x39, 0x74, 0x72, 0xdf, 0x86, 0x4e, 0xee,
-	0xd5, 0x83, 0x90, 0xab, 0xa8, 0xd7, 0xf4, 0x5a, 0xa2, 0x43, 0x9a, 0x9c, 0xc6, 0x6f, 0x38, 0xa3,
-	0x7c, 0xcc, 0xd3, 0x11, 0x41, 0xaf, 0xcd, 0x64, 0x96, 0x4f, 0x9d, 0x25, 0x4c, 0x36, 0x0b, 0xf0,
-	0x43, 0xda, 0xfb, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x48, 0x8f, 0xa2, 0xf2, 0x38, 0x05, 0x00, 0x00,
+func (m *QueryBlockHeaderResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBlockHeaderResponse) GetStatus() HeaderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HeaderStatusFinalized
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryBlockHeaderResponse) GetFinalizeHeight() uint64 {
+	if m != nil {
+		return m.FinalizeHeight
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Space queries a space.
-	Space(ctx context.Context, in *QuerySpaceRequest, opts ...grpc.CallOption) (*QuerySpaceResponse, error)
-	// SpaceOfOwner queries all spaces owned by an address.
-	SpaceOfOwner(ctx context.Context, in *QuerySpaceOfOwnerRequest, opts ...grpc.CallOption) (*QuerySpaceOfOwnerResponse, error)
-	// BlockHeader queries a side chain block header.
-	BlockHeader(ctx context.Context, in *QueryBlockHeaderRequest, opts ...grpc.CallOption) (*QueryBlockHeaderResponse, error)
+func (m *QueryBlockHeaderResponse) GetChallenge() *Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryBlockHeadersRequest is the request type for the Query/BlockHeaders RPC
+type QueryBlockHeadersRequest struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// the lowest height of the range, 0 for no limit
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the highest height of the range, 0 for no limit
+	EndHeight  uint64             `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBlockHeadersRequest) Reset()         { *m = QueryBlockHeadersRequest{} }
+func (m *QueryBlockHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockHeadersRequest) ProtoMessage()    {}
+func (*QueryBlockHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{10}
+}
+func (m *QueryBlockHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockHeadersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockHeadersRequest.Merge(m, src)
+}
+func (m *QueryBlockHeadersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockHeadersRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Space(ctx context.Context, in *QuerySpaceRequest, opts ...grpc.CallOption) (*QuerySpaceResponse, error) {
-	out := new(QuerySpaceResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/Space", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockHeadersRequest proto.InternalMessageInfo
+
+func (m *QueryBlockHeadersRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SpaceOfOwner(ctx context.Context, in *QuerySpaceOfOwnerRequest, opts ...grpc.CallOption) (*QuerySpaceOfOwnerResponse, error) {
-	out := new(QuerySpaceOfOwnerResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/SpaceOfOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlockHeadersRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BlockHeader(ctx context.Context, in *QueryBlockHeaderRequest, opts ...grpc.CallOption) (*QueryBlockHeaderResponse, error) {
-	out := new(QueryBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/BlockHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlockHeadersRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Space queries a space.
-	Space(context.Context, *QuerySpaceRequest) (*QuerySpaceResponse, error)
-	// SpaceOfOwner queries all spaces owned by an address.
-	SpaceOfOwner(context.Context, *QuerySpaceOfOwnerRequest) (*QuerySpaceOfOwnerResponse, error)
-	// BlockHeader queries a side chain block header.
-	BlockHeader(context.Context, *QueryBlockHeaderRequest) (*QueryBlockHeaderResponse, error)
+func (m *QueryBlockHeadersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryBlockHeadersResponse is the response type for the Query/BlockHeaders RPC
+type QueryBlockHeadersResponse struct {
+	BlockHeaders []BlockHeader       `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Space(ctx context.Context, req *QuerySpaceRequest) (*QuerySpaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Space not implemented")
+func (m *QueryBlockHeadersResponse) Reset()         { *m = QueryBlockHeadersResponse{} }
+func (m *QueryBlockHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockHeadersResponse) ProtoMessage()    {}
+func (*QueryBlockHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{11}
 }
-func (*UnimplementedQueryServer) SpaceOfOwner(ctx context.Context, req *QuerySpaceOfOwnerRequest) (*QuerySpaceOfOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpaceOfOwner not implemented")
+func (m *QueryBlockHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BlockHeader(ctx context.Context, req *QueryBlockHeaderRequest) (*QueryBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockHeader not implemented")
+func (m *QueryBlockHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockHeadersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBlockHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockHeadersResponse.Merge(m, src)
+}
+func (m *QueryBlockHeadersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockHeadersResponse.DiscardUnknown(m)
 }
 
-func _Query_Space_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpaceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Space(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Query/Space",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Space(ctx, req.(*QuerySpaceRequest))
+var xxx_messageInfo_QueryBlockHeadersResponse proto.InternalMessageInfo
+
+func (m *QueryBlockHeadersResponse) GetBlockHeaders() []BlockHeader {
+	if m != nil {
+		return m.BlockHeaders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SpaceOfOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpaceOfOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpaceOfOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Query/SpaceOfOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpaceOfOwner(ctx, req.(*QuerySpaceOfOwnerRequest))
+func (m *QueryBlockHeadersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryHeaderGapsRequest is the request type for the Query/HeaderGaps RPC
+type QueryHeaderGapsRequest struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+}
+
+func (m *QueryHeaderGapsRequest) Reset()         { *m = QueryHeaderGapsRequest{} }
+func (m *QueryHeaderGapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderGapsRequest) ProtoMessage()    {}
+func (*QueryHeaderGapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{12}
+}
+func (m *QueryHeaderGapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderGapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderGapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockHeader(ctx, in)
+}
+func (m *QueryHeaderGapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderGapsRequest.Merge(m, src)
+}
+func (m *QueryHeaderGapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderGapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderGapsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderGapsRequest proto.InternalMessageInfo
+
+func (m *QueryHeaderGapsRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Query/BlockHeader",
+	return 0
+}
+
+// QueryHeaderGapsResponse is the response type for the Query/HeaderGaps RPC
+type QueryHeaderGapsResponse struct {
+	FirstHeight  uint64        `protobuf:"varint,1,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	LatestHeight uint64        `protobuf:"varint,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
+	Gaps         []HeightRange `protobuf:"bytes,3,rep,name=gaps,proto3" json:"gaps"`
+}
+
+func (m *QueryHeaderGapsResponse) Reset()         { *m = QueryHeaderGapsResponse{} }
+func (m *QueryHeaderGapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderGapsResponse) ProtoMessage()    {}
+func (*QueryHeaderGapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{13}
+}
+func (m *QueryHeaderGapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderGapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderGapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockHeader(ctx, req.(*QueryBlockHeaderRequest))
+}
+func (m *QueryHeaderGapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderGapsResponse.Merge(m, src)
+}
+func (m *QueryHeaderGapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderGapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderGapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderGapsResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderGapsResponse) GetFirstHeight() uint64 {
+	if m != nil {
+		return m.FirstHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "iritamod.side_chain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Space",
-			Handler:    _Query_Space_Handler,
-		},
-		{
-			MethodName: "SpaceOfOwner",
-			Handler:    _Query_SpaceOfOwner_Handler,
-		},
-		{
-			MethodName: "BlockHeader",
-			Handler:    _Query_BlockHeader_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side-chain/v1/query.proto",
+func (m *QueryHeaderGapsResponse) GetLatestHeight() uint64 {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return 0
 }
 
-func (m *QuerySpaceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHeaderGapsResponse) GetGaps() []HeightRange {
+	if m != nil {
+		return m.Gaps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySpaceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpaceTransferRequest is the request type for the Query/SpaceTransfer RPC
+type QuerySpaceTransferRequest struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
 }
 
-func (m *QuerySpaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySpaceTransferRequest) Reset()         { *m = QuerySpaceTransferRequest{} }
+func (m *QuerySpaceTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpaceTransferRequest) ProtoMessage()    {}
+func (*QuerySpaceTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{14}
+}
+func (m *QuerySpaceTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpaceTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpaceTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpaceTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpaceTransferRequest.Merge(m, src)
+}
+func (m *QuerySpaceTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpaceTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpaceTransferRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySpaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpaceTransferRequest proto.InternalMessageInfo
+
+func (m *QuerySpaceTransferRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySpaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpaceTransferResponse is the response type for the Query/SpaceTransfer RPC
+type QuerySpaceTransferResponse struct {
+	Transfer SpaceTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
 }
 
-func (m *QuerySpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LatestHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LatestHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Space != nil {
-		{
-			size, err := m.Space.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySpaceTransferResponse) Reset()         { *m = QuerySpaceTransferResponse{} }
+func (m *QuerySpaceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpaceTransferResponse) ProtoMessage()    {}
+func (*QuerySpaceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{15}
+}
+func (m *QuerySpaceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpaceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpaceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySpaceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpaceTransferResponse.Merge(m, src)
+}
+func (m *QuerySpaceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpaceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpaceTransferResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpaceOfOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpaceTransferResponse proto.InternalMessageInfo
+
+func (m *QuerySpaceTransferResponse) GetTransfer() SpaceTransfer {
+	if m != nil {
+		return m.Transfer
 	}
-	return dAtA[:n], nil
+	return SpaceTransfer{}
 }
 
-func (m *QuerySpaceOfOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpaceTransfersRequest is the request type for the Query/SpaceTransfers RPC
+type QuerySpaceTransfersRequest struct {
+	// the recipient of the transfers, all transfers if empty
+	Recipient  string             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpaceOfOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySpaceTransfersRequest) Reset()         { *m = QuerySpaceTransfersRequest{} }
+func (m *QuerySpaceTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpaceTransfersRequest) ProtoMessage()    {}
+func (*QuerySpaceTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{16}
+}
+func (m *QuerySpaceTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpaceTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpaceTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QuerySpaceTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpaceTransfersRequest.Merge(m, src)
+}
+func (m *QuerySpaceTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpaceTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpaceTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpaceTransfersRequest proto.InternalMessageInfo
+
+func (m *QuerySpaceTransfersRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QuerySpaceOfOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpaceTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySpaceOfOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySpaceTransfersResponse is the response type for the Query/SpaceTransfers RPC
+type QuerySpaceTransfersResponse struct {
+	Transfers  []SpaceTransfer     `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySpaceOfOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySpaceTransfersResponse) Reset()         { *m = QuerySpaceTransfersResponse{} }
+func (m *QuerySpaceTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpaceTransfersResponse) ProtoMessage()    {}
+func (*QuerySpaceTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{17}
+}
+func (m *QuerySpaceTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpaceTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpaceTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Spaces) > 0 {
-		for iNdEx := len(m.Spaces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Spaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySpaceTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpaceTransfersResponse.Merge(m, src)
+}
+func (m *QuerySpaceTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpaceTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpaceTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpaceTransfersResponse proto.InternalMessageInfo
+
+func (m *QuerySpaceTransfersResponse) GetTransfers() []SpaceTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySpaceTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySubmittersRequest is the request type for the Query/Submitters RPC
+type QuerySubmittersRequest struct {
+	SpaceId    uint64             `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.SpaceId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySubmittersRequest) Reset()         { *m = QuerySubmittersRequest{} }
+func (m *QuerySubmittersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubmittersRequest) ProtoMessage()    {}
+func (*QuerySubmittersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{18}
+}
+func (m *QuerySubmittersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubmittersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubmittersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySubmittersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubmittersRequest.Merge(m, src)
+}
+func (m *QuerySubmittersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubmittersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubmittersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubmittersRequest proto.InternalMessageInfo
+
+func (m *QuerySubmittersRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySubmittersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Header) > 0 {
-		i -= len(m.Header)
-		copy(dAtA[i:], m.Header)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Header)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QuerySubmittersResponse is the response type for the Query/Submitters RPC
+type QuerySubmittersResponse struct {
+	Submitters []Submitter         `protobuf:"bytes,1,rep,name=submitters,proto3" json:"submitters"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QuerySubmittersResponse) Reset()         { *m = QuerySubmittersResponse{} }
+func (m *QuerySubmittersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubmittersResponse) ProtoMessage()    {}
+func (*QuerySubmittersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{19}
 }
-func (m *QuerySpaceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SpaceId))
+func (m *QuerySubmittersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubmittersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubmittersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySubmittersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubmittersResponse.Merge(m, src)
+}
+func (m *QuerySubmittersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubmittersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubmittersResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Space != nil {
-		l = m.Space.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.LatestHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LatestHeight))
+var xxx_messageInfo_QuerySubmittersResponse proto.InternalMessageInfo
+
+func (m *QuerySubmittersResponse) GetSubmitters() []Submitter {
+	if m != nil {
+		return m.Submitters
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySpaceOfOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySubmittersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySpaceOfOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Spaces) > 0 {
-		for _, e := range m.Spaces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryVerifyInclusionRequest is the request type for the Query/VerifyInclusion RPC
+type QueryVerifyInclusionRequest struct {
+	SpaceId  uint64   `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height   uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	RootType RootType `protobuf:"varint,3,opt,name=root_type,json=rootType,proto3,enum=iritamod.side_chain.v1.RootType" json:"root_type,omitempty"`
+	// the leaf data proved to be included
+	Leaf  []byte         `protobuf:"bytes,4,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Proof InclusionProof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *QueryVerifyInclusionRequest) Reset()         { *m = QueryVerifyInclusionRequest{} }
+func (m *QueryVerifyInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyInclusionRequest) ProtoMessage()    {}
+func (*QueryVerifyInclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{20}
+}
+func (m *QueryVerifyInclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyInclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryVerifyInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyInclusionRequest.Merge(m, src)
+}
+func (m *QueryVerifyInclusionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyInclusionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyInclusionRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyInclusionRequest) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVerifyInclusionRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovQuery(uint64(m.SpaceId))
+	return 0
+}
+
+func (m *QueryVerifyInclusionRequest) GetRootType() RootType {
+	if m != nil {
+		return m.RootType
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return RootTypeState
+}
+
+func (m *QueryVerifyInclusionRequest) GetLeaf() []byte {
+	if m != nil {
+		return m.Leaf
 	}
-	return n
+	return nil
 }
 
-func (m *QueryBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVerifyInclusionRequest) GetProof() InclusionProof {
+	if m != nil {
+		return m.Proof
 	}
-	var l int
+	return InclusionProof{}
+}
+
+// QueryVerifyInclusionResponse is the response type for the Query/VerifyInclusion RPC
+type QueryVerifyInclusionResponse struct {
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	// the hex encoded root against which the proof is verified
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// the finality status of the block header, whose roots are final only if finalized
+	Status HeaderStatus `protobuf:"varint,3,opt,name=status,proto3,enum=iritamod.side_chain.v1.HeaderStatus" json:"status,omitempty"`
+}
+
+func (m *QueryVerifyInclusionResponse) Reset()         { *m = QueryVerifyInclusionResponse{} }
+func (m *QueryVerifyInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyInclusionResponse) ProtoMessage()    {}
+func (*QueryVerifyInclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14da640d0a011456, []int{21}
+}
+func (m *QueryVerifyInclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyInclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyInclusionResponse.Merge(m, src)
+}
+func (m *QueryVerifyInclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyInclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyInclusionResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyInclusionResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *QueryVerifyInclusionResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *QueryVerifyInclusionResponse) GetStatus() HeaderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HeaderStatusFinalized
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "iritamod.side_chain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "iritamod.side_chain.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySpaceRequest)(nil), "iritamod.side_chain.v1.QuerySpaceRequest")
+	proto.RegisterType((*QuerySpaceResponse)(nil), "iritamod.side_chain.v1.QuerySpaceResponse")
+	proto.RegisterType((*QuerySpaceOfOwnerRequest)(nil), "iritamod.side_chain.v1.QuerySpaceOfOwnerRequest")
+	proto.RegisterType((*QuerySpaceOfOwnerResponse)(nil), "iritamod.side_chain.v1.QuerySpaceOfOwnerResponse")
+	proto.RegisterType((*QueryValidatorSetRequest)(nil), "iritamod.side_chain.v1.QueryValidatorSetRequest")
+	proto.RegisterType((*QueryValidatorSetResponse)(nil), "iritamod.side_chain.v1.QueryValidatorSetResponse")
+	proto.RegisterType((*QueryBlockHeaderRequest)(nil), "iritamod.side_chain.v1.QueryBlockHeaderRequest")
+	proto.RegisterType((*QueryBlockHeaderResponse)(nil), "iritamod.side_chain.v1.QueryBlockHeaderResponse")
+	proto.RegisterType((*QueryBlockHeadersRequest)(nil), "iritamod.side_chain.v1.QueryBlockHeadersRequest")
+	proto.RegisterType((*QueryBlockHeadersResponse)(nil), "iritamod.side_chain.v1.QueryBlockHeadersResponse")
+	proto.RegisterType((*QueryHeaderGapsRequest)(nil), "iritamod.side_chain.v1.QueryHeaderGapsRequest")
+	proto.RegisterType((*QueryHeaderGapsResponse)(nil), "iritamod.side_chain.v1.QueryHeaderGapsResponse")
+	proto.RegisterType((*QuerySpaceTransferRequest)(nil), "iritamod.side_chain.v1.QuerySpaceTransferRequest")
+	proto.RegisterType((*QuerySpaceTransferResponse)(nil), "iritamod.side_chain.v1.QuerySpaceTransferResponse")
+	proto.RegisterType((*QuerySpaceTransfersRequest)(nil), "iritamod.side_chain.v1.QuerySpaceTransfersRequest")
+	proto.RegisterType((*QuerySpaceTransfersResponse)(nil), "iritamod.side_chain.v1.QuerySpaceTransfersResponse")
+	proto.RegisterType((*QuerySubmittersRequest)(nil), "iritamod.side_chain.v1.QuerySubmittersRequest")
+	proto.RegisterType((*QuerySubmittersResponse)(nil), "iritamod.side_chain.v1.QuerySubmittersResponse")
+	proto.RegisterType((*QueryVerifyInclusionRequest)(nil), "iritamod.side_chain.v1.QueryVerifyInclusionRequest")
+	proto.RegisterType((*QueryVerifyInclusionResponse)(nil), "iritamod.side_chain.v1.QueryVerifyInclusionResponse")
+}
+
+func init() { proto.RegisterFile("side-chain/v1/query.proto", fileDescriptor_14da640d0a011456) }
+
+var fileDescriptor_14da640d0a011456 = []byte{
+	// 1350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x36, 0xb6, 0x1b, 0x3f, 0x3b, 0x29, 0x1d, 0xaa, 0xd6, 0x59, 0x5a, 0x93, 0x6c, 0xa1,
+	0xa4, 0xad, 0xd8, 0x8d, 0x9d, 0x12, 0xa0, 0xb4, 0x02, 0x19, 0xa4, 0xb6, 0x08, 0xd1, 0xb0, 0x29,
+	0x3d, 0x70, 0xb1, 0x26, 0xf6, 0xd8, 0x5e, 0xd5, 0xde, 0xd9, 0xee, 0x8c, 0x43, 0x43, 0xd5, 0x0b,
+	0x37, 0x24, 0x0e, 0x20, 0x38, 0x23, 0x24, 0x8a, 0x38, 0xc0, 0x85, 0xfe, 0x8a, 0x1e, 0x2b, 0x71,
+	0xe1, 0x54, 0xa1, 0x14, 0x89, 0xbf, 0x81, 0x76, 0x66, 0xd6, 0x5e, 0xc7, 0xbb, 0xf6, 0x3a, 0xb9,
+	0xed, 0xbc, 0x79, 0xdf, 0x7b, 0xdf, 0x7b, 0x33, 0xef, 0xcd, 0xb3, 0x61, 0x99, 0x39, 0x4d, 0xf2,
+	0x66, 0xa3, 0x83, 0x1d, 0xd7, 0xda, 0xad, 0x58, 0xf7, 0xfb, 0xc4, 0xdf, 0x33, 0x3d, 0x9f, 0x72,
+	0x8a, 0x4e, 0x3b, 0xbe, 0xc3, 0x71, 0x8f, 0x36, 0xcd, 0x40, 0xa7, 0x2e, 0x74, 0xcc, 0xdd, 0x8a,
+	0x7e, 0xaa, 0x4d, 0xdb, 0x54, 0xa8, 0x58, 0xc1, 0x97, 0xd4, 0xd6, 0xcf, 0xb6, 0x29, 0x6d, 0x77,
+	0x89, 0x85, 0x3d, 0xc7, 0xc2, 0xae, 0x4b, 0x39, 0xe6, 0x0e, 0x75, 0x99, 0xda, 0x2d, 0x8f, 0xba,
+	0x19, 0xae, 0xd4, 0xfe, 0xb9, 0x06, 0x65, 0x3d, 0xca, 0xa4, 0x7f, 0xcb, 0xc3, 0x6d, 0xc7, 0x15,
+	0x78, 0xb9, 0x6d, 0x9c, 0x02, 0xf4, 0x59, 0xb0, 0xb3, 0x85, 0x7d, 0xdc, 0x63, 0x36, 0xb9, 0xdf,
+	0x27, 0x8c, 0x1b, 0xdb, 0xf0, 0xf2, 0x88, 0x94, 0x79, 0xd4, 0x65, 0x04, 0x5d, 0x83, 0x9c, 0x27,
+	0x24, 0x25, 0x6d, 0x45, 0x5b, 0x2b, 0x54, 0xcb, 0x66, 0x7c, 0x20, 0xa6, 0xc4, 0xd5, 0x32, 0x4f,
+	0x9f, 0xbf, 0x3a, 0x67, 0x2b, 0x8c, 0x61, 0xc2, 0x49, 0x61, 0x74, 0xdb, 0xc3, 0x0d, 0xa2, 0x3c,
+	0xa1, 0x65, 0x58, 0x60, 0xc1, 0xba, 0xee, 0x34, 0x85, 0xd1, 0x8c, 0x7d, 0x5c, 0xac, 0x6f, 0x35,
+	0x0d, 0x17, 0x50, 0x54, 0x5f, 0x71, 0xd8, 0x80, 0xac, 0x50, 0x50, 0x14, 0xce, 0x25, 0x51, 0x90,
+	0x28, 0xa9, 0x8b, 0xce, 0xc3, 0x62, 0x17, 0x73, 0xc2, 0x78, 0xbd, 0x43, 0x9c, 0x76, 0x87, 0x97,
+	0x8e, 0x09, 0x57, 0x45, 0x29, 0xbc, 0x29, 0x64, 0xc6, 0x3d, 0x28, 0x0d, 0xfd, 0xdd, 0x6e, 0xdd,
+	0xfe, 0xd2, 0x25, 0x7e, 0x48, 0xf3, 0x14, 0x64, 0x69, 0xb0, 0x16, 0x5e, 0xf3, 0xb6, 0x5c, 0xa0,
+	0x77, 0x01, 0x86, 0x09, 0x15, 0x36, 0x0b, 0xd5, 0x65, 0x53, 0x26, 0xdc, 0x94, 0x07, 0xbe, 0x85,
+	0xdb, 0x61, 0xac, 0x76, 0x44, 0xd9, 0xf8, 0x51, 0x83, 0xe5, 0x18, 0x6f, 0x2a, 0xc8, 0xf7, 0x20,
+	0x27, 0x88, 0x07, 0x89, 0x9e, 0x9f, 0x1a, 0x65, 0x98, 0x67, 0x09, 0x41, 0x57, 0x63, 0x58, 0xe9,
+	0x71, 0xac, 0xa4, 0xb3, 0x11, 0x5a, 0x6f, 0xa9, 0x1c, 0xdc, 0xc5, 0x5d, 0xa7, 0x89, 0x39, 0xf5,
+	0xb7, 0x09, 0x4f, 0x71, 0x54, 0x5d, 0x58, 0x8e, 0x81, 0xa9, 0x60, 0x6e, 0xc3, 0xe2, 0x6e, 0x28,
+	0xaf, 0x33, 0xc2, 0xd5, 0xc9, 0xbd, 0x96, 0x14, 0x53, 0xd4, 0x88, 0x0a, 0xad, 0xb8, 0x1b, 0x91,
+	0x19, 0x9f, 0xc0, 0x19, 0xe1, 0xad, 0xd6, 0xa5, 0x8d, 0x7b, 0x37, 0x09, 0x6e, 0x12, 0x7f, 0x3a,
+	0x47, 0x74, 0x1a, 0x72, 0x23, 0x87, 0xaf, 0x56, 0xc6, 0xf3, 0x63, 0x50, 0x1a, 0x37, 0xa7, 0xb8,
+	0x9f, 0x81, 0xe3, 0xfc, 0x41, 0xbd, 0x83, 0x59, 0x47, 0x9d, 0x7c, 0x8e, 0x3f, 0xb8, 0x89, 0x59,
+	0x47, 0x5a, 0x0b, 0x54, 0x85, 0xb5, 0xbc, 0xad, 0x56, 0xe8, 0x73, 0x38, 0xc9, 0xb8, 0xdf, 0x6f,
+	0xf0, 0xbe, 0x4f, 0x9a, 0x75, 0xa5, 0x32, 0x2f, 0x02, 0x5e, 0x4b, 0x3c, 0xc4, 0x01, 0x40, 0x79,
+	0x7f, 0x89, 0x1d, 0x90, 0x20, 0x04, 0x19, 0x41, 0x22, 0x23, 0x9c, 0x89, 0xef, 0xa0, 0x1a, 0x19,
+	0xc7, 0xbc, 0xcf, 0x4a, 0xd9, 0x15, 0x6d, 0x6d, 0x29, 0x39, 0xa1, 0xd2, 0xc6, 0xb6, 0xd0, 0xb5,
+	0x15, 0x06, 0xbd, 0x01, 0x27, 0x5a, 0x8e, 0x8b, 0xbb, 0xce, 0x57, 0x24, 0x2c, 0x8a, 0x9c, 0xc8,
+	0xcb, 0x52, 0x28, 0x96, 0x65, 0x81, 0xde, 0x87, 0x7c, 0xa3, 0x83, 0xbb, 0x5d, 0xe2, 0xb6, 0x49,
+	0xe9, 0xb8, 0x88, 0x64, 0x35, 0xc9, 0xd3, 0x87, 0xa1, 0xa2, 0x3d, 0xc4, 0x18, 0x4f, 0xb4, 0xf1,
+	0x04, 0xb3, 0x14, 0x07, 0xb6, 0x0a, 0x45, 0xc6, 0xb1, 0x7f, 0xa0, 0x66, 0x0b, 0x42, 0xa6, 0xb8,
+	0x9d, 0x03, 0x20, 0x6e, 0x33, 0x54, 0x98, 0x17, 0x0a, 0x79, 0xe2, 0x36, 0xd5, 0xf6, 0x68, 0x7d,
+	0x66, 0x66, 0xa9, 0xcf, 0xdf, 0xc2, 0xfa, 0x1c, 0x25, 0xad, 0xae, 0xc5, 0xa7, 0xb0, 0xb8, 0x13,
+	0xc8, 0xd5, 0x01, 0x87, 0x65, 0x7a, 0x3e, 0x29, 0x2f, 0x11, 0x23, 0xe1, 0x8d, 0xde, 0x89, 0xd8,
+	0x3d, 0x52, 0xc9, 0x6e, 0xc0, 0x69, 0x41, 0x54, 0xda, 0xba, 0x81, 0xbd, 0x14, 0xb9, 0x35, 0x7e,
+	0xd6, 0xe0, 0xcc, 0x18, 0x4a, 0x05, 0xb7, 0x0a, 0xc5, 0x96, 0xe3, 0x0f, 0x7b, 0xa5, 0x84, 0x16,
+	0x84, 0x4c, 0x25, 0x36, 0x4d, 0x3f, 0x45, 0xd7, 0x21, 0xd3, 0xc6, 0x1e, 0x2b, 0xcd, 0x4f, 0xce,
+	0x8d, 0xd4, 0xb6, 0xb1, 0xdb, 0x0e, 0x1b, 0x99, 0x80, 0x19, 0x9b, 0xd1, 0x06, 0x79, 0xc7, 0xc7,
+	0x2e, 0x6b, 0xa5, 0xa9, 0x73, 0x83, 0x80, 0x1e, 0x87, 0x53, 0xc1, 0xdd, 0x80, 0x05, 0xae, 0x64,
+	0xaa, 0x0f, 0xbd, 0x3e, 0xb1, 0xb7, 0x86, 0x06, 0x14, 0xb5, 0x01, 0xd8, 0xe8, 0xc7, 0xb9, 0x19,
+	0xa4, 0xfe, 0x2c, 0xe4, 0x7d, 0xd2, 0x70, 0x3c, 0x87, 0xb8, 0x5c, 0x75, 0x8e, 0xa1, 0xe0, 0x28,
+	0xef, 0xc6, 0x63, 0x0d, 0x5e, 0x89, 0xf5, 0xab, 0xe2, 0xbb, 0x05, 0xf9, 0x90, 0x62, 0x78, 0x2b,
+	0x67, 0x0a, 0x70, 0x88, 0x3e, 0xd2, 0xa5, 0x74, 0xd5, 0xa5, 0xdc, 0xee, 0xef, 0xf4, 0x1c, 0xce,
+	0xd3, 0x15, 0xfc, 0x11, 0xd2, 0xf2, 0x53, 0x78, 0x9f, 0xa3, 0x0e, 0x07, 0x47, 0x0e, 0x6c, 0x20,
+	0x55, 0x39, 0x49, 0xec, 0x60, 0x03, 0xbc, 0xca, 0x47, 0x04, 0x7a, 0xa4, 0x84, 0xfc, 0x17, 0x9e,
+	0xdb, 0x5d, 0xe2, 0x3b, 0xad, 0xbd, 0x5b, 0x6e, 0xa3, 0xdb, 0x67, 0x0e, 0x75, 0x0f, 0xff, 0x70,
+	0xa1, 0xeb, 0x90, 0xf7, 0x29, 0xe5, 0x75, 0xbe, 0xe7, 0x11, 0xd1, 0xfb, 0x96, 0xaa, 0x2b, 0x49,
+	0x61, 0xd9, 0x94, 0xf2, 0x3b, 0x7b, 0x1e, 0xb1, 0x17, 0x7c, 0xf5, 0x15, 0x3c, 0x29, 0x5d, 0x82,
+	0x5b, 0xa2, 0x2d, 0x16, 0x6d, 0xf1, 0x8d, 0x6a, 0x90, 0xf5, 0x7c, 0x4a, 0x5b, 0xe2, 0x45, 0x29,
+	0x54, 0x2f, 0x24, 0x99, 0x1b, 0xd0, 0xdf, 0x0a, 0xb4, 0x55, 0xaa, 0x24, 0xd4, 0xf8, 0x56, 0x83,
+	0xb3, 0xf1, 0x91, 0xaa, 0xf3, 0xd0, 0x61, 0x61, 0x37, 0xd8, 0x72, 0x88, 0x0c, 0x75, 0xc1, 0x1e,
+	0xac, 0x03, 0x52, 0x01, 0x41, 0xf5, 0xa8, 0x8a, 0xef, 0xc8, 0x3b, 0x37, 0x3f, 0xfb, 0x3b, 0x57,
+	0xdd, 0x5f, 0x82, 0xac, 0xa0, 0x83, 0xbe, 0xd1, 0x20, 0x27, 0x07, 0x53, 0x74, 0x29, 0xc9, 0xc4,
+	0xf8, 0x2c, 0xac, 0x5f, 0x4e, 0xa5, 0x2b, 0x63, 0x33, 0x2e, 0x7c, 0xfd, 0xd7, 0xbf, 0x3f, 0x1c,
+	0x5b, 0x41, 0x65, 0x2b, 0x04, 0x59, 0xa3, 0xf3, 0xb9, 0x9c, 0x85, 0xd1, 0xf7, 0x1a, 0x64, 0x45,
+	0xf9, 0xa1, 0x8b, 0x13, 0xcd, 0x47, 0x67, 0x65, 0xfd, 0x52, 0x1a, 0x55, 0x45, 0xa4, 0x22, 0x88,
+	0x5c, 0x46, 0x17, 0x93, 0x88, 0xc8, 0x61, 0xd1, 0x7a, 0x18, 0xde, 0xba, 0x47, 0xe8, 0x17, 0x0d,
+	0x8a, 0xd1, 0x69, 0x14, 0xad, 0x4f, 0xf7, 0x37, 0x3a, 0x26, 0xeb, 0x95, 0x19, 0x10, 0x8a, 0xa8,
+	0x29, 0x88, 0xae, 0xa1, 0x0b, 0xd3, 0x88, 0x8a, 0x91, 0xfb, 0x11, 0x7a, 0xa2, 0xc1, 0xe2, 0x48,
+	0xe3, 0x42, 0x29, 0x9c, 0x1e, 0x78, 0x3e, 0xf4, 0xea, 0x2c, 0x10, 0x45, 0xf4, 0xaa, 0x20, 0x7a,
+	0x05, 0x55, 0x53, 0x67, 0xd4, 0x0a, 0x7b, 0x29, 0x7a, 0xac, 0xc1, 0xd2, 0x88, 0x55, 0x86, 0x66,
+	0xa0, 0x30, 0xb8, 0x8a, 0x1b, 0x33, 0x61, 0x14, 0xef, 0x8b, 0x82, 0xf7, 0x79, 0xb4, 0x9a, 0xc4,
+	0x7b, 0xd8, 0xf1, 0x7f, 0xd7, 0x00, 0x86, 0x0d, 0x14, 0x99, 0x93, 0xdd, 0x1d, 0x6c, 0xed, 0xba,
+	0x95, 0x5a, 0x5f, 0x51, 0xbb, 0x26, 0xa8, 0x6d, 0xa2, 0x2b, 0xe9, 0x53, 0x1a, 0x69, 0xc7, 0x7f,
+	0x6a, 0x50, 0x8c, 0xfe, 0x56, 0x98, 0x72, 0x5f, 0x63, 0x7e, 0xd2, 0xe8, 0x95, 0x19, 0x10, 0x87,
+	0xe7, 0x3c, 0xf8, 0xf1, 0xc2, 0x82, 0xdb, 0x5b, 0x88, 0x0c, 0x83, 0x68, 0x72, 0xca, 0xc6, 0x7f,
+	0xe0, 0xe8, 0xeb, 0xe9, 0x01, 0x8a, 0xf0, 0x07, 0x82, 0xf0, 0x55, 0xf4, 0x4e, 0x12, 0x61, 0x31,
+	0x89, 0xaa, 0x41, 0x36, 0x4a, 0xfb, 0xa1, 0x7c, 0x67, 0x1e, 0xa1, 0x3f, 0x34, 0x28, 0xd6, 0xa2,
+	0xe3, 0x6a, 0x6a, 0x12, 0x2c, 0x5d, 0xa2, 0xe3, 0x66, 0x6c, 0xe3, 0x6d, 0xc1, 0xbb, 0x82, 0xac,
+	0x19, 0x79, 0xa3, 0x5f, 0x35, 0x80, 0xe1, 0x58, 0x3b, 0xe5, 0x16, 0x8f, 0x4d, 0xcd, 0xba, 0x95,
+	0x5a, 0x5f, 0x11, 0xdd, 0x14, 0x44, 0xd7, 0x91, 0x99, 0xfe, 0x46, 0x04, 0x03, 0x2e, 0x7a, 0xaa,
+	0xc1, 0x89, 0x03, 0x6f, 0x24, 0x9a, 0x5c, 0xe1, 0xf1, 0xb3, 0x83, 0x7e, 0x65, 0x36, 0x90, 0xa2,
+	0xfd, 0xb1, 0xa0, 0xfd, 0x11, 0xaa, 0x1d, 0xf6, 0x5e, 0x58, 0x4e, 0x68, 0xb3, 0xb6, 0xf5, 0x74,
+	0xbf, 0xac, 0x3d, 0xdb, 0x2f, 0x6b, 0xff, 0xec, 0x97, 0xb5, 0xef, 0x5e, 0x94, 0xe7, 0x9e, 0xbd,
+	0x28, 0xcf, 0xfd, 0xfd, 0xa2, 0x3c, 0xf7, 0xc5, 0x66, 0xdb, 0xe1, 0x9d, 0xfe, 0x8e, 0xd9, 0xa0,
+	0x3d, 0x0b, 0xe3, 0x66, 0xc7, 0x59, 0xdf, 0xac, 0x44, 0x3a, 0x68, 0x8f, 0x36, 0xfb, 0x5d, 0xc2,
+	0xa2, 0x9e, 0x83, 0x71, 0x86, 0xed, 0xe4, 0xc4, 0xdf, 0x53, 0x1b, 0xff, 0x0f, 0x00, 0x8e, 0xe1,
+	0x53, 0x01, 0x46, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the side-chain module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Space queries a space.
+	Space(ctx context.Context, in *QuerySpaceRequest, opts ...grpc.CallOption) (*QuerySpaceResponse, error)
+	// SpaceOfOwner queries all spaces owned by an address.
+	SpaceOfOwner(ctx context.Context, in *QuerySpaceOfOwnerRequest, opts ...grpc.CallOption) (*QuerySpaceOfOwnerResponse, error)
+	// SpaceTransfer queries the pending transfer of a space.
+	SpaceTransfer(ctx context.Context, in *QuerySpaceTransferRequest, opts ...grpc.CallOption) (*QuerySpaceTransferResponse, error)
+	// SpaceTransfers queries the pending space transfers, optionally to a recipient.
+	SpaceTransfers(ctx context.Context, in *QuerySpaceTransfersRequest, opts ...grpc.CallOption) (*QuerySpaceTransfersResponse, error)
+	// Submitters queries the block header submitters of a space.
+	Submitters(ctx context.Context, in *QuerySubmittersRequest, opts ...grpc.CallOption) (*QuerySubmittersResponse, error)
+	// ValidatorSet queries the validator set of the side chain in a space.
+	ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error)
+	// BlockHeader queries a side chain block header.
+	BlockHeader(ctx context.Context, in *QueryBlockHeaderRequest, opts ...grpc.CallOption) (*QueryBlockHeaderResponse, error)
+	// BlockHeaders queries the side chain block headers of a space in the height range.
+	BlockHeaders(ctx context.Context, in *QueryBlockHeadersRequest, opts ...grpc.CallOption) (*QueryBlockHeadersResponse, error)
+	// HeaderGaps queries the missing heights between the first and the latest block header of a space.
+	HeaderGaps(ctx context.Context, in *QueryHeaderGapsRequest, opts ...grpc.CallOption) (*QueryHeaderGapsResponse, error)
+	// VerifyInclusion verifies a Merkle inclusion proof against the root of a side chain block header.
+	VerifyInclusion(ctx context.Context, in *QueryVerifyInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyInclusionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Space(ctx context.Context, in *QuerySpaceRequest, opts ...grpc.CallOption) (*QuerySpaceResponse, error) {
+	out := new(QuerySpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/Space", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpaceOfOwner(ctx context.Context, in *QuerySpaceOfOwnerRequest, opts ...grpc.CallOption) (*QuerySpaceOfOwnerResponse, error) {
+	out := new(QuerySpaceOfOwnerResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/SpaceOfOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpaceTransfer(ctx context.Context, in *QuerySpaceTransferRequest, opts ...grpc.CallOption) (*QuerySpaceTransferResponse, error) {
+	out := new(QuerySpaceTransferResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/SpaceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpaceTransfers(ctx context.Context, in *QuerySpaceTransfersRequest, opts ...grpc.CallOption) (*QuerySpaceTransfersResponse, error) {
+	out := new(QuerySpaceTransfersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/SpaceTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Submitters(ctx context.Context, in *QuerySubmittersRequest, opts ...grpc.CallOption) (*QuerySubmittersResponse, error) {
+	out := new(QuerySubmittersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/Submitters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error) {
+	out := new(QueryValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/ValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockHeader(ctx context.Context, in *QueryBlockHeaderRequest, opts ...grpc.CallOption) (*QueryBlockHeaderResponse, error) {
+	out := new(QueryBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/BlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockHeaders(ctx context.Context, in *QueryBlockHeadersRequest, opts ...grpc.CallOption) (*QueryBlockHeadersResponse, error) {
+	out := new(QueryBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/BlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeaderGaps(ctx context.Context, in *QueryHeaderGapsRequest, opts ...grpc.CallOption) (*QueryHeaderGapsResponse, error) {
+	out := new(QueryHeaderGapsResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/HeaderGaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyInclusion(ctx context.Context, in *QueryVerifyInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyInclusionResponse, error) {
+	out := new(QueryVerifyInclusionResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Query/VerifyInclusion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the side-chain module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Space queries a space.
+	Space(context.Context, *QuerySpaceRequest) (*QuerySpaceResponse, error)
+	// SpaceOfOwner queries all spaces owned by an address.
+	SpaceOfOwner(context.Context, *QuerySpaceOfOwnerRequest) (*QuerySpaceOfOwnerResponse, error)
+	// SpaceTransfer queries the pending transfer of a space.
+	SpaceTransfer(context.Context, *QuerySpaceTransferRequest) (*QuerySpaceTransferResponse, error)
+	// SpaceTransfers queries the pending space transfers, optionally to a recipient.
+	SpaceTransfers(context.Context, *QuerySpaceTransfersRequest) (*QuerySpaceTransfersResponse, error)
+	// Submitters queries the block header submitters of a space.
+	Submitters(context.Context, *QuerySubmittersRequest) (*QuerySubmittersResponse, error)
+	// ValidatorSet queries the validator set of the side chain in a space.
+	ValidatorSet(context.Context, *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error)
+	// BlockHeader queries a side chain block header.
+	BlockHeader(context.Context, *QueryBlockHeaderRequest) (*QueryBlockHeaderResponse, error)
+	// BlockHeaders queries the side chain block headers of a space in the height range.
+	BlockHeaders(context.Context, *QueryBlockHeadersRequest) (*QueryBlockHeadersResponse, error)
+	// HeaderGaps queries the missing heights between the first and the latest block header of a space.
+	HeaderGaps(context.Context, *QueryHeaderGapsRequest) (*QueryHeaderGapsResponse, error)
+	// VerifyInclusion verifies a Merkle inclusion proof against the root of a side chain block header.
+	VerifyInclusion(context.Context, *QueryVerifyInclusionRequest) (*QueryVerifyInclusionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Space(ctx context.Context, req *QuerySpaceRequest) (*QuerySpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Space not implemented")
+}
+func (*UnimplementedQueryServer) SpaceOfOwner(ctx context.Context, req *QuerySpaceOfOwnerRequest) (*QuerySpaceOfOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpaceOfOwner not implemented")
+}
+func (*UnimplementedQueryServer) SpaceTransfer(ctx context.Context, req *QuerySpaceTransferRequest) (*QuerySpaceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpaceTransfer not implemented")
+}
+func (*UnimplementedQueryServer) SpaceTransfers(ctx context.Context, req *QuerySpaceTransfersRequest) (*QuerySpaceTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpaceTransfers not implemented")
+}
+func (*UnimplementedQueryServer) Submitters(ctx context.Context, req *QuerySubmittersRequest) (*QuerySubmittersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submitters not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+}
+func (*UnimplementedQueryServer) BlockHeader(ctx context.Context, req *QueryBlockHeaderRequest) (*QueryBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockHeader not implemented")
+}
+func (*UnimplementedQueryServer) BlockHeaders(ctx context.Context, req *QueryBlockHeadersRequest) (*QueryBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockHeaders not implemented")
+}
+func (*UnimplementedQueryServer) HeaderGaps(ctx context.Context, req *QueryHeaderGapsRequest) (*QueryHeaderGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderGaps not implemented")
+}
+func (*UnimplementedQueryServer) VerifyInclusion(ctx context.Context, req *QueryVerifyInclusionRequest) (*QueryVerifyInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyInclusion not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Space_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Space(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/Space",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Space(ctx, req.(*QuerySpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpaceOfOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpaceOfOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpaceOfOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/SpaceOfOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpaceOfOwner(ctx, req.(*QuerySpaceOfOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpaceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpaceTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpaceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/SpaceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpaceTransfer(ctx, req.(*QuerySpaceTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpaceTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpaceTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpaceTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/SpaceTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpaceTransfers(ctx, req.(*QuerySpaceTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Submitters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubmittersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Submitters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/Submitters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Submitters(ctx, req.(*QuerySubmittersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/ValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/BlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockHeader(ctx, req.(*QueryBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockHeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/BlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockHeaders(ctx, req.(*QueryBlockHeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeaderGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/HeaderGaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderGaps(ctx, req.(*QueryHeaderGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Query/VerifyInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyInclusion(ctx, req.(*QueryVerifyInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iritamod.side_chain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Space",
+			Handler:    _Query_Space_Handler,
+		},
+		{
+			MethodName: "SpaceOfOwner",
+			Handler:    _Query_SpaceOfOwner_Handler,
+		},
+		{
+			MethodName: "SpaceTransfer",
+			Handler:    _Query_SpaceTransfer_Handler,
+		},
+		{
+			MethodName: "SpaceTransfers",
+			Handler:    _Query_SpaceTransfers_Handler,
+		},
+		{
+			MethodName: "Submitters",
+			Handler:    _Query_Submitters_Handler,
+		},
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _Query_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "BlockHeader",
+			Handler:    _Query_BlockHeader_Handler,
+		},
+		{
+			MethodName: "BlockHeaders",
+			Handler:    _Query_BlockHeaders_Handler,
+		},
+		{
+			MethodName: "HeaderGaps",
+			Handler:    _Query_HeaderGaps_Handler,
+		},
+		{
+			MethodName: "VerifyInclusion",
+			Handler:    _Query_VerifyInclusion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side-chain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Space != nil {
+		{
+			size, err := m.Space.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceOfOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceOfOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceOfOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceOfOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceOfOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceOfOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spaces) > 0 {
+		for iNdEx := len(m.Spaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Challenge != nil {
+		{
+			size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FinalizeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizeHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StructuredHeader != nil {
+		{
+			size, err := m.StructuredHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeadersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeadersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeadersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderGapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderGapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderGapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderGapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderGapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderGapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gaps) > 0 {
+		for iNdEx := len(m.Gaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LatestHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpaceTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpaceTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpaceTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubmittersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubmittersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubmittersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubmittersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubmittersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubmittersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitters) > 0 {
+		for iNdEx := len(m.Submitters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submitters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Leaf) > 0 {
+		i -= len(m.Leaf)
+		copy(dAtA[i:], m.Leaf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Leaf)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RootType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RootType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	return n
+}
+
+func (m *QuerySpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Space != nil {
+		l = m.Space.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LatestHeight))
+	}
+	return n
+}
+
+func (m *QuerySpaceOfOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpaceOfOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spaces) > 0 {
+		for _, e := range m.Spaces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.TxHash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Header)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StructuredHeader != nil {
+		l = m.StructuredHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.FinalizeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizeHeight))
+	}
+	if m.Challenge != nil {
+		l = m.Challenge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeadersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderGapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	return n
+}
+
+func (m *QueryHeaderGapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstHeight))
+	}
+	if m.LatestHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LatestHeight))
+	}
+	if len(m.Gaps) > 0 {
+		for _, e := range m.Gaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpaceTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	return n
+}
+
+func (m *QuerySpaceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySpaceTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpaceTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubmittersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubmittersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submitters) > 0 {
+		for _, e := range m.Submitters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovQuery(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.RootType != 0 {
+		n += 1 + sovQuery(uint64(m.RootType))
+	}
+	l = len(m.Leaf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVerifyInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Space == nil {
+				m.Space = &Space{}
+			}
+			if err := m.Space.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			m.LatestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceOfOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceOfOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceOfOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceOfOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceOfOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceOfOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spaces = append(m.Spaces, Space{})
+			if err := m.Spaces[len(m.Spaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredHeader == nil {
+				m.StructuredHeader = &StructuredHeader{}
+			}
+			if err := m.StructuredHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HeaderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeHeight", wireType)
+			}
+			m.FinalizeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Challenge == nil {
+				m.Challenge = &Challenge{}
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeadersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeadersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHeaders = append(m.BlockHeaders, BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderGapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderGapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderGapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderGapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderGapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderGapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
+			}
+			m.FirstHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			m.LatestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gaps = append(m.Gaps, HeightRange{})
+			if err := m.Gaps[len(m.Gaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpaceTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpaceTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpaceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySpaceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpaceTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -914,17 +4761,17 @@ func (m *QuerySpaceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpaceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpaceTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpaceTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			m.SpaceId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -934,11 +4781,60 @@ func (m *QuerySpaceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -960,7 +4856,7 @@ func (m *QuerySpaceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpaceTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -983,15 +4879,15 @@ func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpaceTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpaceTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Space", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1018,18 +4914,16 @@ func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Space == nil {
-				m.Space = &Space{}
-			}
-			if err := m.Space.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, SpaceTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.LatestHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1039,11 +4933,28 @@ func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1065,7 +4976,7 @@ func (m *QuerySpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpaceOfOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubmittersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1088,17 +4999,17 @@ func (m *QuerySpaceOfOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpaceOfOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubmittersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpaceOfOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubmittersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			var stringLen uint64
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1108,24 +5019,11 @@ func (m *QuerySpaceOfOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -1183,7 +5081,7 @@ func (m *QuerySpaceOfOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpaceOfOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubmittersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,15 +5104,15 @@ func (m *QuerySpaceOfOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpaceOfOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubmittersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpaceOfOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubmittersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1241,8 +5139,8 @@ func (m *QuerySpaceOfOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spaces = append(m.Spaces, Space{})
-			if err := m.Spaces[len(m.Spaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Submitters = append(m.Submitters, Submitter{})
+			if err := m.Submitters[len(m.Submitters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1303,7 +5201,7 @@ func (m *QuerySpaceOfOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyInclusionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,10 +5224,10 @@ func (m *QueryBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyInclusionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1370,6 +5268,92 @@ func (m *QueryBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootType", wireType)
+			}
+			m.RootType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RootType |= RootType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaf", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaf = append(m.Leaf[:0], dAtA[iNdEx:postIndex]...)
+			if m.Leaf == nil {
+				m.Leaf = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1391,7 +5375,7 @@ func (m *QueryBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyInclusionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,17 +5398,17 @@ func (m *QueryBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyInclusionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1434,27 +5418,15 @@ func (m *QueryBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Verified = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1482,8 +5454,27 @@ func (m *QueryBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Header = string(dAtA[iNdEx:postIndex])
+			m.Root = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HeaderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
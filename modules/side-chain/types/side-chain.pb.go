@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,12 +29,137 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SpaceStatus defines the lifecycle status of a space
+type SpaceStatus int32
+
+const (
+	// SPACE_STATUS_ACTIVE defines an active space accepting the block headers
+	SpaceStatusActive SpaceStatus = 0
+	// SPACE_STATUS_FROZEN defines a space rejecting the block headers until unfrozen
+	SpaceStatusFrozen SpaceStatus = 1
+	// SPACE_STATUS_ARCHIVED defines a retired space, which is read only
+	SpaceStatusArchived SpaceStatus = 2
+)
+
+var SpaceStatus_name = map[int32]string{
+	0: "SPACE_STATUS_ACTIVE",
+	1: "SPACE_STATUS_FROZEN",
+	2: "SPACE_STATUS_ARCHIVED",
+}
+
+var SpaceStatus_value = map[string]int32{
+	"SPACE_STATUS_ACTIVE":   0,
+	"SPACE_STATUS_FROZEN":   1,
+	"SPACE_STATUS_ARCHIVED": 2,
+}
+
+func (x SpaceStatus) String() string {
+	return proto.EnumName(SpaceStatus_name, int32(x))
+}
+
+func (SpaceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{0}
+}
+
+// HeaderStatus defines the finality status of a block header
+type HeaderStatus int32
+
+const (
+	// HEADER_STATUS_FINALIZED defines a final block header
+	HeaderStatusFinalized HeaderStatus = 0
+	// HEADER_STATUS_PENDING defines a block header within the challenge period
+	HeaderStatusPending HeaderStatus = 1
+	// HEADER_STATUS_CHALLENGED defines a block header challenged within the challenge period
+	HeaderStatusChallenged HeaderStatus = 2
+)
+
+var HeaderStatus_name = map[int32]string{
+	0: "HEADER_STATUS_FINALIZED",
+	1: "HEADER_STATUS_PENDING",
+	2: "HEADER_STATUS_CHALLENGED",
+}
+
+var HeaderStatus_value = map[string]int32{
+	"HEADER_STATUS_FINALIZED":  0,
+	"HEADER_STATUS_PENDING":    1,
+	"HEADER_STATUS_CHALLENGED": 2,
+}
+
+func (x HeaderStatus) String() string {
+	return proto.EnumName(HeaderStatus_name, int32(x))
+}
+
+func (HeaderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{1}
+}
+
+// RootType defines the root of a structured header against which the inclusion is proved
+type RootType int32
+
+const (
+	// ROOT_TYPE_STATE defines the state root
+	RootTypeState RootType = 0
+	// ROOT_TYPE_TX defines the tx root
+	RootTypeTx RootType = 1
+)
+
+var RootType_name = map[int32]string{
+	0: "ROOT_TYPE_STATE",
+	1: "ROOT_TYPE_TX",
+}
+
+var RootType_value = map[string]int32{
+	"ROOT_TYPE_STATE": 0,
+	"ROOT_TYPE_TX":    1,
+}
+
+func (x RootType) String() string {
+	return proto.EnumName(RootType_name, int32(x))
+}
+
+func (RootType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{2}
+}
+
+// HashAlgorithm defines the hash algorithm of a Merkle tree
+type HashAlgorithm int32
+
+const (
+	// HASH_ALGORITHM_SHA256 defines the SHA-256 hash algorithm
+	HashAlgorithmSHA256 HashAlgorithm = 0
+	// HASH_ALGORITHM_SM3 defines the SM3 hash algorithm
+	HashAlgorithmSM3 HashAlgorithm = 1
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_SHA256",
+	1: "HASH_ALGORITHM_SM3",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_SHA256": 0,
+	"HASH_ALGORITHM_SM3":    1,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{3}
+}
+
 // Space defines the space info of the side-chain module
 type Space struct {
 	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Uri   string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
 	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the number of blocks in which the block headers can be challenged before finalized, 0 for immediate finality
+	ChallengePeriod uint64      `protobuf:"varint,5,opt,name=challenge_period,json=challengePeriod,proto3" json:"challenge_period,omitempty"`
+	Status          SpaceStatus `protobuf:"varint,6,opt,name=status,proto3,enum=iritamod.side_chain.v1.SpaceStatus" json:"status,omitempty"`
+	// the deposit paid for the space, which is refunded to the owner when the space is archived
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
 func (m *Space) Reset()         { *m = Space{} }
@@ -92,6 +223,97 @@ func (m *Space) GetOwner() string {
 	return ""
 }
 
+func (m *Space) GetChallengePeriod() uint64 {
+	if m != nil {
+		return m.ChallengePeriod
+	}
+	return 0
+}
+
+func (m *Space) GetStatus() SpaceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SpaceStatusActive
+}
+
+func (m *Space) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// SpaceTransfer defines a pending transfer of the space ownership, which takes effect when accepted by the recipient
+type SpaceTransfer struct {
+	SpaceId   uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// the block height after which the transfer expires if not accepted
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *SpaceTransfer) Reset()         { *m = SpaceTransfer{} }
+func (m *SpaceTransfer) String() string { return proto.CompactTextString(m) }
+func (*SpaceTransfer) ProtoMessage()    {}
+func (*SpaceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{1}
+}
+func (m *SpaceTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpaceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpaceTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpaceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpaceTransfer.Merge(m, src)
+}
+func (m *SpaceTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpaceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpaceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpaceTransfer proto.InternalMessageInfo
+
+func (m *SpaceTransfer) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *SpaceTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SpaceTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *SpaceTransfer) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // SpaceLatestHeight defines the latest height of the side-chain.
 type SpaceLatestHeight struct {
 	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
@@ -102,7 +324,7 @@ func (m *SpaceLatestHeight) Reset()         { *m = SpaceLatestHeight{} }
 func (m *SpaceLatestHeight) String() string { return proto.CompactTextString(m) }
 func (*SpaceLatestHeight) ProtoMessage()    {}
 func (*SpaceLatestHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7c92cc5eb9507ffe, []int{1}
+	return fileDescriptor_7c92cc5eb9507ffe, []int{2}
 }
 func (m *SpaceLatestHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,17 +368,21 @@ func (m *SpaceLatestHeight) GetHeight() uint64 {
 }
 
 type BlockHeader struct {
-	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Header  string `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	TxHash  string `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	SpaceId          uint64            `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height           uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Header           string            `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	TxHash           string            `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	StructuredHeader *StructuredHeader `protobuf:"bytes,5,opt,name=structured_header,json=structuredHeader,proto3" json:"structured_header,omitempty"`
+	Hash             string            `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status           HeaderStatus      `protobuf:"varint,7,opt,name=status,proto3,enum=iritamod.side_chain.v1.HeaderStatus" json:"status,omitempty"`
+	FinalizeHeight   uint64            `protobuf:"varint,8,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
 }
 
 func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7c92cc5eb9507ffe, []int{2}
+	return fileDescriptor_7c92cc5eb9507ffe, []int{3}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,247 +439,4249 @@ func (m *BlockHeader) GetTxHash() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Space)(nil), "iritamod.side_chain.v1.Space")
-	proto.RegisterType((*SpaceLatestHeight)(nil), "iritamod.side_chain.v1.SpaceLatestHeight")
-	proto.RegisterType((*BlockHeader)(nil), "iritamod.side_chain.v1.BlockHeader")
+func (m *BlockHeader) GetStructuredHeader() *StructuredHeader {
+	if m != nil {
+		return m.StructuredHeader
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("side-chain/v1/side-chain.proto", fileDescriptor_7c92cc5eb9507ffe) }
+func (m *BlockHeader) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
 
-var fileDescriptor_7c92cc5eb9507ffe = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0xeb, 0x36, 0x6d, 0xc1, 0x48, 0x08, 0xac, 0xaa, 0x04, 0x06, 0xab, 0xea, 0xd4, 0x85,
-	0x5a, 0x15, 0x12, 0x0f, 0xd0, 0x01, 0x15, 0x89, 0x01, 0xb5, 0x1b, 0x4b, 0xe5, 0xc4, 0x56, 0x7c,
-	0xd0, 0xc4, 0x21, 0x76, 0x4a, 0x79, 0x0b, 0x1e, 0x8b, 0xb1, 0x23, 0x23, 0x4a, 0x5e, 0x04, 0xc5,
-	0x09, 0x7f, 0x66, 0xb6, 0xef, 0xf7, 0xdd, 0xe7, 0xbb, 0xd3, 0x19, 0x53, 0x03, 0x42, 0x5e, 0x86,
-	0x8a, 0x43, 0xc2, 0xb6, 0x33, 0xf6, 0x4b, 0xd3, 0x34, 0xd3, 0x56, 0x93, 0x21, 0x64, 0x60, 0x79,
-	0xac, 0xc5, 0xb4, 0x2a, 0xad, 0xeb, 0xd2, 0x76, 0x76, 0x31, 0x88, 0x74, 0xa4, 0x5d, 0x84, 0x55,
-	0xaa, 0x4e, 0x8f, 0x57, 0xb8, 0xbb, 0x4a, 0x79, 0x28, 0xc9, 0x31, 0x6e, 0x83, 0xf0, 0xd1, 0x08,
-	0x4d, 0xbc, 0x65, 0x1b, 0x04, 0x21, 0xd8, 0x4b, 0x78, 0x2c, 0xfd, 0xf6, 0x08, 0x4d, 0x0e, 0x97,
-	0x4e, 0x93, 0x13, 0xdc, 0xc9, 0x33, 0xf0, 0x3b, 0xce, 0xaa, 0x24, 0x19, 0xe0, 0xae, 0x7e, 0x49,
-	0x64, 0xe6, 0x7b, 0xce, 0xab, 0x61, 0x7c, 0x83, 0x4f, 0x5d, 0xd3, 0x3b, 0x6e, 0xa5, 0xb1, 0x0b,
-	0x09, 0x91, 0xb2, 0xe4, 0x1c, 0x1f, 0x98, 0xca, 0x5c, 0xff, 0x8c, 0xe9, 0x3b, 0xbe, 0x15, 0x64,
-	0x88, 0x7b, 0xca, 0x85, 0xdc, 0x34, 0x6f, 0xd9, 0xd0, 0xf8, 0x19, 0x1f, 0xcd, 0x37, 0x3a, 0x7c,
-	0x5a, 0x48, 0x2e, 0x64, 0xf6, 0x8f, 0x0e, 0xb5, 0x5f, 0x3d, 0x6e, 0x96, 0x6e, 0x88, 0x9c, 0xe1,
-	0xbe, 0xdd, 0xad, 0x15, 0x37, 0xaa, 0xd9, 0xbc, 0x67, 0x77, 0x0b, 0x6e, 0xd4, 0xfc, 0xfe, 0xbd,
-	0xa0, 0x68, 0x5f, 0x50, 0xf4, 0x59, 0x50, 0xf4, 0x56, 0xd2, 0xd6, 0xbe, 0xa4, 0xad, 0x8f, 0x92,
-	0xb6, 0x1e, 0xae, 0x23, 0xb0, 0x2a, 0x0f, 0xa6, 0xa1, 0x8e, 0x59, 0x00, 0x3c, 0x79, 0x04, 0xc9,
-	0x81, 0x7d, 0x1f, 0x9b, 0xc5, 0x5a, 0xe4, 0x1b, 0x69, 0xfe, 0xfc, 0x07, 0xb3, 0xaf, 0xa9, 0x34,
-	0x41, 0xcf, 0x1d, 0xfa, 0xea, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xed, 0x35, 0x64, 0x3e, 0xb8, 0x01,
-	0x00, 0x00,
+func (m *BlockHeader) GetStatus() HeaderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HeaderStatusFinalized
 }
 
-func (m *Space) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockHeader) GetFinalizeHeight() uint64 {
+	if m != nil {
+		return m.FinalizeHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Space) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HeaderFinality defines the finality of a block header
+type HeaderFinality struct {
+	Status HeaderStatus `protobuf:"varint,1,opt,name=status,proto3,enum=iritamod.side_chain.v1.HeaderStatus" json:"status,omitempty"`
+	// the block height at which the pending header is finalized
+	FinalizeHeight uint64 `protobuf:"varint,2,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
 }
 
-func (m *Space) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x1a
+func (m *HeaderFinality) Reset()         { *m = HeaderFinality{} }
+func (m *HeaderFinality) String() string { return proto.CompactTextString(m) }
+func (*HeaderFinality) ProtoMessage()    {}
+func (*HeaderFinality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{4}
+}
+func (m *HeaderFinality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderFinality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderFinality.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *HeaderFinality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderFinality.Merge(m, src)
+}
+func (m *HeaderFinality) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderFinality) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderFinality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderFinality proto.InternalMessageInfo
+
+func (m *HeaderFinality) GetStatus() HeaderStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Id != 0 {
-		i = encodeVarintSideChain(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return HeaderStatusFinalized
+}
+
+func (m *HeaderFinality) GetFinalizeHeight() uint64 {
+	if m != nil {
+		return m.FinalizeHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SpaceLatestHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ConflictingHeader defines a block header signed by the space owner or submitter, which
+// conflicts with the recorded header at the same height
+type ConflictingHeader struct {
+	Header           string            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StructuredHeader *StructuredHeader `protobuf:"bytes,2,opt,name=structured_header,json=structuredHeader,proto3" json:"structured_header,omitempty"`
+	Signer           string            `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Signature        []byte            `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ConflictingHeader) Reset()         { *m = ConflictingHeader{} }
+func (m *ConflictingHeader) String() string { return proto.CompactTextString(m) }
+func (*ConflictingHeader) ProtoMessage()    {}
+func (*ConflictingHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{5}
+}
+func (m *ConflictingHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ConflictingHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingHeader.Merge(m, src)
+}
+func (m *ConflictingHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingHeader.DiscardUnknown(m)
 }
 
-func (m *SpaceLatestHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConflictingHeader proto.InternalMessageInfo
+
+func (m *ConflictingHeader) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
 }
 
-func (m *SpaceLatestHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+func (m *ConflictingHeader) GetStructuredHeader() *StructuredHeader {
+	if m != nil {
+		return m.StructuredHeader
 	}
-	if m.SpaceId != 0 {
-		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ConflictingHeader) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConflictingHeader) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Challenge defines a challenge against a pending block header
+type Challenge struct {
+	SpaceId           uint64             `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height            uint64             `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Challenger        string             `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	ConflictingHeader *ConflictingHeader `protobuf:"bytes,4,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty"`
+	Evidence          string             `protobuf:"bytes,5,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	BlockHeight       int64              `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintSideChain(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x22
+func (m *Challenge) Reset()         { *m = Challenge{} }
+func (m *Challenge) String() string { return proto.CompactTextString(m) }
+func (*Challenge) ProtoMessage()    {}
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{6}
+}
+func (m *Challenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Challenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Challenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Header) > 0 {
-		i -= len(m.Header)
-		copy(dAtA[i:], m.Header)
-		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Header)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Challenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Challenge.Merge(m, src)
+}
+func (m *Challenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Challenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Challenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Challenge proto.InternalMessageInfo
+
+func (m *Challenge) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	if m.Height != 0 {
-		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *Challenge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.SpaceId != 0 {
-		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Challenge) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintSideChain(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSideChain(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Challenge) GetConflictingHeader() *ConflictingHeader {
+	if m != nil {
+		return m.ConflictingHeader
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Space) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Challenge) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovSideChain(uint64(m.Id))
+	return ""
+}
+
+func (m *Challenge) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovSideChain(uint64(l))
+	return 0
+}
+
+// StructuredHeader defines the typed layer2 block header which links to the parent header by hash
+type StructuredHeader struct {
+	ParentHash string    `protobuf:"bytes,1,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	StateRoot  string    `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TxRoot     string    `protobuf:"bytes,3,opt,name=tx_root,json=txRoot,proto3" json:"tx_root,omitempty"`
+	Timestamp  time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *StructuredHeader) Reset()         { *m = StructuredHeader{} }
+func (m *StructuredHeader) String() string { return proto.CompactTextString(m) }
+func (*StructuredHeader) ProtoMessage()    {}
+func (*StructuredHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{7}
+}
+func (m *StructuredHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StructuredHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StructuredHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovSideChain(uint64(l))
+}
+func (m *StructuredHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StructuredHeader.Merge(m, src)
+}
+func (m *StructuredHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *StructuredHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_StructuredHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StructuredHeader proto.InternalMessageInfo
+
+func (m *StructuredHeader) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovSideChain(uint64(l))
+	return ""
+}
+
+func (m *StructuredHeader) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	return n
+	return ""
 }
 
-func (m *SpaceLatestHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StructuredHeader) GetTxRoot() string {
+	if m != nil {
+		return m.TxRoot
 	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovSideChain(uint64(m.SpaceId))
+	return ""
+}
+
+func (m *StructuredHeader) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	if m.Height != 0 {
-		n += 1 + sovSideChain(uint64(m.Height))
+	return time.Time{}
+}
+
+// Submitter defines an account authorized by the space owner to submit block headers
+type Submitter struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the lowest height allowed to submit, 0 for no limit
+	MinHeight uint64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// the highest height allowed to submit, 0 for no limit
+	MaxHeight uint64 `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// the maximum number of headers allowed to submit in a block, 0 for no limit
+	MaxPerBlock uint64 `protobuf:"varint,5,opt,name=max_per_block,json=maxPerBlock,proto3" json:"max_per_block,omitempty"`
+}
+
+func (m *Submitter) Reset()         { *m = Submitter{} }
+func (m *Submitter) String() string { return proto.CompactTextString(m) }
+func (*Submitter) ProtoMessage()    {}
+func (*Submitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{8}
+}
+func (m *Submitter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Submitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Submitter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Submitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Submitter.Merge(m, src)
+}
+func (m *Submitter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Submitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Submitter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Submitter proto.InternalMessageInfo
+
+func (m *Submitter) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *Submitter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Submitter) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *Submitter) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *Submitter) GetMaxPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPerBlock
+	}
+	return 0
+}
+
+// HeightRange defines an inclusive range of the block heights
+type HeightRange struct {
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *HeightRange) Reset()         { *m = HeightRange{} }
+func (m *HeightRange) String() string { return proto.CompactTextString(m) }
+func (*HeightRange) ProtoMessage()    {}
+func (*HeightRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{9}
+}
+func (m *HeightRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightRange.Merge(m, src)
+}
+func (m *HeightRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightRange proto.InternalMessageInfo
+
+func (m *HeightRange) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HeightRange) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// Params defines the parameters for the side-chain module
+type Params struct {
+	// the number of the latest heights of a space whose block headers cannot be pruned, 0 to disable pruning
+	HeaderRetention uint64 `protobuf:"varint,1,opt,name=header_retention,json=headerRetention,proto3" json:"header_retention,omitempty" yaml:"header_retention"`
+	// the refundable deposit to create a space
+	SpaceDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=space_deposit,json=spaceDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"space_deposit" yaml:"space_deposit"`
+	// the fee paid for each submitted block header
+	HeaderFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=header_fee,json=headerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"header_fee" yaml:"header_fee"`
+	// the recipient of the block header fees, the fee collector if empty
+	HeaderFeeRecipient string `protobuf:"bytes,4,opt,name=header_fee_recipient,json=headerFeeRecipient,proto3" json:"header_fee_recipient,omitempty" yaml:"header_fee_recipient"`
+	// the maximum size of the opaque block header in bytes, 0 for no limit
+	MaxHeaderBytes uint64 `protobuf:"varint,5,opt,name=max_header_bytes,json=maxHeaderBytes,proto3" json:"max_header_bytes,omitempty" yaml:"max_header_bytes"`
+	// the maximum number of the spaces owned by an account, 0 for no limit
+	MaxSpacesPerOwner uint64 `protobuf:"varint,6,opt,name=max_spaces_per_owner,json=maxSpacesPerOwner,proto3" json:"max_spaces_per_owner,omitempty" yaml:"max_spaces_per_owner"`
+	// the maximum gap between the submitted height and the latest height of a space, 0 for no limit
+	MaxFutureHeightGap uint64 `protobuf:"varint,7,opt,name=max_future_height_gap,json=maxFutureHeightGap,proto3" json:"max_future_height_gap,omitempty" yaml:"max_future_height_gap"`
+	// whether the block headers below the latest height of a space can be submitted
+	AllowNonMonotonic bool `protobuf:"varint,8,opt,name=allow_non_monotonic,json=allowNonMonotonic,proto3" json:"allow_non_monotonic,omitempty" yaml:"allow_non_monotonic"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{10}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHeaderRetention() uint64 {
+	if m != nil {
+		return m.HeaderRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSpaceDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpaceDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetHeaderFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.HeaderFee
+	}
+	return nil
+}
+
+func (m *Params) GetHeaderFeeRecipient() string {
+	if m != nil {
+		return m.HeaderFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetMaxHeaderBytes() uint64 {
+	if m != nil {
+		return m.MaxHeaderBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSpacesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxSpacesPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFutureHeightGap() uint64 {
+	if m != nil {
+		return m.MaxFutureHeightGap
+	}
+	return 0
+}
+
+func (m *Params) GetAllowNonMonotonic() bool {
+	if m != nil {
+		return m.AllowNonMonotonic
+	}
+	return false
+}
+
+// Validator defines a validator of the side chain
+type Validator struct {
+	// bech32 encoded consensus public key of the validator
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Power  int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{11}
+}
+func (m *Validator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
+}
+func (m *Validator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Validator proto.InternalMessageInfo
+
+func (m *Validator) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *Validator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ValidatorSet defines the validator set of the side chain in a space
+type ValidatorSet struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	// the side chain height at which the validator set is updated, 0 for the registered one
+	Height     uint64      `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Validators []Validator `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *ValidatorSet) Reset()         { *m = ValidatorSet{} }
+func (m *ValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSet) ProtoMessage()    {}
+func (*ValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{12}
+}
+func (m *ValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSet.Merge(m, src)
+}
+func (m *ValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSet proto.InternalMessageInfo
+
+func (m *ValidatorSet) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *ValidatorSet) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorSet) GetValidators() []Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// ValidatorSignature defines a signature of the validator on the block header
+type ValidatorSignature struct {
+	PubKey    string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ValidatorSignature) Reset()         { *m = ValidatorSignature{} }
+func (m *ValidatorSignature) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSignature) ProtoMessage()    {}
+func (*ValidatorSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{13}
+}
+func (m *ValidatorSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSignature.Merge(m, src)
+}
+func (m *ValidatorSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSignature proto.InternalMessageInfo
+
+func (m *ValidatorSignature) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *ValidatorSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// HeaderCommit defines the validator signatures on the block header, which optionally
+// updates the validator set of the side chain
+type HeaderCommit struct {
+	Signatures     []ValidatorSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures"`
+	NextValidators []Validator          `protobuf:"bytes,2,rep,name=next_validators,json=nextValidators,proto3" json:"next_validators"`
+}
+
+func (m *HeaderCommit) Reset()         { *m = HeaderCommit{} }
+func (m *HeaderCommit) String() string { return proto.CompactTextString(m) }
+func (*HeaderCommit) ProtoMessage()    {}
+func (*HeaderCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{14}
+}
+func (m *HeaderCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderCommit.Merge(m, src)
+}
+func (m *HeaderCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderCommit proto.InternalMessageInfo
+
+func (m *HeaderCommit) GetSignatures() []ValidatorSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *HeaderCommit) GetNextValidators() []Validator {
+	if m != nil {
+		return m.NextValidators
+	}
+	return nil
+}
+
+// InclusionProof defines the Merkle proof of a leaf in the simple Merkle tree of RFC 6962,
+// in which the leaf is hashed with the prefix 0x00 and the inner node with the prefix 0x01
+type InclusionProof struct {
+	Algorithm HashAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=iritamod.side_chain.v1.HashAlgorithm" json:"algorithm,omitempty"`
+	// the index of the leaf in the tree
+	Index uint64 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// the total number of the leaves in the tree
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// the hashes of the sibling nodes from the leaf to the root
+	Aunts [][]byte `protobuf:"bytes,4,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *InclusionProof) Reset()         { *m = InclusionProof{} }
+func (m *InclusionProof) String() string { return proto.CompactTextString(m) }
+func (*InclusionProof) ProtoMessage()    {}
+func (*InclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c92cc5eb9507ffe, []int{15}
+}
+func (m *InclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InclusionProof.Merge(m, src)
+}
+func (m *InclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *InclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_InclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InclusionProof proto.InternalMessageInfo
+
+func (m *InclusionProof) GetAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return HashAlgorithmSHA256
+}
+
+func (m *InclusionProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *InclusionProof) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *InclusionProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("iritamod.side_chain.v1.SpaceStatus", SpaceStatus_name, SpaceStatus_value)
+	proto.RegisterEnum("iritamod.side_chain.v1.HeaderStatus", HeaderStatus_name, HeaderStatus_value)
+	proto.RegisterEnum("iritamod.side_chain.v1.RootType", RootType_name, RootType_value)
+	proto.RegisterEnum("iritamod.side_chain.v1.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
+	proto.RegisterType((*Space)(nil), "iritamod.side_chain.v1.Space")
+	proto.RegisterType((*SpaceTransfer)(nil), "iritamod.side_chain.v1.SpaceTransfer")
+	proto.RegisterType((*SpaceLatestHeight)(nil), "iritamod.side_chain.v1.SpaceLatestHeight")
+	proto.RegisterType((*BlockHeader)(nil), "iritamod.side_chain.v1.BlockHeader")
+	proto.RegisterType((*HeaderFinality)(nil), "iritamod.side_chain.v1.HeaderFinality")
+	proto.RegisterType((*ConflictingHeader)(nil), "iritamod.side_chain.v1.ConflictingHeader")
+	proto.RegisterType((*Challenge)(nil), "iritamod.side_chain.v1.Challenge")
+	proto.RegisterType((*StructuredHeader)(nil), "iritamod.side_chain.v1.StructuredHeader")
+	proto.RegisterType((*Submitter)(nil), "iritamod.side_chain.v1.Submitter")
+	proto.RegisterType((*HeightRange)(nil), "iritamod.side_chain.v1.HeightRange")
+	proto.RegisterType((*Params)(nil), "iritamod.side_chain.v1.Params")
+	proto.RegisterType((*Validator)(nil), "iritamod.side_chain.v1.Validator")
+	proto.RegisterType((*ValidatorSet)(nil), "iritamod.side_chain.v1.ValidatorSet")
+	proto.RegisterType((*ValidatorSignature)(nil), "iritamod.side_chain.v1.ValidatorSignature")
+	proto.RegisterType((*HeaderCommit)(nil), "iritamod.side_chain.v1.HeaderCommit")
+	proto.RegisterType((*InclusionProof)(nil), "iritamod.side_chain.v1.InclusionProof")
+}
+
+func init() { proto.RegisterFile("side-chain/v1/side-chain.proto", fileDescriptor_7c92cc5eb9507ffe) }
+
+var fileDescriptor_7c92cc5eb9507ffe = []byte{
+	// 1687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x3d, 0x6c, 0x23, 0xc7,
+	0x15, 0xd6, 0x92, 0xd4, 0x0f, 0x9f, 0xfe, 0xc8, 0x39, 0xdd, 0x1d, 0x8f, 0x76, 0x48, 0x7a, 0x9d,
+	0x1f, 0xf9, 0x10, 0x93, 0x96, 0x0e, 0x3e, 0x04, 0x97, 0x34, 0x24, 0x45, 0x89, 0x82, 0x75, 0x12,
+	0xb3, 0xa4, 0x0f, 0xce, 0x35, 0x8b, 0xe1, 0xee, 0x88, 0x1c, 0x1c, 0x77, 0x87, 0xd8, 0x1d, 0x4a,
+	0x94, 0x9b, 0x54, 0x01, 0x0c, 0x55, 0xee, 0x52, 0x09, 0x08, 0x9c, 0x22, 0x40, 0x9a, 0x00, 0xa9,
+	0x82, 0xb4, 0x69, 0x5c, 0xba, 0x4c, 0x25, 0x07, 0x77, 0x4d, 0x6a, 0xa5, 0x48, 0x1b, 0xcc, 0xcf,
+	0xf2, 0x4f, 0x52, 0x84, 0xbb, 0xa4, 0xe2, 0xbe, 0x6f, 0xde, 0x7b, 0xf3, 0x7e, 0xbe, 0x99, 0x37,
+	0x84, 0x5c, 0x48, 0x5d, 0xf2, 0xb1, 0xd3, 0xc5, 0xd4, 0x2f, 0x9d, 0x6c, 0x95, 0xc6, 0x52, 0xb1,
+	0x1f, 0x30, 0xce, 0xd0, 0x03, 0x1a, 0x50, 0x8e, 0x3d, 0xe6, 0x16, 0xc5, 0x92, 0xad, 0x96, 0x4e,
+	0xb6, 0xb2, 0x1b, 0x1d, 0xd6, 0x61, 0x52, 0xa5, 0x24, 0xbe, 0x94, 0x76, 0x36, 0xe7, 0xb0, 0xd0,
+	0x63, 0x61, 0xa9, 0x8d, 0x43, 0x52, 0x3a, 0xd9, 0x6a, 0x13, 0x8e, 0xb7, 0x4a, 0x0e, 0x8b, 0xbc,
+	0x65, 0xf3, 0x1d, 0xc6, 0x3a, 0x3d, 0x52, 0x92, 0x52, 0x7b, 0x70, 0x5c, 0xe2, 0xd4, 0x23, 0x21,
+	0xc7, 0x5e, 0x5f, 0x29, 0x98, 0xdf, 0xc4, 0x60, 0xbe, 0xd9, 0xc7, 0x0e, 0x41, 0x6b, 0x10, 0xa3,
+	0x6e, 0xc6, 0x28, 0x18, 0x9b, 0x09, 0x2b, 0x46, 0x5d, 0x84, 0x20, 0xe1, 0x63, 0x8f, 0x64, 0x62,
+	0x05, 0x63, 0x33, 0x69, 0xc9, 0x6f, 0x94, 0x82, 0xf8, 0x20, 0xa0, 0x99, 0xb8, 0x84, 0xc4, 0x27,
+	0xda, 0x80, 0x79, 0x76, 0xea, 0x93, 0x20, 0x93, 0x90, 0x98, 0x12, 0xd0, 0x47, 0x90, 0x72, 0xba,
+	0xb8, 0xd7, 0x23, 0x7e, 0x87, 0xd8, 0x7d, 0x12, 0x50, 0xe6, 0x66, 0xe6, 0xa5, 0xe7, 0xf5, 0x11,
+	0xde, 0x90, 0x30, 0xfa, 0x39, 0x2c, 0x84, 0x1c, 0xf3, 0x41, 0x98, 0x59, 0x28, 0x18, 0x9b, 0x6b,
+	0xdb, 0x1f, 0x16, 0x6f, 0x2e, 0x40, 0x51, 0x46, 0xd9, 0x94, 0xaa, 0x96, 0x36, 0x41, 0x04, 0x16,
+	0x5d, 0xd2, 0x67, 0x21, 0xe5, 0x99, 0xc5, 0x42, 0x7c, 0x73, 0x79, 0xfb, 0x51, 0x51, 0x15, 0xa4,
+	0x28, 0x0a, 0x52, 0xd4, 0x05, 0x29, 0x56, 0x19, 0xf5, 0x2b, 0x9f, 0x7c, 0x7b, 0x99, 0x9f, 0xfb,
+	0xe3, 0xf7, 0xf9, 0xcd, 0x0e, 0xe5, 0xdd, 0x41, 0xbb, 0xe8, 0x30, 0xaf, 0xa4, 0xab, 0xa7, 0x7e,
+	0x3e, 0x0e, 0xdd, 0x57, 0x25, 0x7e, 0xd6, 0x27, 0xa1, 0x34, 0x08, 0xad, 0xc8, 0xb7, 0xf9, 0x1b,
+	0x03, 0x56, 0xe5, 0xf6, 0xad, 0x00, 0xfb, 0xe1, 0x31, 0x09, 0xd0, 0x23, 0x58, 0x0a, 0x05, 0x60,
+	0x8f, 0x4a, 0xb6, 0x28, 0xe5, 0x7d, 0x17, 0x3d, 0x80, 0x85, 0x90, 0xf8, 0x2e, 0x09, 0x74, 0xe5,
+	0xb4, 0x84, 0xde, 0x87, 0x64, 0x40, 0x1c, 0xda, 0xa7, 0xc4, 0xe7, 0xba, 0x82, 0x63, 0x00, 0x7d,
+	0x08, 0xab, 0x64, 0xd8, 0xa7, 0xc1, 0x99, 0xdd, 0x25, 0xb4, 0xd3, 0xe5, 0xb2, 0x9e, 0x09, 0x6b,
+	0x45, 0x81, 0x75, 0x89, 0x99, 0xbb, 0x90, 0x96, 0x61, 0x1c, 0x60, 0x4e, 0x42, 0xae, 0xc0, 0x3b,
+	0x42, 0xd1, 0xde, 0x62, 0x72, 0x41, 0x4b, 0xe6, 0xdf, 0x62, 0xb0, 0x5c, 0xe9, 0x31, 0xe7, 0x55,
+	0x9d, 0x60, 0xf7, 0xce, 0x6c, 0x6e, 0x72, 0xa1, 0x70, 0x61, 0xac, 0x53, 0xd1, 0x12, 0x7a, 0x08,
+	0x8b, 0x7c, 0x68, 0x77, 0x71, 0xd8, 0xd5, 0x8c, 0x58, 0xe0, 0xc3, 0x3a, 0x0e, 0xbb, 0xe8, 0x73,
+	0x48, 0x87, 0x3c, 0x18, 0x38, 0x7c, 0x10, 0x10, 0xd7, 0xd6, 0xb6, 0x82, 0x13, 0xcb, 0xdb, 0x9b,
+	0xb7, 0xb6, 0x7c, 0x64, 0xa0, 0x02, 0xb5, 0x52, 0xe1, 0x0c, 0x22, 0x58, 0x2a, 0x37, 0x5b, 0x50,
+	0x2c, 0x15, 0xdf, 0xe8, 0x17, 0x23, 0x4a, 0x2d, 0x4a, 0x4a, 0xfd, 0xf0, 0x36, 0xff, 0xca, 0xc7,
+	0x0c, 0xa7, 0x7e, 0x02, 0xeb, 0xc7, 0xd4, 0xc7, 0x3d, 0xfa, 0x25, 0x89, 0x7a, 0xb1, 0x24, 0x53,
+	0x5f, 0x8b, 0x60, 0xdd, 0x8d, 0x53, 0x58, 0x53, 0x0e, 0x76, 0x25, 0xce, 0xcf, 0x26, 0x36, 0x36,
+	0xfe, 0x3f, 0x1b, 0xc7, 0x6e, 0xdc, 0xf8, 0x2f, 0x06, 0xa4, 0xab, 0xcc, 0x3f, 0xee, 0x51, 0x87,
+	0x53, 0xbf, 0xa3, 0x2b, 0x31, 0xee, 0x88, 0x31, 0xd5, 0x91, 0x1b, 0x0b, 0x1f, 0xfb, 0x9f, 0x0b,
+	0x2f, 0x68, 0x4e, 0x3b, 0xfe, 0x98, 0x00, 0x4a, 0x12, 0x34, 0x17, 0x5f, 0x58, 0xe8, 0x4a, 0x0a,
+	0xac, 0x58, 0x63, 0xc0, 0xfc, 0xb7, 0x01, 0xc9, 0x6a, 0x74, 0x03, 0xbc, 0x0b, 0xef, 0x72, 0x00,
+	0xa3, 0x1b, 0x24, 0xda, 0x7a, 0x02, 0x41, 0x5f, 0x00, 0x72, 0xc6, 0xa5, 0x89, 0xd2, 0x4d, 0xc8,
+	0x74, 0x3f, 0xba, 0x2d, 0xdd, 0x6b, 0xc5, 0xb4, 0xd2, 0xce, 0xb5, 0xfa, 0x66, 0x61, 0x89, 0x9c,
+	0x50, 0x97, 0xf8, 0x0e, 0x91, 0xbc, 0x4d, 0x5a, 0x23, 0x19, 0x7d, 0x00, 0x2b, 0x6d, 0x71, 0x9e,
+	0xa2, 0xbe, 0x09, 0x36, 0xc6, 0xad, 0xe5, 0xb6, 0x3a, 0x63, 0xb2, 0x69, 0x7f, 0x32, 0x20, 0x35,
+	0x5b, 0x56, 0x94, 0x87, 0xe5, 0x3e, 0x0e, 0x88, 0xcf, 0xd5, 0x89, 0x51, 0x8d, 0x03, 0x05, 0xc9,
+	0x53, 0xf3, 0x03, 0x00, 0xc1, 0x0e, 0x62, 0x07, 0x8c, 0x71, 0x7d, 0xa1, 0x24, 0x25, 0x62, 0x31,
+	0xc6, 0xf5, 0x69, 0x93, 0x6b, 0xf1, 0xe8, 0xb4, 0xc9, 0x85, 0x0a, 0x24, 0x47, 0x37, 0xbd, 0xce,
+	0x3e, 0x5b, 0x54, 0xb3, 0xa0, 0x18, 0xcd, 0x82, 0x62, 0x2b, 0xd2, 0xa8, 0x2c, 0x89, 0xbb, 0xf1,
+	0xeb, 0xef, 0xf3, 0x86, 0x35, 0x36, 0x33, 0xbf, 0x31, 0x20, 0xd9, 0x1c, 0xb4, 0x3d, 0xca, 0xf9,
+	0x7f, 0xbf, 0x23, 0x32, 0xb0, 0x88, 0x5d, 0x37, 0x20, 0x61, 0xa8, 0x23, 0x8c, 0x44, 0x11, 0xbe,
+	0x47, 0xfd, 0xa8, 0x2a, 0x71, 0x69, 0x96, 0xf4, 0xa8, 0xaf, 0xaf, 0x2e, 0xb1, 0x8c, 0x87, 0xd3,
+	0x37, 0x5e, 0xd2, 0xc3, 0x43, 0xbd, 0x6c, 0xc2, 0xaa, 0x58, 0xee, 0x93, 0xc0, 0x96, 0x95, 0xd4,
+	0x23, 0x64, 0xd9, 0xc3, 0xc3, 0x06, 0x09, 0xe4, 0x05, 0x66, 0x7e, 0x0a, 0xcb, 0x4a, 0xdb, 0xc2,
+	0x82, 0x51, 0x1b, 0x30, 0x1f, 0x72, 0x1c, 0x70, 0x1d, 0xa2, 0x12, 0xc4, 0xd8, 0x22, 0xbe, 0xab,
+	0x99, 0x24, 0x3e, 0xcd, 0x7f, 0xcd, 0xc3, 0x42, 0x03, 0x07, 0xd8, 0x0b, 0xd1, 0x2e, 0xa4, 0x14,
+	0x4b, 0xec, 0x80, 0x70, 0xe2, 0x73, 0xca, 0x7c, 0x65, 0x5d, 0x79, 0xef, 0xea, 0x32, 0xff, 0xf0,
+	0x0c, 0x7b, 0xbd, 0x67, 0xe6, 0xac, 0x86, 0x69, 0xad, 0x2b, 0xc8, 0x8a, 0x10, 0xf4, 0x95, 0x01,
+	0xab, 0xaa, 0x42, 0xd1, 0x48, 0x8a, 0xdd, 0x35, 0x92, 0xea, 0xa2, 0xec, 0x57, 0x97, 0xf9, 0x0d,
+	0xb5, 0xc9, 0x94, 0xb5, 0xf9, 0x56, 0xa3, 0x6a, 0x45, 0xda, 0xee, 0x28, 0x53, 0xf4, 0x6b, 0x00,
+	0x1d, 0xf0, 0x31, 0x21, 0x99, 0xf8, 0x5d, 0x61, 0xd4, 0x74, 0x18, 0xe9, 0xa9, 0x5c, 0x8f, 0x09,
+	0x79, 0xbb, 0x18, 0x92, 0xca, 0x70, 0x97, 0x10, 0xf4, 0x4b, 0xd8, 0x18, 0x7b, 0xb1, 0xc7, 0x63,
+	0x4f, 0x8e, 0x84, 0x4a, 0xfe, 0xea, 0x32, 0xff, 0xde, 0xec, 0x5e, 0x63, 0x2d, 0xd3, 0x42, 0x23,
+	0x4f, 0x56, 0x04, 0xa2, 0x1a, 0xa4, 0x14, 0x57, 0xa4, 0x41, 0xfb, 0x8c, 0x93, 0x30, 0x33, 0x3f,
+	0xdb, 0xa6, 0x59, 0x0d, 0xd3, 0x5a, 0x93, 0x74, 0x12, 0x48, 0x45, 0x00, 0xa8, 0x01, 0x1b, 0x42,
+	0x49, 0x96, 0x2b, 0x94, 0xd4, 0x52, 0xcf, 0x97, 0x05, 0xe9, 0x6a, 0x22, 0xb2, 0x9b, 0xb4, 0x4c,
+	0x2b, 0xed, 0xe1, 0xa1, 0x1c, 0xc1, 0x61, 0x83, 0x04, 0x47, 0x02, 0x43, 0x4d, 0xb8, 0x2f, 0x74,
+	0x8f, 0x07, 0xe2, 0x60, 0x6b, 0x2e, 0xdb, 0x1d, 0xdc, 0x97, 0xc3, 0x27, 0x51, 0x29, 0x5c, 0x5d,
+	0xe6, 0xdf, 0x1f, 0xbb, 0xbc, 0xa6, 0x66, 0x5a, 0xc8, 0xc3, 0xc3, 0x5d, 0x09, 0x2b, 0x26, 0xef,
+	0xe1, 0x3e, 0x3a, 0x84, 0x7b, 0xb8, 0xd7, 0x63, 0xa7, 0xb6, 0xcf, 0x7c, 0xdb, 0x63, 0x3e, 0xe3,
+	0xcc, 0xa7, 0x8e, 0x1c, 0x44, 0x4b, 0x95, 0xdc, 0xd5, 0x65, 0x3e, 0xab, 0x5c, 0xde, 0xa0, 0x64,
+	0x5a, 0x69, 0x89, 0x1e, 0x32, 0xff, 0x79, 0x84, 0x3d, 0x4b, 0xfc, 0xf3, 0x77, 0x79, 0xc3, 0x7c,
+	0x06, 0xc9, 0x17, 0xb8, 0x47, 0x5d, 0xcc, 0x99, 0x9c, 0xd4, 0xfd, 0x41, 0xdb, 0x7e, 0x45, 0xce,
+	0xa2, 0x81, 0xd1, 0x1f, 0xb4, 0x3f, 0x23, 0x67, 0xe2, 0x0c, 0xf5, 0xd9, 0xa9, 0x1e, 0x12, 0x71,
+	0x4b, 0x09, 0xe6, 0xb9, 0x01, 0x2b, 0x23, 0xe3, 0x26, 0x79, 0x97, 0x77, 0x07, 0xda, 0x03, 0x38,
+	0x89, 0x5c, 0x84, 0x9a, 0x97, 0x1f, 0xdc, 0x76, 0x29, 0x8f, 0x36, 0xab, 0x24, 0x04, 0x3f, 0xad,
+	0x09, 0x53, 0xf3, 0x33, 0x40, 0xe3, 0x58, 0xa2, 0xe1, 0x72, 0x7b, 0x46, 0x53, 0x33, 0x29, 0x36,
+	0x3b, 0x93, 0xfe, 0x6c, 0xc0, 0x8a, 0xa2, 0x48, 0x95, 0x79, 0x1e, 0xe5, 0xa8, 0x01, 0x30, 0x5a,
+	0x15, 0xa3, 0x5c, 0x84, 0xf9, 0xf8, 0xce, 0x30, 0x47, 0x71, 0x44, 0xf1, 0x8e, 0x7d, 0xa0, 0x06,
+	0xac, 0xfb, 0x64, 0xc8, 0xed, 0x89, 0xec, 0x63, 0x6f, 0x97, 0xfd, 0x9a, 0xb0, 0x7f, 0x31, 0xae,
+	0xc0, 0x6f, 0x0d, 0x58, 0xdb, 0xf7, 0x9d, 0xde, 0x20, 0xa4, 0xcc, 0x6f, 0x04, 0x8c, 0x1d, 0xa3,
+	0x2a, 0x24, 0x71, 0xaf, 0xc3, 0x02, 0xca, 0xbb, 0x9e, 0x7e, 0x80, 0xfc, 0xe8, 0xd6, 0x07, 0x08,
+	0x0e, 0xbb, 0xe5, 0x48, 0xd9, 0x1a, 0xdb, 0x89, 0xe6, 0x53, 0xdf, 0x25, 0x43, 0xdd, 0x39, 0x25,
+	0x08, 0x94, 0x33, 0x8e, 0x7b, 0xfa, 0x0a, 0x57, 0x82, 0x40, 0xf1, 0xc0, 0xe7, 0x61, 0x26, 0x51,
+	0x88, 0x6f, 0xae, 0x58, 0x4a, 0x78, 0xfc, 0x07, 0x03, 0x96, 0x27, 0xde, 0xea, 0xa8, 0x08, 0xf7,
+	0x9a, 0x8d, 0x72, 0xb5, 0x66, 0x37, 0x5b, 0xe5, 0xd6, 0xe7, 0x4d, 0xbb, 0x5c, 0x6d, 0xed, 0xbf,
+	0xa8, 0xa5, 0xe6, 0xb2, 0xf7, 0xcf, 0x2f, 0x0a, 0xe9, 0x09, 0xcd, 0xb2, 0xc3, 0xe9, 0x09, 0xb9,
+	0xa6, 0xbf, 0x6b, 0x1d, 0xbd, 0xac, 0x1d, 0xa6, 0x8c, 0x6b, 0xfa, 0xbb, 0x01, 0xfb, 0x92, 0xf8,
+	0x68, 0x1b, 0xee, 0x4f, 0xfb, 0xb7, 0xaa, 0xf5, 0xfd, 0x17, 0xb5, 0x9d, 0x54, 0x2c, 0xfb, 0xf0,
+	0xfc, 0xa2, 0x70, 0x6f, 0x72, 0x87, 0xc0, 0xe9, 0xd2, 0x13, 0xe2, 0x66, 0x13, 0x5f, 0xfd, 0x3e,
+	0x37, 0xf7, 0xf8, 0xaf, 0xa3, 0xc6, 0xeb, 0x50, 0x9f, 0xc2, 0xc3, 0x7a, 0xad, 0xbc, 0x53, 0xb3,
+	0x46, 0x7b, 0xef, 0x1f, 0x96, 0x0f, 0xf6, 0x5f, 0xd6, 0x76, 0x52, 0x73, 0xd9, 0x47, 0xe7, 0x17,
+	0x85, 0xfb, 0x93, 0xea, 0xbb, 0xfa, 0x55, 0xe6, 0x8a, 0x10, 0xa6, 0xed, 0x1a, 0xb5, 0xc3, 0x9d,
+	0xfd, 0xc3, 0xbd, 0x94, 0xa1, 0x42, 0x98, 0xb4, 0x6a, 0x10, 0xdf, 0xa5, 0x7e, 0x07, 0xfd, 0x0c,
+	0x32, 0xd3, 0x36, 0xd5, 0x7a, 0xf9, 0xe0, 0xa0, 0x76, 0xb8, 0x27, 0x23, 0xcf, 0x9e, 0x5f, 0x14,
+	0x1e, 0x4c, 0x9a, 0x8d, 0x1e, 0x4d, 0x51, 0xf0, 0x2f, 0x61, 0x49, 0x4c, 0xfa, 0xd6, 0x59, 0x9f,
+	0xa0, 0x1f, 0xc3, 0xba, 0x75, 0x74, 0xd4, 0xb2, 0x5b, 0xbf, 0x6a, 0xa8, 0x32, 0x88, 0xf2, 0xa6,
+	0xcf, 0x2f, 0x0a, 0xab, 0x91, 0x8a, 0x70, 0x42, 0x50, 0x01, 0x56, 0xc6, 0x7a, 0xad, 0x2f, 0x52,
+	0x46, 0x76, 0xed, 0xfc, 0xa2, 0x00, 0x91, 0x52, 0x6b, 0xa8, 0x7d, 0x9f, 0xc2, 0xea, 0x14, 0x41,
+	0x64, 0x82, 0xe5, 0x66, 0xdd, 0x2e, 0x1f, 0xec, 0x1d, 0x59, 0xfb, 0xad, 0xfa, 0x73, 0xbb, 0x59,
+	0x2f, 0x6f, 0x7f, 0xfa, 0x34, 0x35, 0xa7, 0x13, 0x9c, 0xd4, 0x56, 0x4b, 0xe8, 0xa7, 0x80, 0x66,
+	0x6d, 0x9e, 0x3f, 0x49, 0x19, 0xd9, 0x8d, 0xf3, 0x8b, 0x42, 0x6a, 0xda, 0xe0, 0xf9, 0x13, 0xb5,
+	0x71, 0xa5, 0xf1, 0xed, 0xeb, 0x9c, 0xf1, 0xdd, 0xeb, 0x9c, 0xf1, 0x8f, 0xd7, 0x39, 0xe3, 0xeb,
+	0x37, 0xb9, 0xb9, 0xef, 0xde, 0xe4, 0xe6, 0xfe, 0xfe, 0x26, 0x37, 0xf7, 0xf2, 0xe9, 0xc4, 0x18,
+	0xc2, 0xd8, 0xed, 0xd2, 0x4f, 0x9e, 0x6e, 0x6d, 0x97, 0x22, 0x76, 0x97, 0x3c, 0xe6, 0x0e, 0x7a,
+	0x24, 0x9c, 0xf8, 0x3b, 0xad, 0x46, 0x53, 0x7b, 0x41, 0xbe, 0x76, 0x9e, 0xfc, 0x67, 0x00, 0x34,
+	0x9b, 0x67, 0xa8, 0x77, 0x0f, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HeaderRetention != that1.HeaderRetention {
+		return false
+	}
+	if len(this.SpaceDeposit) != len(that1.SpaceDeposit) {
+		return false
+	}
+	for i := range this.SpaceDeposit {
+		if !this.SpaceDeposit[i].Equal(&that1.SpaceDeposit[i]) {
+			return false
+		}
+	}
+	if len(this.HeaderFee) != len(that1.HeaderFee) {
+		return false
+	}
+	for i := range this.HeaderFee {
+		if !this.HeaderFee[i].Equal(&that1.HeaderFee[i]) {
+			return false
+		}
+	}
+	if this.HeaderFeeRecipient != that1.HeaderFeeRecipient {
+		return false
+	}
+	if this.MaxHeaderBytes != that1.MaxHeaderBytes {
+		return false
+	}
+	if this.MaxSpacesPerOwner != that1.MaxSpacesPerOwner {
+		return false
+	}
+	if this.MaxFutureHeightGap != that1.MaxFutureHeightGap {
+		return false
+	}
+	if this.AllowNonMonotonic != that1.AllowNonMonotonic {
+		return false
+	}
+	return true
+}
+func (m *Space) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Space) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Space) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ChallengePeriod != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.ChallengePeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpaceLatestHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpaceLatestHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpaceLatestHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizeHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.FinalizeHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StructuredHeader != nil {
+		{
+			size, err := m.StructuredHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSideChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderFinality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderFinality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderFinality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizeHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.FinalizeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StructuredHeader != nil {
+		{
+			size, err := m.StructuredHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSideChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Challenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Challenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConflictingHeader != nil {
+		{
+			size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSideChain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StructuredHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructuredHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StructuredHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSideChain(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TxRoot) > 0 {
+		i -= len(m.TxRoot)
+		copy(dAtA[i:], m.TxRoot)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.TxRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Submitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Submitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPerBlock != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MaxPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowNonMonotonic {
+		i--
+		if m.AllowNonMonotonic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxFutureHeightGap != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MaxFutureHeightGap))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxSpacesPerOwner != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MaxSpacesPerOwner))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxHeaderBytes != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.MaxHeaderBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HeaderFeeRecipient) > 0 {
+		i -= len(m.HeaderFeeRecipient)
+		copy(dAtA[i:], m.HeaderFeeRecipient)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.HeaderFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HeaderFee) > 0 {
+		for iNdEx := len(m.HeaderFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeaderFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpaceDeposit) > 0 {
+		for iNdEx := len(m.SpaceDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpaceDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HeaderRetention != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.HeaderRetention))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSideChain(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextValidators) > 0 {
+		for iNdEx := len(m.NextValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSideChain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintSideChain(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintSideChain(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSideChain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSideChain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Space) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSideChain(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.ChallengePeriod != 0 {
+		n += 1 + sovSideChain(uint64(m.ChallengePeriod))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSideChain(uint64(m.Status))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpaceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *SpaceLatestHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSideChain(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSideChain(uint64(m.Height))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.StructuredHeader != nil {
+		l = m.StructuredHeader.Size()
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSideChain(uint64(m.Status))
+	}
+	if m.FinalizeHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.FinalizeHeight))
+	}
+	return n
+}
+
+func (m *HeaderFinality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSideChain(uint64(m.Status))
+	}
+	if m.FinalizeHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.FinalizeHeight))
+	}
+	return n
+}
+
+func (m *ConflictingHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.StructuredHeader != nil {
+		l = m.StructuredHeader.Size()
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	return n
+}
+
+func (m *Challenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSideChain(uint64(m.Height))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.ConflictingHeader != nil {
+		l = m.ConflictingHeader.Size()
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *StructuredHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.TxRoot)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovSideChain(uint64(l))
+	return n
+}
+
+func (m *Submitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovSideChain(uint64(m.MaxHeight))
+	}
+	if m.MaxPerBlock != 0 {
+		n += 1 + sovSideChain(uint64(m.MaxPerBlock))
+	}
+	return n
+}
+
+func (m *HeightRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovSideChain(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovSideChain(uint64(m.End))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeaderRetention != 0 {
+		n += 1 + sovSideChain(uint64(m.HeaderRetention))
+	}
+	if len(m.SpaceDeposit) > 0 {
+		for _, e := range m.SpaceDeposit {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	if len(m.HeaderFee) > 0 {
+		for _, e := range m.HeaderFee {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	l = len(m.HeaderFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.MaxHeaderBytes != 0 {
+		n += 1 + sovSideChain(uint64(m.MaxHeaderBytes))
+	}
+	if m.MaxSpacesPerOwner != 0 {
+		n += 1 + sovSideChain(uint64(m.MaxSpacesPerOwner))
+	}
+	if m.MaxFutureHeightGap != 0 {
+		n += 1 + sovSideChain(uint64(m.MaxFutureHeightGap))
+	}
+	if m.AllowNonMonotonic {
+		n += 2
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSideChain(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovSideChain(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSideChain(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSideChain(uint64(l))
+	}
+	return n
+}
+
+func (m *HeaderCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	if len(m.NextValidators) > 0 {
+		for _, e := range m.NextValidators {
+			l = e.Size()
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		n += 1 + sovSideChain(uint64(m.Algorithm))
+	}
+	if m.Index != 0 {
+		n += 1 + sovSideChain(uint64(m.Index))
+	}
+	if m.Total != 0 {
+		n += 1 + sovSideChain(uint64(m.Total))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovSideChain(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSideChain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSideChain(x uint64) (n int) {
+	return sovSideChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Space) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Space: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Space: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePeriod", wireType)
+			}
+			m.ChallengePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SpaceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpaceLatestHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpaceLatestHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredHeader == nil {
+				m.StructuredHeader = &StructuredHeader{}
+			}
+			if err := m.StructuredHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HeaderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeHeight", wireType)
+			}
+			m.FinalizeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderFinality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderFinality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderFinality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HeaderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeHeight", wireType)
+			}
+			m.FinalizeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictingHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredHeader == nil {
+				m.StructuredHeader = &StructuredHeader{}
+			}
+			if err := m.StructuredHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Challenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = &ConflictingHeader{}
+			}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StructuredHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Submitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerBlock", wireType)
+			}
+			m.MaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderRetention", wireType)
+			}
+			m.HeaderRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeaderRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceDeposit = append(m.SpaceDeposit, types.Coin{})
+			if err := m.SpaceDeposit[len(m.SpaceDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderFee = append(m.HeaderFee, types.Coin{})
+			if err := m.HeaderFee[len(m.HeaderFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeaderBytes", wireType)
+			}
+			m.MaxHeaderBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeaderBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpacesPerOwner", wireType)
+			}
+			m.MaxSpacesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSpacesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFutureHeightGap", wireType)
+			}
+			m.MaxFutureHeightGap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFutureHeightGap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowNonMonotonic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowNonMonotonic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *BlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovSideChain(uint64(m.SpaceId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovSideChain(uint64(m.Height))
-	}
-	l = len(m.Header)
-	if l > 0 {
-		n += 1 + l + sovSideChain(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovSideChain(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSideChain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSideChain(x uint64) (n int) {
-	return sovSideChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Space) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -476,17 +4704,17 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Space: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Space: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.Id = 0
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -496,16 +4724,35 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSideChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -515,27 +4762,79 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSideChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSideChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSideChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSideChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -563,13 +4862,13 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -579,23 +4878,25 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSideChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSideChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -618,7 +4919,7 @@ func (m *Space) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
+func (m *HeaderCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -641,17 +4942,17 @@ func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpaceLatestHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpaceLatestHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			m.SpaceId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -661,16 +4962,31 @@ func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, ValidatorSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidators", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -680,11 +4996,26 @@ func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSideChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidators = append(m.NextValidators, Validator{})
+			if err := m.NextValidators[len(m.NextValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSideChain(dAtA[iNdEx:])
@@ -706,7 +5037,7 @@ func (m *SpaceLatestHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+func (m *InclusionProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -729,17 +5060,17 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: InclusionProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
-			m.SpaceId = 0
+			m.Algorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -749,16 +5080,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
+				m.Algorithm |= HashAlgorithm(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Height = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -768,16 +5099,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -787,29 +5118,16 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSideChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSideChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Header = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSideChain
@@ -819,23 +5137,23 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSideChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSideChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
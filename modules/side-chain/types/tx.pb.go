@@ -30,9 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgCreateSpace defines the Msg/CreateSpace request type.
 type MsgCreateSpace struct {
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uri    string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
-	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uri             string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Sender          string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	ChallengePeriod uint64 `protobuf:"varint,4,opt,name=challenge_period,json=challengePeriod,proto3" json:"challenge_period,omitempty"`
 }
 
 func (m *MsgCreateSpace) Reset()         { *m = MsgCreateSpace{} }
@@ -89,6 +90,13 @@ func (m *MsgCreateSpace) GetSender() string {
 	return ""
 }
 
+func (m *MsgCreateSpace) GetChallengePeriod() uint64 {
+	if m != nil {
+		return m.ChallengePeriod
+	}
+	return 0
+}
+
 // MsgCreateSpaceResponse defines the Msg/CreateSpace response type.
 type MsgCreateSpaceResponse struct {
 	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
@@ -139,6 +147,8 @@ type MsgTransferSpace struct {
 	SpaceId   uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
 	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Sender    string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the block height after which the transfer expires if not accepted by the recipient
+	ExpiryHeight uint64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
 }
 
 func (m *MsgTransferSpace) Reset()         { *m = MsgTransferSpace{} }
@@ -195,6 +205,13 @@ func (m *MsgTransferSpace) GetSender() string {
 	return ""
 }
 
+func (m *MsgTransferSpace) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
 // MsgTransferSpaceResponse defines the Msg/TransferSpace response type.
 type MsgTransferSpaceResponse struct {
 }
@@ -232,26 +249,24 @@ func (m *MsgTransferSpaceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTransferSpaceResponse proto.InternalMessageInfo
 
-// MsgCreateBlockHeader defines the Msg/CreateRecord request type.
-type MsgCreateBlockHeader struct {
+// MsgAcceptSpaceTransfer defines the Msg/AcceptSpaceTransfer request type.
+type MsgAcceptSpaceTransfer struct {
 	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
-	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Header  string `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	Sender  string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgCreateBlockHeader) Reset()         { *m = MsgCreateBlockHeader{} }
-func (m *MsgCreateBlockHeader) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateBlockHeader) ProtoMessage()    {}
-func (*MsgCreateBlockHeader) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptSpaceTransfer) Reset()         { *m = MsgAcceptSpaceTransfer{} }
+func (m *MsgAcceptSpaceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptSpaceTransfer) ProtoMessage()    {}
+func (*MsgAcceptSpaceTransfer) Descriptor() ([]byte, []int) {
 	return fileDescriptor_928006f8a682ca0e, []int{4}
 }
-func (m *MsgCreateBlockHeader) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptSpaceTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptSpaceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBlockHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptSpaceTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -261,62 +276,87 @@ func (m *MsgCreateBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBlockHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBlockHeader.Merge(m, src)
+func (m *MsgAcceptSpaceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSpaceTransfer.Merge(m, src)
 }
-func (m *MsgCreateBlockHeader) XXX_Size() int {
+func (m *MsgAcceptSpaceTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBlockHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBlockHeader.DiscardUnknown(m)
+func (m *MsgAcceptSpaceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSpaceTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBlockHeader proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptSpaceTransfer proto.InternalMessageInfo
 
-func (m *MsgCreateBlockHeader) GetSpaceId() uint64 {
+func (m *MsgAcceptSpaceTransfer) GetSpaceId() uint64 {
 	if m != nil {
 		return m.SpaceId
 	}
 	return 0
 }
 
-func (m *MsgCreateBlockHeader) GetHeight() uint64 {
+func (m *MsgAcceptSpaceTransfer) GetSender() string {
 	if m != nil {
-		return m.Height
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgCreateBlockHeader) GetHeader() string {
-	if m != nil {
-		return m.Header
-	}
-	return ""
+// MsgAcceptSpaceTransferResponse defines the Msg/AcceptSpaceTransfer response type.
+type MsgAcceptSpaceTransferResponse struct {
 }
 
-func (m *MsgCreateBlockHeader) GetSender() string {
-	if m != nil {
-		return m.Sender
+func (m *MsgAcceptSpaceTransferResponse) Reset()         { *m = MsgAcceptSpaceTransferResponse{} }
+func (m *MsgAcceptSpaceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptSpaceTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptSpaceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{5}
+}
+func (m *MsgAcceptSpaceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptSpaceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptSpaceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgAcceptSpaceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptSpaceTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptSpaceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptSpaceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptSpaceTransferResponse.DiscardUnknown(m)
 }
 
-// MsgCreateBlockHeaderResponse defines the Msg/CreateRecord response type.
-type MsgCreateBlockHeaderResponse struct {
+var xxx_messageInfo_MsgAcceptSpaceTransferResponse proto.InternalMessageInfo
+
+// MsgCancelSpaceTransfer defines the Msg/CancelSpaceTransfer request type.
+type MsgCancelSpaceTransfer struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgCreateBlockHeaderResponse) Reset()         { *m = MsgCreateBlockHeaderResponse{} }
-func (m *MsgCreateBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateBlockHeaderResponse) ProtoMessage()    {}
-func (*MsgCreateBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_928006f8a682ca0e, []int{5}
+func (m *MsgCancelSpaceTransfer) Reset()         { *m = MsgCancelSpaceTransfer{} }
+func (m *MsgCancelSpaceTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSpaceTransfer) ProtoMessage()    {}
+func (*MsgCancelSpaceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{6}
 }
-func (m *MsgCreateBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelSpaceTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelSpaceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateBlockHeaderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelSpaceTransfer.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -326,536 +366,6620 @@ func (m *MsgCreateBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateBlockHeaderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateBlockHeaderResponse.Merge(m, src)
+func (m *MsgCancelSpaceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSpaceTransfer.Merge(m, src)
 }
-func (m *MsgCreateBlockHeaderResponse) XXX_Size() int {
+func (m *MsgCancelSpaceTransfer) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateBlockHeaderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateBlockHeaderResponse.DiscardUnknown(m)
+func (m *MsgCancelSpaceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSpaceTransfer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateBlockHeaderResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelSpaceTransfer proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateSpace)(nil), "iritamod.side_chain.v1.MsgCreateSpace")
-	proto.RegisterType((*MsgCreateSpaceResponse)(nil), "iritamod.side_chain.v1.MsgCreateSpaceResponse")
-	proto.RegisterType((*MsgTransferSpace)(nil), "iritamod.side_chain.v1.MsgTransferSpace")
-	proto.RegisterType((*MsgTransferSpaceResponse)(nil), "iritamod.side_chain.v1.MsgTransferSpaceResponse")
-	proto.RegisterType((*MsgCreateBlockHeader)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeader")
-	proto.RegisterType((*MsgCreateBlockHeaderResponse)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeaderResponse")
+func (m *MsgCancelSpaceTransfer) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("side-chain/v1/tx.proto", fileDescriptor_928006f8a682ca0e) }
+func (m *MsgCancelSpaceTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-var fileDescriptor_928006f8a682ca0e = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8f, 0x93, 0x40,
-	0x14, 0xc7, 0xcb, 0x96, 0x54, 0xf7, 0x19, 0xcd, 0x3a, 0xd9, 0x10, 0x24, 0x9b, 0x89, 0xe1, 0x60,
-	0xf6, 0xa0, 0xe0, 0x5a, 0xe3, 0x07, 0xa8, 0x17, 0x3d, 0xd4, 0x18, 0xf4, 0xe4, 0xa5, 0x99, 0xc2,
-	0x13, 0xc6, 0x16, 0x86, 0xcc, 0xd0, 0x6a, 0xbf, 0x85, 0x1f, 0xcb, 0x63, 0xe3, 0xc9, 0xa3, 0x69,
-	0xbf, 0x88, 0x61, 0x28, 0x2d, 0x68, 0x5b, 0xbb, 0xb7, 0x79, 0x8f, 0x5f, 0xde, 0xff, 0x97, 0x79,
-	0x0c, 0x58, 0x8a, 0x47, 0xf8, 0x2c, 0x4c, 0x18, 0xcf, 0xfc, 0xf9, 0x8d, 0x5f, 0x7c, 0xf3, 0x72,
-	0x29, 0x0a, 0x41, 0x2c, 0x2e, 0x79, 0xc1, 0x52, 0x11, 0x79, 0x25, 0x30, 0xd2, 0x80, 0x37, 0xbf,
-	0x71, 0x2e, 0x63, 0x11, 0x0b, 0x8d, 0xf8, 0xe5, 0xa9, 0xa2, 0x1d, 0xda, 0x9e, 0xb2, 0xab, 0xaa,
-	0xef, 0xee, 0x3b, 0x78, 0x30, 0x54, 0xf1, 0x6b, 0x89, 0xac, 0xc0, 0x0f, 0x39, 0x0b, 0x91, 0x10,
-	0x30, 0x33, 0x96, 0xa2, 0x6d, 0x3c, 0x36, 0xae, 0xcf, 0x03, 0x7d, 0x26, 0x17, 0xd0, 0x9d, 0x49,
-	0x6e, 0x9f, 0xe9, 0x56, 0x79, 0x24, 0x16, 0xf4, 0x14, 0x66, 0x11, 0x4a, 0xbb, 0xab, 0x9b, 0x9b,
-	0xca, 0xed, 0x83, 0xd5, 0x9e, 0x17, 0xa0, 0xca, 0x45, 0xa6, 0x90, 0x3c, 0x82, 0xbb, 0xaa, 0x6c,
-	0x8c, 0x78, 0xa4, 0x67, 0x9b, 0xc1, 0x1d, 0x5d, 0xbf, 0x8d, 0xdc, 0x10, 0x2e, 0x86, 0x2a, 0xfe,
-	0x28, 0x59, 0xa6, 0x3e, 0xa3, 0xac, 0x34, 0x0e, 0xe3, 0xe4, 0x0a, 0xce, 0x25, 0x86, 0x3c, 0xe7,
-	0x98, 0x15, 0x1b, 0xa7, 0x5d, 0xe3, 0xa0, 0x99, 0x03, 0xf6, 0xdf, 0x21, 0xb5, 0x9b, 0xbb, 0x80,
-	0xcb, 0xad, 0xf5, 0x60, 0x2a, 0xc2, 0xc9, 0x1b, 0x64, 0x11, 0xca, 0x63, 0x12, 0x16, 0xf4, 0x12,
-	0xe4, 0x71, 0x52, 0x19, 0x98, 0xc1, 0xa6, 0xaa, 0xfa, 0xac, 0x11, 0x5f, 0x55, 0x0d, 0x2d, 0xb3,
-	0xa5, 0x45, 0xe1, 0x6a, 0x5f, 0x74, 0xad, 0xf6, 0xe2, 0xe7, 0x19, 0x74, 0x87, 0x2a, 0x26, 0x08,
-	0xf7, 0x9a, 0x5b, 0x7a, 0xe2, 0xed, 0xff, 0x0d, 0xbc, 0xf6, 0xed, 0x3b, 0xde, 0x69, 0xdc, 0x76,
-	0x4b, 0x13, 0xb8, 0xdf, 0xde, 0xc3, 0xf5, 0x91, 0x01, 0x2d, 0xd2, 0x79, 0x7e, 0x2a, 0xb9, 0x0d,
-	0xfb, 0x0a, 0x0f, 0xff, 0xbd, 0xf3, 0xa7, 0xff, 0x35, 0x6e, 0xd0, 0xce, 0xcb, 0xdb, 0xd0, 0x75,
-	0xf0, 0xe0, 0xfd, 0x8f, 0x15, 0x35, 0x96, 0x2b, 0x6a, 0xfc, 0x5e, 0x51, 0xe3, 0xfb, 0x9a, 0x76,
-	0x96, 0x6b, 0xda, 0xf9, 0xb5, 0xa6, 0x9d, 0x4f, 0xaf, 0x62, 0x5e, 0x24, 0xb3, 0xb1, 0x17, 0x8a,
-	0xd4, 0x1f, 0x73, 0x96, 0x7d, 0xe1, 0xc8, 0xb8, 0x5f, 0x67, 0xf8, 0xa9, 0x88, 0x66, 0x53, 0x54,
-	0x8d, 0x77, 0xe4, 0x17, 0x8b, 0x1c, 0xd5, 0xb8, 0xa7, 0x9f, 0x53, 0xff, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x1c, 0xbb, 0x9e, 0x2c, 0xb6, 0x03, 0x00, 0x00,
+// MsgCancelSpaceTransferResponse defines the Msg/CancelSpaceTransfer response type.
+type MsgCancelSpaceTransferResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgCancelSpaceTransferResponse) Reset()         { *m = MsgCancelSpaceTransferResponse{} }
+func (m *MsgCancelSpaceTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSpaceTransferResponse) ProtoMessage()    {}
+func (*MsgCancelSpaceTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{7}
+}
+func (m *MsgCancelSpaceTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSpaceTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSpaceTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSpaceTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSpaceTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelSpaceTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSpaceTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSpaceTransferResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgCancelSpaceTransferResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateSpace defines a method for creating a space
-	CreateSpace(ctx context.Context, in *MsgCreateSpace, opts ...grpc.CallOption) (*MsgCreateSpaceResponse, error)
-	// TransferSpace defines a method for transferring a space
-	TransferSpace(ctx context.Context, in *MsgTransferSpace, opts ...grpc.CallOption) (*MsgTransferSpaceResponse, error)
-	// CreateBlockHeader defines a method for creating a record
-	CreateBlockHeader(ctx context.Context, in *MsgCreateBlockHeader, opts ...grpc.CallOption) (*MsgCreateBlockHeaderResponse, error)
+// MsgUpdateSpace defines the Msg/UpdateSpace request type.
+type MsgUpdateSpace struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uri     string `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Sender  string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateSpace) Reset()         { *m = MsgUpdateSpace{} }
+func (m *MsgUpdateSpace) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpace) ProtoMessage()    {}
+func (*MsgUpdateSpace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{8}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateSpace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSpace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpace.Merge(m, src)
+}
+func (m *MsgUpdateSpace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpace.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateSpace(ctx context.Context, in *MsgCreateSpace, opts ...grpc.CallOption) (*MsgCreateSpaceResponse, error) {
-	out := new(MsgCreateSpaceResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CreateSpace", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateSpace proto.InternalMessageInfo
+
+func (m *MsgUpdateSpace) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) TransferSpace(ctx context.Context, in *MsgTransferSpace, opts ...grpc.CallOption) (*MsgTransferSpaceResponse, error) {
-	out := new(MsgTransferSpaceResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/TransferSpace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSpace) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateBlockHeader(ctx context.Context, in *MsgCreateBlockHeader, opts ...grpc.CallOption) (*MsgCreateBlockHeaderResponse, error) {
-	out := new(MsgCreateBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CreateBlockHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSpace) GetUri() string {
+	if m != nil {
+		return m.Uri
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateSpace defines a method for creating a space
-	CreateSpace(context.Context, *MsgCreateSpace) (*MsgCreateSpaceResponse, error)
-	// TransferSpace defines a method for transferring a space
-	TransferSpace(context.Context, *MsgTransferSpace) (*MsgTransferSpaceResponse, error)
-	// CreateBlockHeader defines a method for creating a record
-	CreateBlockHeader(context.Context, *MsgCreateBlockHeader) (*MsgCreateBlockHeaderResponse, error)
+func (m *MsgUpdateSpace) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateSpaceResponse defines the Msg/UpdateSpace response type.
+type MsgUpdateSpaceResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateSpace(ctx context.Context, req *MsgCreateSpace) (*MsgCreateSpaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSpace not implemented")
+func (m *MsgUpdateSpaceResponse) Reset()         { *m = MsgUpdateSpaceResponse{} }
+func (m *MsgUpdateSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSpaceResponse) ProtoMessage()    {}
+func (*MsgUpdateSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{9}
 }
-func (*UnimplementedMsgServer) TransferSpace(ctx context.Context, req *MsgTransferSpace) (*MsgTransferSpaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferSpace not implemented")
+func (m *MsgUpdateSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateBlockHeader(ctx context.Context, req *MsgCreateBlockHeader) (*MsgCreateBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBlockHeader not implemented")
+func (m *MsgUpdateSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSpaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSpaceResponse.Merge(m, src)
+}
+func (m *MsgUpdateSpaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSpaceResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateSpaceResponse proto.InternalMessageInfo
+
+// MsgFreezeSpace defines the Msg/FreezeSpace request type.
+type MsgFreezeSpace struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Msg_CreateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateSpace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateSpace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Msg/CreateSpace",
+func (m *MsgFreezeSpace) Reset()         { *m = MsgFreezeSpace{} }
+func (m *MsgFreezeSpace) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeSpace) ProtoMessage()    {}
+func (*MsgFreezeSpace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{10}
+}
+func (m *MsgFreezeSpace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeSpace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeSpace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateSpace(ctx, req.(*MsgCreateSpace))
+}
+func (m *MsgFreezeSpace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeSpace.Merge(m, src)
+}
+func (m *MsgFreezeSpace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeSpace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeSpace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeSpace proto.InternalMessageInfo
+
+func (m *MsgFreezeSpace) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_TransferSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferSpace)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgFreezeSpace) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferSpace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Msg/TransferSpace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferSpace(ctx, req.(*MsgTransferSpace))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBlockHeader)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBlockHeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/iritamod.side_chain.v1.Msg/CreateBlockHeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBlockHeader(ctx, req.(*MsgCreateBlockHeader))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgFreezeSpaceResponse defines the Msg/FreezeSpace response type.
+type MsgFreezeSpaceResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "iritamod.side_chain.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSpace",
-			Handler:    _Msg_CreateSpace_Handler,
-		},
-		{
-			MethodName: "TransferSpace",
-			Handler:    _Msg_TransferSpace_Handler,
-		},
-		{
-			MethodName: "CreateBlockHeader",
-			Handler:    _Msg_CreateBlockHeader_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "side-chain/v1/tx.proto",
+func (m *MsgFreezeSpaceResponse) Reset()         { *m = MsgFreezeSpaceResponse{} }
+func (m *MsgFreezeSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeSpaceResponse) ProtoMessage()    {}
+func (*MsgFreezeSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{11}
 }
-
-func (m *MsgCreateSpace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeSpaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFreezeSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeSpaceResponse.Merge(m, src)
+}
+func (m *MsgFreezeSpaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeSpaceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSpace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezeSpaceResponse proto.InternalMessageInfo
+
+// MsgUnfreezeSpace defines the Msg/UnfreezeSpace request type.
+type MsgUnfreezeSpace struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgCreateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Uri) > 0 {
-		i -= len(m.Uri)
-		copy(dAtA[i:], m.Uri)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnfreezeSpace) Reset()         { *m = MsgUnfreezeSpace{} }
+func (m *MsgUnfreezeSpace) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeSpace) ProtoMessage()    {}
+func (*MsgUnfreezeSpace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{12}
+}
+func (m *MsgUnfreezeSpace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeSpace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeSpace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnfreezeSpace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeSpace.Merge(m, src)
+}
+func (m *MsgUnfreezeSpace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeSpace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeSpace.DiscardUnknown(m)
 }
 
-func (m *MsgCreateSpaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnfreezeSpace proto.InternalMessageInfo
+
+func (m *MsgUnfreezeSpace) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnfreezeSpace) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgCreateSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgUnfreezeSpaceResponse defines the Msg/UnfreezeSpace response type.
+type MsgUnfreezeSpaceResponse struct {
 }
 
-func (m *MsgTransferSpace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnfreezeSpaceResponse) Reset()         { *m = MsgUnfreezeSpaceResponse{} }
+func (m *MsgUnfreezeSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeSpaceResponse) ProtoMessage()    {}
+func (*MsgUnfreezeSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{13}
+}
+func (m *MsgUnfreezeSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeSpaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnfreezeSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeSpaceResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeSpaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeSpaceResponse.DiscardUnknown(m)
 }
 
-func (m *MsgTransferSpace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnfreezeSpaceResponse proto.InternalMessageInfo
+
+// MsgArchiveSpace defines the Msg/ArchiveSpace request type.
+type MsgArchiveSpace struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Sender  string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgTransferSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgArchiveSpace) Reset()         { *m = MsgArchiveSpace{} }
+func (m *MsgArchiveSpace) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveSpace) ProtoMessage()    {}
+func (*MsgArchiveSpace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{14}
+}
+func (m *MsgArchiveSpace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveSpace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveSpace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgArchiveSpace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveSpace.Merge(m, src)
+}
+func (m *MsgArchiveSpace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveSpace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveSpace.DiscardUnknown(m)
 }
 
-func (m *MsgTransferSpaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgArchiveSpace proto.InternalMessageInfo
+
+func (m *MsgArchiveSpace) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgTransferSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgArchiveSpace) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgTransferSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgArchiveSpaceResponse defines the Msg/ArchiveSpace response type.
+type MsgArchiveSpaceResponse struct {
 }
 
-func (m *MsgCreateBlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgArchiveSpaceResponse) Reset()         { *m = MsgArchiveSpaceResponse{} }
+func (m *MsgArchiveSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveSpaceResponse) ProtoMessage()    {}
+func (*MsgArchiveSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{15}
+}
+func (m *MsgArchiveSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveSpaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateBlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgArchiveSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveSpaceResponse.Merge(m, src)
 }
-
-func (m *MsgCreateBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgArchiveSpaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveSpaceResponse proto.InternalMessageInfo
+
+// MsgRegisterValidatorSet defines the Msg/RegisterValidatorSet request type.
+type MsgRegisterValidatorSet struct {
+	SpaceId    uint64      `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Validators []Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	Sender     string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRegisterValidatorSet) Reset()         { *m = MsgRegisterValidatorSet{} }
+func (m *MsgRegisterValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterValidatorSet) ProtoMessage()    {}
+func (*MsgRegisterValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{16}
+}
+func (m *MsgRegisterValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Header) > 0 {
-		i -= len(m.Header)
-		copy(dAtA[i:], m.Header)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Header)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgRegisterValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterValidatorSet.Merge(m, src)
+}
+func (m *MsgRegisterValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterValidatorSet proto.InternalMessageInfo
+
+func (m *MsgRegisterValidatorSet) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *MsgRegisterValidatorSet) GetValidators() []Validator {
+	if m != nil {
+		return m.Validators
 	}
-	if m.SpaceId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *MsgRegisterValidatorSet) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgRegisterValidatorSetResponse defines the Msg/RegisterValidatorSet response type.
+type MsgRegisterValidatorSetResponse struct {
+}
+
+func (m *MsgRegisterValidatorSetResponse) Reset()         { *m = MsgRegisterValidatorSetResponse{} }
+func (m *MsgRegisterValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterValidatorSetResponse) ProtoMessage()    {}
+func (*MsgRegisterValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{17}
+}
+func (m *MsgRegisterValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgRegisterValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterValidatorSetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterValidatorSetResponse proto.InternalMessageInfo
+
+// MsgPruneBlockHeaders defines the Msg/PruneBlockHeaders request type.
+// The finalized block headers below the height are pruned
+type MsgPruneBlockHeaders struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Sender  string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgCreateBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPruneBlockHeaders) Reset()         { *m = MsgPruneBlockHeaders{} }
+func (m *MsgPruneBlockHeaders) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneBlockHeaders) ProtoMessage()    {}
+func (*MsgPruneBlockHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{18}
+}
+func (m *MsgPruneBlockHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneBlockHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneBlockHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneBlockHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneBlockHeaders.Merge(m, src)
+}
+func (m *MsgPruneBlockHeaders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneBlockHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneBlockHeaders.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgPruneBlockHeaders proto.InternalMessageInfo
+
+func (m *MsgPruneBlockHeaders) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgCreateSpace) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgPruneBlockHeaders) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgPruneBlockHeaders) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = len(m.Uri)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgPruneBlockHeadersResponse defines the Msg/PruneBlockHeaders response type.
+type MsgPruneBlockHeadersResponse struct {
+	Pruned uint64 `protobuf:"varint,1,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *MsgPruneBlockHeadersResponse) Reset()         { *m = MsgPruneBlockHeadersResponse{} }
+func (m *MsgPruneBlockHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneBlockHeadersResponse) ProtoMessage()    {}
+func (*MsgPruneBlockHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{19}
+}
+func (m *MsgPruneBlockHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneBlockHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneBlockHeadersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgPruneBlockHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneBlockHeadersResponse.Merge(m, src)
+}
+func (m *MsgPruneBlockHeadersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneBlockHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneBlockHeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneBlockHeadersResponse proto.InternalMessageInfo
+
+func (m *MsgPruneBlockHeadersResponse) GetPruned() uint64 {
+	if m != nil {
+		return m.Pruned
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCreateSpaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgCreateBlockHeader defines the Msg/CreateRecord request type.
+type MsgCreateBlockHeader struct {
+	SpaceId          uint64            `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height           uint64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Header           string            `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Sender           string            `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	StructuredHeader *StructuredHeader `protobuf:"bytes,5,opt,name=structured_header,json=structuredHeader,proto3" json:"structured_header,omitempty"`
+	Commit           *HeaderCommit     `protobuf:"bytes,6,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *MsgCreateBlockHeader) Reset()         { *m = MsgCreateBlockHeader{} }
+func (m *MsgCreateBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBlockHeader) ProtoMessage()    {}
+func (*MsgCreateBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{20}
+}
+func (m *MsgCreateBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
+}
+func (m *MsgCreateBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBlockHeader.Merge(m, src)
+}
+func (m *MsgCreateBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBlockHeader proto.InternalMessageInfo
+
+func (m *MsgCreateBlockHeader) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgTransferSpace) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateBlockHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
+	return 0
+}
+
+func (m *MsgCreateBlockHeader) GetHeader() string {
+	if m != nil {
+		return m.Header
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateBlockHeader) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgCreateBlockHeader) GetStructuredHeader() *StructuredHeader {
+	if m != nil {
+		return m.StructuredHeader
+	}
+	return nil
+}
+
+func (m *MsgCreateBlockHeader) GetCommit() *HeaderCommit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+// MsgCreateBlockHeaderResponse defines the Msg/CreateRecord response type.
+type MsgCreateBlockHeaderResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgCreateBlockHeaderResponse) Reset()         { *m = MsgCreateBlockHeaderResponse{} }
+func (m *MsgCreateBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBlockHeaderResponse) ProtoMessage()    {}
+func (*MsgCreateBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{21}
+}
+func (m *MsgCreateBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBlockHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBlockHeaderResponse.Merge(m, src)
+}
+func (m *MsgCreateBlockHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBlockHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBlockHeaderResponse proto.InternalMessageInfo
+
+func (m *MsgCreateBlockHeaderResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// MsgCreateBlockHeaders defines the Msg/CreateBlockHeaders request type.
+// The headers are created at the contiguous heights starting from the start height
+type MsgCreateBlockHeaders struct {
+	SpaceId     uint64             `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	StartHeight uint64             `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Headers     []BatchBlockHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers"`
+	Sender      string             `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCreateBlockHeaders) Reset()         { *m = MsgCreateBlockHeaders{} }
+func (m *MsgCreateBlockHeaders) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBlockHeaders) ProtoMessage()    {}
+func (*MsgCreateBlockHeaders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{22}
+}
+func (m *MsgCreateBlockHeaders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBlockHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBlockHeaders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBlockHeaders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBlockHeaders.Merge(m, src)
+}
+func (m *MsgCreateBlockHeaders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBlockHeaders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBlockHeaders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBlockHeaders proto.InternalMessageInfo
+
+func (m *MsgCreateBlockHeaders) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *MsgCreateBlockHeaders) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateBlockHeaders) GetHeaders() []BatchBlockHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *MsgCreateBlockHeaders) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// BatchBlockHeader defines a block header in the batch
+type BatchBlockHeader struct {
+	Header           string            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	StructuredHeader *StructuredHeader `protobuf:"bytes,2,opt,name=structured_header,json=structuredHeader,proto3" json:"structured_header,omitempty"`
+	Commit           *HeaderCommit     `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+}
+
+func (m *BatchBlockHeader) Reset()         { *m = BatchBlockHeader{} }
+func (m *BatchBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BatchBlockHeader) ProtoMessage()    {}
+func (*BatchBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{23}
+}
+func (m *BatchBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchBlockHeader.Merge(m, src)
+}
+func (m *BatchBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchBlockHeader proto.InternalMessageInfo
+
+func (m *BatchBlockHeader) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *BatchBlockHeader) GetStructuredHeader() *StructuredHeader {
+	if m != nil {
+		return m.StructuredHeader
+	}
+	return nil
+}
+
+func (m *BatchBlockHeader) GetCommit() *HeaderCommit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+// MsgCreateBlockHeadersResponse defines the Msg/CreateBlockHeaders response type.
+type MsgCreateBlockHeadersResponse struct {
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (m *MsgCreateBlockHeadersResponse) Reset()         { *m = MsgCreateBlockHeadersResponse{} }
+func (m *MsgCreateBlockHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBlockHeadersResponse) ProtoMessage()    {}
+func (*MsgCreateBlockHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{24}
+}
+func (m *MsgCreateBlockHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBlockHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBlockHeadersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBlockHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBlockHeadersResponse.Merge(m, src)
+}
+func (m *MsgCreateBlockHeadersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBlockHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBlockHeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBlockHeadersResponse proto.InternalMessageInfo
+
+func (m *MsgCreateBlockHeadersResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+// MsgAddSubmitter defines the Msg/AddSubmitter request type.
+type MsgAddSubmitter struct {
+	SpaceId     uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Submitter   string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	MinHeight   uint64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight   uint64 `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	MaxPerBlock uint64 `protobuf:"varint,5,opt,name=max_per_block,json=maxPerBlock,proto3" json:"max_per_block,omitempty"`
+	Sender      string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgAddSubmitter) Reset()         { *m = MsgAddSubmitter{} }
+func (m *MsgAddSubmitter) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSubmitter) ProtoMessage()    {}
+func (*MsgAddSubmitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{25}
+}
+func (m *MsgAddSubmitter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSubmitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSubmitter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSubmitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSubmitter.Merge(m, src)
+}
+func (m *MsgAddSubmitter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSubmitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSubmitter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSubmitter proto.InternalMessageInfo
+
+func (m *MsgAddSubmitter) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *MsgAddSubmitter) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgAddSubmitter) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *MsgAddSubmitter) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *MsgAddSubmitter) GetMaxPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPerBlock
+	}
+	return 0
+}
+
+func (m *MsgAddSubmitter) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgAddSubmitterResponse defines the Msg/AddSubmitter response type.
+type MsgAddSubmitterResponse struct {
+}
+
+func (m *MsgAddSubmitterResponse) Reset()         { *m = MsgAddSubmitterResponse{} }
+func (m *MsgAddSubmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSubmitterResponse) ProtoMessage()    {}
+func (*MsgAddSubmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{26}
+}
+func (m *MsgAddSubmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSubmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSubmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSubmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSubmitterResponse.Merge(m, src)
+}
+func (m *MsgAddSubmitterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSubmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSubmitterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSubmitterResponse proto.InternalMessageInfo
+
+// MsgRemoveSubmitter defines the Msg/RemoveSubmitter request type.
+type MsgRemoveSubmitter struct {
+	SpaceId   uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	Sender    string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRemoveSubmitter) Reset()         { *m = MsgRemoveSubmitter{} }
+func (m *MsgRemoveSubmitter) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSubmitter) ProtoMessage()    {}
+func (*MsgRemoveSubmitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{27}
+}
+func (m *MsgRemoveSubmitter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSubmitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSubmitter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSubmitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSubmitter.Merge(m, src)
+}
+func (m *MsgRemoveSubmitter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSubmitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSubmitter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSubmitter proto.InternalMessageInfo
+
+func (m *MsgRemoveSubmitter) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *MsgRemoveSubmitter) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgRemoveSubmitter) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgRemoveSubmitterResponse defines the Msg/RemoveSubmitter response type.
+type MsgRemoveSubmitterResponse struct {
+}
+
+func (m *MsgRemoveSubmitterResponse) Reset()         { *m = MsgRemoveSubmitterResponse{} }
+func (m *MsgRemoveSubmitterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSubmitterResponse) ProtoMessage()    {}
+func (*MsgRemoveSubmitterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{28}
+}
+func (m *MsgRemoveSubmitterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSubmitterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSubmitterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSubmitterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSubmitterResponse.Merge(m, src)
+}
+func (m *MsgRemoveSubmitterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSubmitterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSubmitterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSubmitterResponse proto.InternalMessageInfo
+
+// MsgChallengeBlockHeader defines the Msg/ChallengeBlockHeader request type.
+type MsgChallengeBlockHeader struct {
+	SpaceId           uint64             `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height            uint64             `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ConflictingHeader *ConflictingHeader `protobuf:"bytes,3,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty"`
+	Evidence          string             `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Challenger        string             `protobuf:"bytes,5,opt,name=challenger,proto3" json:"challenger,omitempty"`
+}
+
+func (m *MsgChallengeBlockHeader) Reset()         { *m = MsgChallengeBlockHeader{} }
+func (m *MsgChallengeBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeBlockHeader) ProtoMessage()    {}
+func (*MsgChallengeBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{29}
+}
+func (m *MsgChallengeBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChallengeBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeBlockHeader.Merge(m, src)
+}
+func (m *MsgChallengeBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChallengeBlockHeader proto.InternalMessageInfo
+
+func (m *MsgChallengeBlockHeader) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *MsgChallengeBlockHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgChallengeBlockHeader) GetConflictingHeader() *ConflictingHeader {
+	if m != nil {
+		return m.ConflictingHeader
+	}
+	return nil
+}
+
+func (m *MsgChallengeBlockHeader) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *MsgChallengeBlockHeader) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+// MsgChallengeBlockHeaderResponse defines the Msg/ChallengeBlockHeader response type.
+type MsgChallengeBlockHeaderResponse struct {
+}
+
+func (m *MsgChallengeBlockHeaderResponse) Reset()         { *m = MsgChallengeBlockHeaderResponse{} }
+func (m *MsgChallengeBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChallengeBlockHeaderResponse) ProtoMessage()    {}
+func (*MsgChallengeBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{30}
+}
+func (m *MsgChallengeBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChallengeBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChallengeBlockHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChallengeBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChallengeBlockHeaderResponse.Merge(m, src)
+}
+func (m *MsgChallengeBlockHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChallengeBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChallengeBlockHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChallengeBlockHeaderResponse proto.InternalMessageInfo
+
+// MsgResolveChallenge defines the Msg/ResolveChallenge request type.
+type MsgResolveChallenge struct {
+	SpaceId uint64 `protobuf:"varint,1,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// whether the challenge is upheld, replacing the challenged header with the conflicting header.
+	// Otherwise the challenged header is kept
+	Upheld bool   `protobuf:"varint,3,opt,name=upheld,proto3" json:"upheld,omitempty"`
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgResolveChallenge) Reset()         { *m = MsgResolveChallenge{} }
+func (m *MsgResolveChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveChallenge) ProtoMessage()    {}
+func (*MsgResolveChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{31}
+}
+func (m *MsgResolveChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveChallenge.Merge(m, src)
+}
+func (m *MsgResolveChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveChallenge proto.InternalMessageInfo
+
+func (m *MsgResolveChallenge) GetSpaceId() uint64 {
+	if m != nil {
+		return m.SpaceId
+	}
+	return 0
+}
+
+func (m *MsgResolveChallenge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgResolveChallenge) GetUpheld() bool {
+	if m != nil {
+		return m.Upheld
+	}
+	return false
+}
+
+func (m *MsgResolveChallenge) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgResolveChallengeResponse defines the Msg/ResolveChallenge response type.
+type MsgResolveChallengeResponse struct {
+}
+
+func (m *MsgResolveChallengeResponse) Reset()         { *m = MsgResolveChallengeResponse{} }
+func (m *MsgResolveChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveChallengeResponse) ProtoMessage()    {}
+func (*MsgResolveChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928006f8a682ca0e, []int{32}
+}
+func (m *MsgResolveChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveChallengeResponse.Merge(m, src)
+}
+func (m *MsgResolveChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveChallengeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateSpace)(nil), "iritamod.side_chain.v1.MsgCreateSpace")
+	proto.RegisterType((*MsgCreateSpaceResponse)(nil), "iritamod.side_chain.v1.MsgCreateSpaceResponse")
+	proto.RegisterType((*MsgTransferSpace)(nil), "iritamod.side_chain.v1.MsgTransferSpace")
+	proto.RegisterType((*MsgTransferSpaceResponse)(nil), "iritamod.side_chain.v1.MsgTransferSpaceResponse")
+	proto.RegisterType((*MsgAcceptSpaceTransfer)(nil), "iritamod.side_chain.v1.MsgAcceptSpaceTransfer")
+	proto.RegisterType((*MsgAcceptSpaceTransferResponse)(nil), "iritamod.side_chain.v1.MsgAcceptSpaceTransferResponse")
+	proto.RegisterType((*MsgCancelSpaceTransfer)(nil), "iritamod.side_chain.v1.MsgCancelSpaceTransfer")
+	proto.RegisterType((*MsgCancelSpaceTransferResponse)(nil), "iritamod.side_chain.v1.MsgCancelSpaceTransferResponse")
+	proto.RegisterType((*MsgUpdateSpace)(nil), "iritamod.side_chain.v1.MsgUpdateSpace")
+	proto.RegisterType((*MsgUpdateSpaceResponse)(nil), "iritamod.side_chain.v1.MsgUpdateSpaceResponse")
+	proto.RegisterType((*MsgFreezeSpace)(nil), "iritamod.side_chain.v1.MsgFreezeSpace")
+	proto.RegisterType((*MsgFreezeSpaceResponse)(nil), "iritamod.side_chain.v1.MsgFreezeSpaceResponse")
+	proto.RegisterType((*MsgUnfreezeSpace)(nil), "iritamod.side_chain.v1.MsgUnfreezeSpace")
+	proto.RegisterType((*MsgUnfreezeSpaceResponse)(nil), "iritamod.side_chain.v1.MsgUnfreezeSpaceResponse")
+	proto.RegisterType((*MsgArchiveSpace)(nil), "iritamod.side_chain.v1.MsgArchiveSpace")
+	proto.RegisterType((*MsgArchiveSpaceResponse)(nil), "iritamod.side_chain.v1.MsgArchiveSpaceResponse")
+	proto.RegisterType((*MsgRegisterValidatorSet)(nil), "iritamod.side_chain.v1.MsgRegisterValidatorSet")
+	proto.RegisterType((*MsgRegisterValidatorSetResponse)(nil), "iritamod.side_chain.v1.MsgRegisterValidatorSetResponse")
+	proto.RegisterType((*MsgPruneBlockHeaders)(nil), "iritamod.side_chain.v1.MsgPruneBlockHeaders")
+	proto.RegisterType((*MsgPruneBlockHeadersResponse)(nil), "iritamod.side_chain.v1.MsgPruneBlockHeadersResponse")
+	proto.RegisterType((*MsgCreateBlockHeader)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeader")
+	proto.RegisterType((*MsgCreateBlockHeaderResponse)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeaderResponse")
+	proto.RegisterType((*MsgCreateBlockHeaders)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeaders")
+	proto.RegisterType((*BatchBlockHeader)(nil), "iritamod.side_chain.v1.BatchBlockHeader")
+	proto.RegisterType((*MsgCreateBlockHeadersResponse)(nil), "iritamod.side_chain.v1.MsgCreateBlockHeadersResponse")
+	proto.RegisterType((*MsgAddSubmitter)(nil), "iritamod.side_chain.v1.MsgAddSubmitter")
+	proto.RegisterType((*MsgAddSubmitterResponse)(nil), "iritamod.side_chain.v1.MsgAddSubmitterResponse")
+	proto.RegisterType((*MsgRemoveSubmitter)(nil), "iritamod.side_chain.v1.MsgRemoveSubmitter")
+	proto.RegisterType((*MsgRemoveSubmitterResponse)(nil), "iritamod.side_chain.v1.MsgRemoveSubmitterResponse")
+	proto.RegisterType((*MsgChallengeBlockHeader)(nil), "iritamod.side_chain.v1.MsgChallengeBlockHeader")
+	proto.RegisterType((*MsgChallengeBlockHeaderResponse)(nil), "iritamod.side_chain.v1.MsgChallengeBlockHeaderResponse")
+	proto.RegisterType((*MsgResolveChallenge)(nil), "iritamod.side_chain.v1.MsgResolveChallenge")
+	proto.RegisterType((*MsgResolveChallengeResponse)(nil), "iritamod.side_chain.v1.MsgResolveChallengeResponse")
+}
+
+func init() { proto.RegisterFile("side-chain/v1/tx.proto", fileDescriptor_928006f8a682ca0e) }
+
+var fileDescriptor_928006f8a682ca0e = []byte{
+	// 1224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0xaf, 0x93, 0x7c, 0xb3, 0x9b, 0x97, 0x76, 0x9b, 0x7a, 0xfb, 0x0d, 0x59, 0xd3, 0x66, 0x53,
+	0x83, 0x20, 0x0b, 0xbb, 0x49, 0x9b, 0x2e, 0xed, 0x85, 0x0b, 0x0d, 0x3f, 0x8a, 0x50, 0xa5, 0x2a,
+	0xa5, 0x08, 0x71, 0x89, 0x5c, 0x7b, 0x1a, 0x8f, 0x36, 0xfe, 0x81, 0x67, 0x12, 0xd2, 0x95, 0x90,
+	0x38, 0x72, 0x42, 0x5c, 0xf8, 0x5b, 0x38, 0x72, 0x80, 0xc3, 0x1e, 0xf7, 0xc8, 0x09, 0xa1, 0xf6,
+	0x1f, 0x41, 0x1e, 0xdb, 0xd3, 0x71, 0x62, 0xbb, 0x49, 0xb7, 0x37, 0xcf, 0x9b, 0xcf, 0x7b, 0x9f,
+	0xf7, 0xe6, 0xbd, 0x79, 0xf3, 0x64, 0xa8, 0x12, 0x6c, 0xa0, 0x67, 0xba, 0xa9, 0x61, 0xbb, 0x3d,
+	0xde, 0x69, 0xd3, 0x49, 0xcb, 0xf5, 0x1c, 0xea, 0xc8, 0x55, 0xec, 0x61, 0xaa, 0x59, 0x8e, 0xd1,
+	0xf2, 0x01, 0x7d, 0x06, 0x68, 0x8d, 0x77, 0x94, 0xf5, 0x81, 0x33, 0x70, 0x18, 0xa4, 0xed, 0x7f,
+	0x05, 0x68, 0xa5, 0x1e, 0xb7, 0x72, 0xbd, 0x0a, 0xf6, 0xd5, 0x0b, 0x78, 0x70, 0x44, 0x06, 0x5d,
+	0x0f, 0x69, 0x14, 0x9d, 0xb8, 0x9a, 0x8e, 0x64, 0x19, 0x0a, 0xb6, 0x66, 0xa1, 0x9a, 0xd4, 0x90,
+	0x9a, 0xa5, 0x1e, 0xfb, 0x96, 0x2b, 0x90, 0x1f, 0x79, 0xb8, 0x96, 0x63, 0x22, 0xff, 0x53, 0xae,
+	0x42, 0x91, 0x20, 0xdb, 0x40, 0x5e, 0x2d, 0xcf, 0x84, 0xe1, 0x4a, 0x7e, 0x02, 0x15, 0xdd, 0xd4,
+	0x86, 0x43, 0x64, 0x0f, 0x50, 0xdf, 0x45, 0x1e, 0x76, 0x8c, 0x5a, 0xa1, 0x21, 0x35, 0x0b, 0xbd,
+	0x55, 0x2e, 0x3f, 0x66, 0x62, 0x75, 0x17, 0xaa, 0x71, 0xea, 0x1e, 0x22, 0xae, 0x63, 0x13, 0x24,
+	0x3f, 0x82, 0xfb, 0xc4, 0x17, 0xf4, 0xb1, 0xc1, 0xdc, 0x28, 0xf4, 0xee, 0xb1, 0xf5, 0x97, 0x86,
+	0xfa, 0xb3, 0x04, 0x95, 0x23, 0x32, 0xf8, 0xda, 0xd3, 0x6c, 0x72, 0x8e, 0xbc, 0xc0, 0xe5, 0x74,
+	0xbc, 0xbc, 0x01, 0x25, 0x0f, 0xe9, 0xd8, 0xc5, 0xc8, 0xa6, 0xa1, 0xff, 0xd7, 0x82, 0xd4, 0x28,
+	0xde, 0x81, 0x15, 0x34, 0x71, 0xb1, 0x77, 0xd1, 0x37, 0x11, 0x1e, 0x98, 0x34, 0x0c, 0x61, 0x39,
+	0x10, 0x1e, 0x32, 0x99, 0xaa, 0x40, 0x6d, 0xda, 0x93, 0x28, 0x02, 0xf5, 0x2b, 0x16, 0xdb, 0x27,
+	0xba, 0x8e, 0x5c, 0xca, 0x76, 0x22, 0x58, 0x96, 0xaf, 0xd7, 0xde, 0xe4, 0x44, 0x6f, 0xd4, 0x06,
+	0xd4, 0x93, 0x8d, 0x4d, 0xd1, 0x75, 0x35, 0x5b, 0x47, 0xc3, 0x3b, 0xa2, 0x4b, 0x30, 0xc6, 0xe9,
+	0x30, 0x2b, 0x9a, 0x53, 0xd7, 0xe0, 0x45, 0x93, 0x41, 0x13, 0xd5, 0x53, 0x6e, 0xb6, 0x9e, 0xf2,
+	0x49, 0xf5, 0x54, 0x88, 0x39, 0x53, 0x83, 0x6a, 0x9c, 0x8a, 0x3b, 0xd1, 0x65, 0x4e, 0x7c, 0xee,
+	0x21, 0xf4, 0xf2, 0x66, 0x27, 0xd2, 0x62, 0x0d, 0xcc, 0x0b, 0x46, 0xb8, 0xf9, 0xcf, 0x58, 0x9d,
+	0x9d, 0xda, 0xe7, 0x6f, 0x46, 0x10, 0x14, 0x49, 0xcc, 0x0c, 0xa7, 0xf8, 0x14, 0x56, 0xfd, 0xbc,
+	0x7a, 0xba, 0x89, 0xc7, 0xb7, 0x67, 0x78, 0x04, 0x6f, 0x4d, 0x59, 0xe1, 0x04, 0xbf, 0x49, 0x6c,
+	0xaf, 0x87, 0x06, 0x98, 0x50, 0xe4, 0x7d, 0xa3, 0x0d, 0xb1, 0xa1, 0x51, 0xc7, 0x3b, 0x41, 0x34,
+	0x8b, 0xe9, 0x0b, 0x80, 0x71, 0x04, 0x25, 0xb5, 0x5c, 0x23, 0xdf, 0x2c, 0x77, 0xb6, 0x5a, 0xc9,
+	0x6d, 0xa7, 0xc5, 0x8d, 0x1e, 0x14, 0x5e, 0xfd, 0xf3, 0x78, 0xa9, 0x27, 0xa8, 0xa6, 0x5d, 0x2f,
+	0x75, 0x0b, 0x1e, 0xa7, 0xb8, 0xc5, 0x5d, 0xd7, 0x60, 0xfd, 0x88, 0x0c, 0x8e, 0xbd, 0x91, 0x8d,
+	0x0e, 0x86, 0x8e, 0xfe, 0xe2, 0x10, 0x69, 0x06, 0xf2, 0xc8, 0x0d, 0x07, 0x14, 0xde, 0xd6, 0x1c,
+	0xdb, 0x08, 0x57, 0xa9, 0x5e, 0xec, 0xc1, 0x46, 0x12, 0x05, 0xef, 0x42, 0x55, 0x28, 0xba, 0xfe,
+	0x66, 0x44, 0x14, 0xae, 0xd4, 0x5f, 0x72, 0xb0, 0xce, 0x1b, 0x97, 0xa0, 0x79, 0x4b, 0xdf, 0x4c,
+	0xa4, 0x09, 0xbe, 0x05, 0xab, 0xb4, 0xeb, 0x20, 0x9f, 0xc2, 0x1a, 0xa1, 0xde, 0x48, 0xa7, 0x23,
+	0x0f, 0x19, 0xfd, 0x50, 0xf5, 0x7f, 0x0d, 0xa9, 0x59, 0xee, 0x34, 0xd3, 0x32, 0x74, 0xc2, 0x15,
+	0x02, 0x3f, 0x7b, 0x15, 0x32, 0x25, 0x91, 0x3f, 0x86, 0xa2, 0xee, 0x58, 0x16, 0xa6, 0xb5, 0x22,
+	0xb3, 0xf5, 0x6e, 0x9a, 0xad, 0x00, 0xdf, 0x65, 0xd8, 0x5e, 0xa8, 0xa3, 0x76, 0x60, 0x23, 0xe9,
+	0x3c, 0xf8, 0x41, 0xca, 0x50, 0x30, 0x35, 0x62, 0x46, 0x2f, 0x8a, 0xff, 0xad, 0xfe, 0x2e, 0xc1,
+	0xff, 0x93, 0x94, 0x32, 0x33, 0xbc, 0x05, 0xcb, 0x84, 0x6a, 0x1e, 0xed, 0xc7, 0xce, 0xb2, 0xcc,
+	0x64, 0x41, 0x53, 0x96, 0x0f, 0xe1, 0x5e, 0x70, 0x2a, 0xa4, 0x96, 0x6f, 0xe4, 0xb3, 0x8e, 0xe5,
+	0x40, 0xa3, 0xba, 0x29, 0x30, 0x87, 0xf5, 0x1b, 0xa9, 0xa7, 0x76, 0xa4, 0x3f, 0x24, 0xa8, 0x4c,
+	0xeb, 0x0a, 0x79, 0x94, 0x62, 0x79, 0x4c, 0xcc, 0x57, 0xee, 0x0e, 0xf3, 0x95, 0xbf, 0x45, 0xbe,
+	0xf6, 0x61, 0x33, 0xf1, 0xe8, 0xc5, 0xca, 0xf7, 0x93, 0x84, 0x48, 0x4d, 0x6a, 0xe4, 0x59, 0x34,
+	0x6c, 0xa5, 0xfe, 0x25, 0x05, 0x1d, 0xcb, 0x30, 0x4e, 0x46, 0x67, 0x16, 0xa6, 0x34, 0xbb, 0xe8,
+	0x37, 0xa0, 0x44, 0x22, 0x5c, 0xf4, 0xf6, 0x72, 0x81, 0xbc, 0x09, 0x60, 0x61, 0x3b, 0x4a, 0x65,
+	0x9e, 0xa9, 0x96, 0x2c, 0x6c, 0x87, 0x89, 0xf4, 0xb7, 0xb5, 0x49, 0xfc, 0xfd, 0x2d, 0x59, 0xda,
+	0x24, 0xdc, 0x56, 0x61, 0xc5, 0xdf, 0x76, 0x91, 0xd7, 0x3f, 0xf3, 0x43, 0x60, 0x97, 0xa0, 0xd0,
+	0x2b, 0x5b, 0xda, 0xe4, 0x18, 0x79, 0x2c, 0x2a, 0x21, 0x83, 0xc5, 0xa4, 0x8e, 0x29, 0x44, 0xc1,
+	0xdb, 0x0e, 0x02, 0x99, 0x75, 0x26, 0xcb, 0x19, 0xa3, 0x3b, 0x88, 0x31, 0xad, 0xf5, 0x6c, 0x80,
+	0x32, 0x4b, 0xc3, 0x9d, 0xb8, 0x0c, 0xda, 0x76, 0x37, 0x9a, 0x97, 0xde, 0xb0, 0xc7, 0x7c, 0x0b,
+	0xb2, 0xee, 0xd8, 0xe7, 0x43, 0xac, 0x53, 0x6c, 0x0f, 0xfa, 0x42, 0xbf, 0x29, 0x77, 0x9e, 0xa4,
+	0x15, 0x4e, 0xf7, 0x5a, 0x23, 0xac, 0xc2, 0x35, 0x7d, 0x5a, 0x24, 0x2b, 0x70, 0x1f, 0x8d, 0xb1,
+	0x81, 0x6c, 0x1d, 0x85, 0x97, 0x84, 0xaf, 0xe5, 0x3a, 0x00, 0x1f, 0xf8, 0x82, 0x16, 0x55, 0xea,
+	0x09, 0x92, 0xf0, 0x0d, 0x48, 0x8a, 0x91, 0x9f, 0xc3, 0x04, 0x1e, 0xb2, 0x53, 0x22, 0xce, 0x70,
+	0x8c, 0x38, 0xf2, 0x96, 0x6d, 0x76, 0xe4, 0x9a, 0x68, 0x68, 0xb0, 0xb0, 0xef, 0xf7, 0xc2, 0x55,
+	0xea, 0x1d, 0xdf, 0x84, 0xb7, 0x13, 0x98, 0x23, 0xc7, 0x3a, 0x7f, 0x3e, 0x80, 0xfc, 0x11, 0x19,
+	0xc8, 0x08, 0xca, 0xe2, 0xe4, 0xfc, 0x5e, 0xda, 0x61, 0xc6, 0xc7, 0x5c, 0xa5, 0x35, 0x1f, 0x8e,
+	0x5f, 0xc7, 0x17, 0xb0, 0x12, 0x9f, 0x77, 0x9b, 0x19, 0x06, 0x62, 0x48, 0x65, 0x7b, 0x5e, 0x24,
+	0x27, 0xfb, 0x11, 0x1e, 0x26, 0x8d, 0xad, 0x59, 0x3e, 0x27, 0xe0, 0x95, 0xbd, 0xc5, 0xf0, 0x22,
+	0x7d, 0xd2, 0x18, 0x9b, 0x79, 0x64, 0xb3, 0x78, 0x65, 0x6f, 0x31, 0x3c, 0xa7, 0x47, 0x50, 0x16,
+	0xc7, 0xda, 0xac, 0x8c, 0x0a, 0x38, 0xa5, 0x35, 0x1f, 0x4e, 0xa4, 0x11, 0x07, 0xd7, 0x2c, 0x1a,
+	0x01, 0xa7, 0xb4, 0xe6, 0xc3, 0x89, 0x85, 0x13, 0x1f, 0x60, 0xb3, 0x0a, 0x27, 0x86, 0x54, 0xb6,
+	0xe7, 0x45, 0x72, 0x32, 0x13, 0x96, 0x63, 0xa3, 0xec, 0xfb, 0x59, 0x15, 0x20, 0x00, 0x95, 0xf6,
+	0x9c, 0x40, 0xce, 0xf4, 0x93, 0x04, 0xeb, 0x89, 0x33, 0x6d, 0x96, 0xa5, 0x24, 0x05, 0x65, 0x7f,
+	0x41, 0x05, 0xee, 0xc2, 0x0f, 0xb0, 0x36, 0x3b, 0x9b, 0x3e, 0xcd, 0xb0, 0x36, 0x83, 0x56, 0x9e,
+	0x2f, 0x82, 0x16, 0x89, 0x67, 0x07, 0xcf, 0xa7, 0x37, 0x36, 0x14, 0x01, 0xad, 0x3c, 0x5f, 0x04,
+	0xcd, 0x89, 0x5f, 0x82, 0x9c, 0x30, 0xac, 0x3d, 0x5b, 0xc4, 0x16, 0x51, 0x3e, 0x5a, 0x08, 0x1e,
+	0x2b, 0x2d, 0x71, 0xe6, 0xc8, 0x2c, 0x2d, 0x01, 0xa8, 0xb4, 0xe7, 0x04, 0x72, 0xa6, 0xef, 0x61,
+	0x75, 0xfa, 0xf1, 0xff, 0x20, 0xb3, 0x46, 0x62, 0x58, 0xa5, 0x33, 0x3f, 0x36, 0x56, 0xcd, 0x89,
+	0x4f, 0x7d, 0x96, 0xf3, 0x49, 0x0a, 0xca, 0xfe, 0x82, 0x0a, 0xdc, 0x05, 0x0a, 0x95, 0x99, 0x57,
+	0xf6, 0xc3, 0xcc, 0x50, 0xe2, 0x60, 0x65, 0x77, 0x01, 0x70, 0xc4, 0x7a, 0x70, 0xfc, 0xea, 0xb2,
+	0x2e, 0xbd, 0xbe, 0xac, 0x4b, 0xff, 0x5e, 0xd6, 0xa5, 0x5f, 0xaf, 0xea, 0x4b, 0xaf, 0xaf, 0xea,
+	0x4b, 0x7f, 0x5f, 0xd5, 0x97, 0xbe, 0xdb, 0x1b, 0x60, 0x6a, 0x8e, 0xce, 0x5a, 0xba, 0x63, 0xb5,
+	0x35, 0xcd, 0x30, 0xf1, 0xf6, 0xde, 0x4e, 0xa7, 0x1d, 0x51, 0xb4, 0x2d, 0xc7, 0x18, 0x0d, 0x11,
+	0x11, 0x7e, 0x66, 0xb5, 0xe9, 0x85, 0x8b, 0xc8, 0x59, 0x91, 0xfd, 0xd3, 0xda, 0xfd, 0x6f, 0x00,
+	0x2c, 0x4d, 0x93, 0xd0, 0x3b, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateSpace defines a method for creating a space
+	CreateSpace(ctx context.Context, in *MsgCreateSpace, opts ...grpc.CallOption) (*MsgCreateSpaceResponse, error)
+	// TransferSpace defines a method for proposing the transfer of a space, which must be accepted by the recipient
+	TransferSpace(ctx context.Context, in *MsgTransferSpace, opts ...grpc.CallOption) (*MsgTransferSpaceResponse, error)
+	// AcceptSpaceTransfer defines a method for accepting a pending transfer of a space
+	AcceptSpaceTransfer(ctx context.Context, in *MsgAcceptSpaceTransfer, opts ...grpc.CallOption) (*MsgAcceptSpaceTransferResponse, error)
+	// CancelSpaceTransfer defines a method for cancelling a pending transfer of a space
+	CancelSpaceTransfer(ctx context.Context, in *MsgCancelSpaceTransfer, opts ...grpc.CallOption) (*MsgCancelSpaceTransferResponse, error)
+	// UpdateSpace defines a method for updating the name and uri of a space
+	UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error)
+	// FreezeSpace defines a method for freezing a space, which rejects the block headers
+	FreezeSpace(ctx context.Context, in *MsgFreezeSpace, opts ...grpc.CallOption) (*MsgFreezeSpaceResponse, error)
+	// UnfreezeSpace defines a method for unfreezing a frozen space
+	UnfreezeSpace(ctx context.Context, in *MsgUnfreezeSpace, opts ...grpc.CallOption) (*MsgUnfreezeSpaceResponse, error)
+	// ArchiveSpace defines a method for retiring a space permanently
+	ArchiveSpace(ctx context.Context, in *MsgArchiveSpace, opts ...grpc.CallOption) (*MsgArchiveSpaceResponse, error)
+	// RegisterValidatorSet defines a method for registering the initial validator set of the side chain in a space
+	RegisterValidatorSet(ctx context.Context, in *MsgRegisterValidatorSet, opts ...grpc.CallOption) (*MsgRegisterValidatorSetResponse, error)
+	// PruneBlockHeaders defines a method for pruning the block headers of a space below a height
+	PruneBlockHeaders(ctx context.Context, in *MsgPruneBlockHeaders, opts ...grpc.CallOption) (*MsgPruneBlockHeadersResponse, error)
+	// CreateBlockHeader defines a method for creating a record
+	CreateBlockHeader(ctx context.Context, in *MsgCreateBlockHeader, opts ...grpc.CallOption) (*MsgCreateBlockHeaderResponse, error)
+	// CreateBlockHeaders defines a method for creating a contiguous range of layer2 block headers atomically
+	CreateBlockHeaders(ctx context.Context, in *MsgCreateBlockHeaders, opts ...grpc.CallOption) (*MsgCreateBlockHeadersResponse, error)
+	// AddSubmitter defines a method for authorizing a block header submitter of a space
+	AddSubmitter(ctx context.Context, in *MsgAddSubmitter, opts ...grpc.CallOption) (*MsgAddSubmitterResponse, error)
+	// RemoveSubmitter defines a method for revoking a block header submitter of a space
+	RemoveSubmitter(ctx context.Context, in *MsgRemoveSubmitter, opts ...grpc.CallOption) (*MsgRemoveSubmitterResponse, error)
+	// ChallengeBlockHeader defines a method for challenging a pending block header
+	ChallengeBlockHeader(ctx context.Context, in *MsgChallengeBlockHeader, opts ...grpc.CallOption) (*MsgChallengeBlockHeaderResponse, error)
+	// ResolveChallenge defines a method for resolving a challenged block header
+	ResolveChallenge(ctx context.Context, in *MsgResolveChallenge, opts ...grpc.CallOption) (*MsgResolveChallengeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSpace(ctx context.Context, in *MsgCreateSpace, opts ...grpc.CallOption) (*MsgCreateSpaceResponse, error) {
+	out := new(MsgCreateSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CreateSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferSpace(ctx context.Context, in *MsgTransferSpace, opts ...grpc.CallOption) (*MsgTransferSpaceResponse, error) {
+	out := new(MsgTransferSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/TransferSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptSpaceTransfer(ctx context.Context, in *MsgAcceptSpaceTransfer, opts ...grpc.CallOption) (*MsgAcceptSpaceTransferResponse, error) {
+	out := new(MsgAcceptSpaceTransferResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/AcceptSpaceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSpaceTransfer(ctx context.Context, in *MsgCancelSpaceTransfer, opts ...grpc.CallOption) (*MsgCancelSpaceTransferResponse, error) {
+	out := new(MsgCancelSpaceTransferResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CancelSpaceTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSpace(ctx context.Context, in *MsgUpdateSpace, opts ...grpc.CallOption) (*MsgUpdateSpaceResponse, error) {
+	out := new(MsgUpdateSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/UpdateSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeSpace(ctx context.Context, in *MsgFreezeSpace, opts ...grpc.CallOption) (*MsgFreezeSpaceResponse, error) {
+	out := new(MsgFreezeSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/FreezeSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeSpace(ctx context.Context, in *MsgUnfreezeSpace, opts ...grpc.CallOption) (*MsgUnfreezeSpaceResponse, error) {
+	out := new(MsgUnfreezeSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/UnfreezeSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveSpace(ctx context.Context, in *MsgArchiveSpace, opts ...grpc.CallOption) (*MsgArchiveSpaceResponse, error) {
+	out := new(MsgArchiveSpaceResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/ArchiveSpace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterValidatorSet(ctx context.Context, in *MsgRegisterValidatorSet, opts ...grpc.CallOption) (*MsgRegisterValidatorSetResponse, error) {
+	out := new(MsgRegisterValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/RegisterValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneBlockHeaders(ctx context.Context, in *MsgPruneBlockHeaders, opts ...grpc.CallOption) (*MsgPruneBlockHeadersResponse, error) {
+	out := new(MsgPruneBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/PruneBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBlockHeader(ctx context.Context, in *MsgCreateBlockHeader, opts ...grpc.CallOption) (*MsgCreateBlockHeaderResponse, error) {
+	out := new(MsgCreateBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CreateBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateBlockHeaders(ctx context.Context, in *MsgCreateBlockHeaders, opts ...grpc.CallOption) (*MsgCreateBlockHeadersResponse, error) {
+	out := new(MsgCreateBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/CreateBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddSubmitter(ctx context.Context, in *MsgAddSubmitter, opts ...grpc.CallOption) (*MsgAddSubmitterResponse, error) {
+	out := new(MsgAddSubmitterResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/AddSubmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSubmitter(ctx context.Context, in *MsgRemoveSubmitter, opts ...grpc.CallOption) (*MsgRemoveSubmitterResponse, error) {
+	out := new(MsgRemoveSubmitterResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/RemoveSubmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChallengeBlockHeader(ctx context.Context, in *MsgChallengeBlockHeader, opts ...grpc.CallOption) (*MsgChallengeBlockHeaderResponse, error) {
+	out := new(MsgChallengeBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/ChallengeBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveChallenge(ctx context.Context, in *MsgResolveChallenge, opts ...grpc.CallOption) (*MsgResolveChallengeResponse, error) {
+	out := new(MsgResolveChallengeResponse)
+	err := c.cc.Invoke(ctx, "/iritamod.side_chain.v1.Msg/ResolveChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateSpace defines a method for creating a space
+	CreateSpace(context.Context, *MsgCreateSpace) (*MsgCreateSpaceResponse, error)
+	// TransferSpace defines a method for proposing the transfer of a space, which must be accepted by the recipient
+	TransferSpace(context.Context, *MsgTransferSpace) (*MsgTransferSpaceResponse, error)
+	// AcceptSpaceTransfer defines a method for accepting a pending transfer of a space
+	AcceptSpaceTransfer(context.Context, *MsgAcceptSpaceTransfer) (*MsgAcceptSpaceTransferResponse, error)
+	// CancelSpaceTransfer defines a method for cancelling a pending transfer of a space
+	CancelSpaceTransfer(context.Context, *MsgCancelSpaceTransfer) (*MsgCancelSpaceTransferResponse, error)
+	// UpdateSpace defines a method for updating the name and uri of a space
+	UpdateSpace(context.Context, *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error)
+	// FreezeSpace defines a method for freezing a space, which rejects the block headers
+	FreezeSpace(context.Context, *MsgFreezeSpace) (*MsgFreezeSpaceResponse, error)
+	// UnfreezeSpace defines a method for unfreezing a frozen space
+	UnfreezeSpace(context.Context, *MsgUnfreezeSpace) (*MsgUnfreezeSpaceResponse, error)
+	// ArchiveSpace defines a method for retiring a space permanently
+	ArchiveSpace(context.Context, *MsgArchiveSpace) (*MsgArchiveSpaceResponse, error)
+	// RegisterValidatorSet defines a method for registering the initial validator set of the side chain in a space
+	RegisterValidatorSet(context.Context, *MsgRegisterValidatorSet) (*MsgRegisterValidatorSetResponse, error)
+	// PruneBlockHeaders defines a method for pruning the block headers of a space below a height
+	PruneBlockHeaders(context.Context, *MsgPruneBlockHeaders) (*MsgPruneBlockHeadersResponse, error)
+	// CreateBlockHeader defines a method for creating a record
+	CreateBlockHeader(context.Context, *MsgCreateBlockHeader) (*MsgCreateBlockHeaderResponse, error)
+	// CreateBlockHeaders defines a method for creating a contiguous range of layer2 block headers atomically
+	CreateBlockHeaders(context.Context, *MsgCreateBlockHeaders) (*MsgCreateBlockHeadersResponse, error)
+	// AddSubmitter defines a method for authorizing a block header submitter of a space
+	AddSubmitter(context.Context, *MsgAddSubmitter) (*MsgAddSubmitterResponse, error)
+	// RemoveSubmitter defines a method for revoking a block header submitter of a space
+	RemoveSubmitter(context.Context, *MsgRemoveSubmitter) (*MsgRemoveSubmitterResponse, error)
+	// ChallengeBlockHeader defines a method for challenging a pending block header
+	ChallengeBlockHeader(context.Context, *MsgChallengeBlockHeader) (*MsgChallengeBlockHeaderResponse, error)
+	// ResolveChallenge defines a method for resolving a challenged block header
+	ResolveChallenge(context.Context, *MsgResolveChallenge) (*MsgResolveChallengeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateSpace(ctx context.Context, req *MsgCreateSpace) (*MsgCreateSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpace not implemented")
+}
+func (*UnimplementedMsgServer) TransferSpace(ctx context.Context, req *MsgTransferSpace) (*MsgTransferSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSpace not implemented")
+}
+func (*UnimplementedMsgServer) AcceptSpaceTransfer(ctx context.Context, req *MsgAcceptSpaceTransfer) (*MsgAcceptSpaceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSpaceTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelSpaceTransfer(ctx context.Context, req *MsgCancelSpaceTransfer) (*MsgCancelSpaceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSpaceTransfer not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSpace(ctx context.Context, req *MsgUpdateSpace) (*MsgUpdateSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpace not implemented")
+}
+func (*UnimplementedMsgServer) FreezeSpace(ctx context.Context, req *MsgFreezeSpace) (*MsgFreezeSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeSpace not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeSpace(ctx context.Context, req *MsgUnfreezeSpace) (*MsgUnfreezeSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeSpace not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveSpace(ctx context.Context, req *MsgArchiveSpace) (*MsgArchiveSpaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSpace not implemented")
+}
+func (*UnimplementedMsgServer) RegisterValidatorSet(ctx context.Context, req *MsgRegisterValidatorSet) (*MsgRegisterValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterValidatorSet not implemented")
+}
+func (*UnimplementedMsgServer) PruneBlockHeaders(ctx context.Context, req *MsgPruneBlockHeaders) (*MsgPruneBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBlockHeaders not implemented")
+}
+func (*UnimplementedMsgServer) CreateBlockHeader(ctx context.Context, req *MsgCreateBlockHeader) (*MsgCreateBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlockHeader not implemented")
+}
+func (*UnimplementedMsgServer) CreateBlockHeaders(ctx context.Context, req *MsgCreateBlockHeaders) (*MsgCreateBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlockHeaders not implemented")
+}
+func (*UnimplementedMsgServer) AddSubmitter(ctx context.Context, req *MsgAddSubmitter) (*MsgAddSubmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubmitter not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSubmitter(ctx context.Context, req *MsgRemoveSubmitter) (*MsgRemoveSubmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubmitter not implemented")
+}
+func (*UnimplementedMsgServer) ChallengeBlockHeader(ctx context.Context, req *MsgChallengeBlockHeader) (*MsgChallengeBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChallengeBlockHeader not implemented")
+}
+func (*UnimplementedMsgServer) ResolveChallenge(ctx context.Context, req *MsgResolveChallenge) (*MsgResolveChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveChallenge not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/CreateSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSpace(ctx, req.(*MsgCreateSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/TransferSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferSpace(ctx, req.(*MsgTransferSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptSpaceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptSpaceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptSpaceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/AcceptSpaceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptSpaceTransfer(ctx, req.(*MsgAcceptSpaceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSpaceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSpaceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSpaceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/CancelSpaceTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSpaceTransfer(ctx, req.(*MsgCancelSpaceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/UpdateSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSpace(ctx, req.(*MsgUpdateSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/FreezeSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeSpace(ctx, req.(*MsgFreezeSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/UnfreezeSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeSpace(ctx, req.(*MsgUnfreezeSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveSpace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/ArchiveSpace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveSpace(ctx, req.(*MsgArchiveSpace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/RegisterValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterValidatorSet(ctx, req.(*MsgRegisterValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneBlockHeaders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/PruneBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneBlockHeaders(ctx, req.(*MsgPruneBlockHeaders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/CreateBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBlockHeader(ctx, req.(*MsgCreateBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBlockHeaders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/CreateBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBlockHeaders(ctx, req.(*MsgCreateBlockHeaders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddSubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSubmitter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSubmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/AddSubmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSubmitter(ctx, req.(*MsgAddSubmitter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSubmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSubmitter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSubmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/RemoveSubmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSubmitter(ctx, req.(*MsgRemoveSubmitter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChallengeBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChallengeBlockHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChallengeBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/ChallengeBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChallengeBlockHeader(ctx, req.(*MsgChallengeBlockHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iritamod.side_chain.v1.Msg/ResolveChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveChallenge(ctx, req.(*MsgResolveChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "iritamod.side_chain.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSpace",
+			Handler:    _Msg_CreateSpace_Handler,
+		},
+		{
+			MethodName: "TransferSpace",
+			Handler:    _Msg_TransferSpace_Handler,
+		},
+		{
+			MethodName: "AcceptSpaceTransfer",
+			Handler:    _Msg_AcceptSpaceTransfer_Handler,
+		},
+		{
+			MethodName: "CancelSpaceTransfer",
+			Handler:    _Msg_CancelSpaceTransfer_Handler,
+		},
+		{
+			MethodName: "UpdateSpace",
+			Handler:    _Msg_UpdateSpace_Handler,
+		},
+		{
+			MethodName: "FreezeSpace",
+			Handler:    _Msg_FreezeSpace_Handler,
+		},
+		{
+			MethodName: "UnfreezeSpace",
+			Handler:    _Msg_UnfreezeSpace_Handler,
+		},
+		{
+			MethodName: "ArchiveSpace",
+			Handler:    _Msg_ArchiveSpace_Handler,
+		},
+		{
+			MethodName: "RegisterValidatorSet",
+			Handler:    _Msg_RegisterValidatorSet_Handler,
+		},
+		{
+			MethodName: "PruneBlockHeaders",
+			Handler:    _Msg_PruneBlockHeaders_Handler,
+		},
+		{
+			MethodName: "CreateBlockHeader",
+			Handler:    _Msg_CreateBlockHeader_Handler,
+		},
+		{
+			MethodName: "CreateBlockHeaders",
+			Handler:    _Msg_CreateBlockHeaders_Handler,
+		},
+		{
+			MethodName: "AddSubmitter",
+			Handler:    _Msg_AddSubmitter_Handler,
+		},
+		{
+			MethodName: "RemoveSubmitter",
+			Handler:    _Msg_RemoveSubmitter_Handler,
+		},
+		{
+			MethodName: "ChallengeBlockHeader",
+			Handler:    _Msg_ChallengeBlockHeader_Handler,
+		},
+		{
+			MethodName: "ResolveChallenge",
+			Handler:    _Msg_ResolveChallenge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "side-chain/v1/tx.proto",
+}
+
+func (m *MsgCreateSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSpaceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSpaceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSpaceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptSpaceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptSpaceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptSpaceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSpaceTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSpaceTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSpaceTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSpaceTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSpaceTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSpaceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveSpace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveSpace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveSpace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveSpaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveSpaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveSpaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneBlockHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneBlockHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneBlockHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Pruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StructuredHeader != nil {
+		{
+			size, err := m.StructuredHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBlockHeaders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBlockHeaders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBlockHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StructuredHeader != nil {
+		{
+			size, err := m.StructuredHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBlockHeadersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBlockHeadersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBlockHeadersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSubmitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSubmitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSubmitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxPerBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSubmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSubmitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSubmitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSubmitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSubmitterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSubmitterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSubmitterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConflictingHeader != nil {
+		{
+			size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChallengeBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChallengeBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChallengeBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Upheld {
+		i--
+		if m.Upheld {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpaceId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpaceId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengePeriod != 0 {
+		n += 1 + sovTx(uint64(m.ChallengePeriod))
+	}
+	return n
+}
+
+func (m *MsgCreateSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	return n
+}
+
+func (m *MsgTransferSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgTransferSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptSpaceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptSpaceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSpaceTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSpaceTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgArchiveSpace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgArchiveSpaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneBlockHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneBlockHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		n += 1 + sovTx(uint64(m.Pruned))
+	}
+	return n
+}
+
+func (m *MsgCreateBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StructuredHeader != nil {
+		l = m.StructuredHeader.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBlockHeaders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StructuredHeader != nil {
+		l = m.StructuredHeader.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBlockHeadersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSubmitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovTx(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovTx(uint64(m.MaxHeight))
+	}
+	if m.MaxPerBlock != 0 {
+		n += 1 + sovTx(uint64(m.MaxPerBlock))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSubmitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveSubmitterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChallengeBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.ConflictingHeader != nil {
+		l = m.ConflictingHeader.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChallengeBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpaceId != 0 {
+		n += 1 + sovTx(uint64(m.SpaceId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Upheld {
+		n += 2
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResolveChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePeriod", wireType)
+			}
+			m.ChallengePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptSpaceTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptSpaceTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptSpaceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptSpaceTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptSpaceTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptSpaceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpaceTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpaceTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpaceTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSpaceTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSpaceTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSpaceTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveSpace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveSpace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveSpaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveSpaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneBlockHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneBlockHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneBlockHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneBlockHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			m.Pruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredHeader == nil {
+				m.StructuredHeader = &StructuredHeader{}
+			}
+			if err := m.StructuredHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &HeaderCommit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBlockHeaders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBlockHeaders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBlockHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, BatchBlockHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructuredHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StructuredHeader == nil {
+				m.StructuredHeader = &StructuredHeader{}
+			}
+			if err := m.StructuredHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &HeaderCommit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBlockHeadersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBlockHeadersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBlockHeadersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSubmitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSubmitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSubmitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			m.SpaceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpaceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerBlock", wireType)
+			}
+			m.MaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgTransferSpaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgCreateBlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SpaceId != 0 {
-		n += 1 + sovTx(uint64(m.SpaceId))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	l = len(m.Header)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddSubmitterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSubmitterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCreateBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSubmitter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -878,17 +7002,17 @@ func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSpace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSubmitter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSubmitter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			var stringLen uint64
+			m.SpaceId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -898,27 +7022,14 @@ func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpaceId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -946,7 +7057,7 @@ func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uri = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1001,7 +7112,7 @@ func (m *MsgCreateSpace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateSpaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveSubmitterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1024,31 +7135,12 @@ func (m *MsgCreateSpaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateSpaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveSubmitterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveSubmitterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
-			}
-			m.SpaceId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpaceId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1070,7 +7162,7 @@ func (m *MsgCreateSpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
+func (m *MsgChallengeBlockHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1093,10 +7185,10 @@ func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferSpace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChallengeBlockHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferSpace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChallengeBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1119,8 +7211,63 @@ func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = &ConflictingHeader{}
+			}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1148,11 +7295,11 @@ func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Evidence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,7 +7327,7 @@ func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Challenger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1203,7 +7350,7 @@ func (m *MsgTransferSpace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferSpaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChallengeBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1226,10 +7373,10 @@ func (m *MsgTransferSpaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferSpaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChallengeBlockHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferSpaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChallengeBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1253,7 +7400,7 @@ func (m *MsgTransferSpaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveChallenge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1276,10 +7423,10 @@ func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveChallenge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1321,10 +7468,10 @@ func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upheld", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1334,24 +7481,12 @@ func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Header = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Upheld = bool(v != 0)
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
@@ -1405,7 +7540,7 @@ func (m *MsgCreateBlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolveChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1428,10 +7563,10 @@ func (m *MsgCreateBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolveChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolveChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
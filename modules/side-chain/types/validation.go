@@ -10,3 +10,11 @@ func ValidateSpaceId(spaceId uint64) error {
 	}
 	return nil
 }
+
+// ValidateHeightRange validates the height range, in which 0 stands for no limit
+func ValidateHeightRange(minHeight, maxHeight uint64) error {
+	if maxHeight != 0 && minHeight > maxHeight {
+		return sdkerrors.Wrapf(ErrInvalidSubmitter, "min height (%d) cannot be greater than max height (%d)", minHeight, maxHeight)
+	}
+	return nil
+}
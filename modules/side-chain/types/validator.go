@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+const (
+	MaxValidators = 300 // maximum number of the validators of a side chain
+
+	// MaxTotalPower is the maximum total power of the validators of a side chain,
+	// which is low enough to avoid overflow when checking the signed power
+	MaxTotalPower = int64(math.MaxInt64) / 8
+)
+
+// NewValidator creates a new Validator instance with the bech32 encoded consensus public key
+func NewValidator(pubKey cryptotypes.PubKey, power int64) (Validator, error) {
+	pkStr, err := bech32.ConvertAndEncode(sdk.GetConfig().GetBech32ConsensusPubPrefix(), legacy.Cdc.MustMarshal(pubKey))
+	if err != nil {
+		return Validator{}, err
+	}
+
+	return Validator{
+		PubKey: pkStr,
+		Power:  power,
+	}, nil
+}
+
+// ConsPubKey returns the validator PubKey as a cryptotypes.PubKey.
+func (v Validator) ConsPubKey() (cryptotypes.PubKey, error) {
+	return parseConsPubKey(v.PubKey)
+}
+
+// ValidateValidators validates the validators of a side chain
+func ValidateValidators(validators []Validator) error {
+	if len(validators) == 0 || len(validators) > MaxValidators {
+		return sdkerrors.Wrapf(ErrInvalidValidatorSet, "number of validators must be 1 ~ %d", MaxValidators)
+	}
+
+	var totalPower int64
+	seenPubKeys := make(map[string]bool)
+	for _, v := range validators {
+		if _, err := v.ConsPubKey(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "invalid validator public key (%s)", v.PubKey)
+		}
+
+		if v.Power <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "power of validator (%s) must be positive", v.PubKey)
+		}
+
+		if seenPubKeys[v.PubKey] {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "duplicate validator (%s)", v.PubKey)
+		}
+		seenPubKeys[v.PubKey] = true
+
+		totalPower += v.Power
+		if v.Power > MaxTotalPower || totalPower > MaxTotalPower {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "total power of validators must not be greater than %d", MaxTotalPower)
+		}
+	}
+
+	return nil
+}
+
+// GetValidatorsHash returns the hash of the validators, empty if no validators
+func GetValidatorsHash(validators []Validator) tmbytes.HexBytes {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	bz, err := (&ValidatorSet{Validators: validators}).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidatorSignBytes returns the bytes to be signed by the validators of the side chain for the block header,
+// which commit to the next validators if the validator set is updated by the header. The chain id binds the
+// signatures to the chain the header is submitted to. These are not the canonical Tendermint vote sign bytes,
+// so the commits of a Tendermint side chain can not be reused and the validators must sign them explicitly
+func ValidatorSignBytes(chainID string, spaceId, height uint64, hash tmbytes.HexBytes, nextValidators []Validator) []byte {
+	bz, err := json.Marshal(struct {
+		ChainID            string `json:"chain_id"`
+		SpaceId            string `json:"space_id"`
+		Height             string `json:"height"`
+		Hash               string `json:"hash"`
+		NextValidatorsHash string `json:"next_validators_hash"`
+	}{
+		ChainID:            chainID,
+		SpaceId:            strconv.FormatUint(spaceId, 10),
+		Height:             strconv.FormatUint(height, 10),
+		Hash:               hash.String(),
+		NextValidatorsHash: GetValidatorsHash(nextValidators).String(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// Validate validates the header commit
+func (c HeaderCommit) Validate() error {
+	if len(c.Signatures) == 0 {
+		return sdkerrors.Wrapf(ErrInsufficientSignatures, "signatures cannot be empty")
+	}
+
+	for _, sig := range c.Signatures {
+		if len(sig.PubKey) == 0 || len(sig.Signature) == 0 {
+			return sdkerrors.Wrapf(ErrInsufficientSignatures, "public key and signature of the validator cannot be empty")
+		}
+	}
+
+	if len(c.NextValidators) > 0 {
+		return ValidateValidators(c.NextValidators)
+	}
+
+	return nil
+}
+
+func parseConsPubKey(pubKey string) (cryptotypes.PubKey, error) {
+	bz, err := sdk.GetFromBech32(pubKey, sdk.GetConfig().GetBech32ConsensusPubPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return legacy.PubKeyFromBytes(bz)
+}
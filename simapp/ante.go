@@ -0,0 +1,76 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	identitykeeper "github.com/aadhi0612/iritamod/modules/identity/keeper"
+	opbkeeper "github.com/aadhi0612/iritamod/modules/opb/keeper"
+	permkeeper "github.com/aadhi0612/iritamod/modules/perm/keeper"
+)
+
+// HandlerOptions extends the SDK ante handler options with the keepers
+// required by the iritamod decorators
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	PermKeeper     *permkeeper.Keeper
+	IdentityKeeper *identitykeeper.Keeper
+	OpbKeeper      *opbkeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK ante handler with the identity based tx
+// authentication in place of the SDK extension option and signature checks,
+// along with the OPB token transfer restrictions and point token fees
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.PermKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "perm keeper is required for ante builder")
+	}
+
+	if options.IdentityKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "identity keeper is required for ante builder")
+	}
+
+	if options.OpbKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "opb keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		identitykeeper.NewExtensionOptionsDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		identitykeeper.NewValidateIdentityDecorator(options.PermKeeper),
+		opbkeeper.NewValidateTokenTransferDecorator(*options.OpbKeeper).DefaultValidateFn(),
+		// the fee paid in the point token is deducted to the point token fee collector,
+		// otherwise it falls back to the default mempool fee and deduct fee decorators
+		opbkeeper.NewPointTokenFeeDecorator(
+			*options.OpbKeeper,
+			options.FeegrantKeeper,
+			ante.NewMempoolFeeDecorator(),
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, identitykeeper.NewSigVerificationGasConsumer(ante.DefaultSigVerificationGasConsumer)),
+		identitykeeper.NewSigVerificationDecorator(*options.IdentityKeeper, options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
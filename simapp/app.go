@@ -69,6 +69,9 @@ import (
 	"github.com/aadhi0612/iritamod/modules/node"
 	nodekeeper "github.com/aadhi0612/iritamod/modules/node/keeper"
 	nodetypes "github.com/aadhi0612/iritamod/modules/node/types"
+	"github.com/aadhi0612/iritamod/modules/opb"
+	opbkeeper "github.com/aadhi0612/iritamod/modules/opb/keeper"
+	opbtypes "github.com/aadhi0612/iritamod/modules/opb/types"
 	cparams "github.com/aadhi0612/iritamod/modules/params"
 	"github.com/aadhi0612/iritamod/modules/perm"
 	permkeeper "github.com/aadhi0612/iritamod/modules/perm/keeper"
@@ -107,6 +110,7 @@ var (
 		identity.AppModuleBasic{},
 		node.AppModuleBasic{},
 		sidechain.AppModuleBasic{},
+		opb.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -114,6 +118,11 @@ var (
 		authtypes.FeeCollectorName: nil,
 		//gov.ModuleName:                  {authtypes.Burner},
 		sidechaintypes.ModuleName: nil,
+		TokenModuleName:           {authtypes.Minter, authtypes.Burner},
+
+		opbtypes.PointTokenFeeCollectorName: nil,
+		opbtypes.RedemptionEscrowName:       {authtypes.Burner},
+		opbtypes.EscrowAccountName:          nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -152,6 +161,8 @@ type SimApp struct {
 	NodeKeeper      nodekeeper.Keeper
 	FeeGrantKeeper  feegrantkeeper.Keeper
 	SideChainKeeper sidechainkeeper.Keeper
+	TokenKeeper     *TokenKeeper
+	OpbKeeper       opbkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -202,6 +213,7 @@ func NewSimApp(
 		identitytypes.StoreKey,
 		nodetypes.StoreKey,
 		sidechaintypes.StoreKey,
+		opbtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -250,9 +262,16 @@ func NewSimApp(
 		stakingtypes.NewMultiStakingHooks(app.SlashingKeeper.Hooks()),
 	)
 	app.PermKeeper = permkeeper.NewKeeper(appCodec, keys[permtypes.StoreKey])
-	app.IdentityKeeper = identitykeeper.NewKeeper(appCodec, keys[identitytypes.StoreKey])
+	app.IdentityKeeper = identitykeeper.NewKeeper(appCodec, keys[identitytypes.StoreKey], app.GetSubspace(identitytypes.ModuleName))
+
+	app.SideChainKeeper = sidechainkeeper.NewKeeper(appCodec, keys[sidechaintypes.StoreKey], app.GetSubspace(sidechaintypes.ModuleName), app.AccountKeeper, app.BankKeeper)
 
-	app.SideChainKeeper = sidechainkeeper.NewKeeper(appCodec, keys[sidechaintypes.StoreKey], app.AccountKeeper)
+	app.TokenKeeper = NewTokenKeeper(app.BankKeeper)
+	app.TokenKeeper.AddToken(opbtypes.DefaultBaseTokenDenom, nil)
+	app.TokenKeeper.AddToken(opbtypes.DefaultPointTokenDenom, nil)
+	app.OpbKeeper = opbkeeper.NewKeeper(
+		appCodec, keys[opbtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.TokenKeeper, app.PermKeeper, keys[paramstypes.StoreKey],
+	)
 
 	/****  Module Options ****/
 
@@ -265,7 +284,7 @@ func NewSimApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.AccountKeeper, app.NodeKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		NewBankModule(appCodec, app.BankKeeper, app.AccountKeeper, &app.OpbKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		//gov.NewAppModule(appCodec, app.govKeeper, app.AccountKeeper, app.BankKeeper),
@@ -277,6 +296,7 @@ func NewSimApp(
 		identity.NewAppModule(app.IdentityKeeper),
 		node.NewAppModule(appCodec, app.NodeKeeper),
 		sidechain.NewAppModule(appCodec, app.SideChainKeeper),
+		opb.NewAppModule(appCodec, app.OpbKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -297,6 +317,7 @@ func NewSimApp(
 		paramstypes.ModuleName,
 		genutiltypes.ModuleName,
 		sidechaintypes.ModuleName,
+		opbtypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -313,6 +334,7 @@ func NewSimApp(
 		paramstypes.ModuleName,
 		genutiltypes.ModuleName,
 		sidechaintypes.ModuleName,
+		opbtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -334,6 +356,7 @@ func NewSimApp(
 		paramstypes.ModuleName,
 		genutiltypes.ModuleName,
 		sidechaintypes.ModuleName,
+		opbtypes.ModuleName,
 	)
 
 	app.mm.SetOrderMigrations(
@@ -350,6 +373,7 @@ func NewSimApp(
 		paramstypes.ModuleName,
 		genutiltypes.ModuleName,
 		sidechaintypes.ModuleName,
+		opbtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -387,13 +411,17 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+			},
+			PermKeeper:     &app.PermKeeper,
+			IdentityKeeper: &app.IdentityKeeper,
+			OpbKeeper:      &app.OpbKeeper,
 		},
 	)
 	if err != nil {
@@ -561,6 +589,8 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	ParamsKeeper.Subspace(authtypes.ModuleName)
 	ParamsKeeper.Subspace(banktypes.ModuleName)
 	ParamsKeeper.Subspace(nodetypes.ModuleName)
+	ParamsKeeper.Subspace(identitytypes.ModuleName)
+	ParamsKeeper.Subspace(sidechaintypes.ModuleName)
 	ParamsKeeper.Subspace(slashingtypes.ModuleName)
 	ParamsKeeper.Subspace(crisistypes.ModuleName)
 
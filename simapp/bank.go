@@ -0,0 +1,38 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	opbkeeper "github.com/aadhi0612/iritamod/modules/opb/keeper"
+)
+
+// BankModule is the bank module whose msgs charge the OPB transfer limits upon execution
+type BankModule struct {
+	bank.AppModule
+
+	keeper    bankkeeper.Keeper
+	opbKeeper *opbkeeper.Keeper
+}
+
+// NewBankModule creates a new BankModule instance
+func NewBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, accountKeeper banktypes.AccountKeeper, opbKeeper *opbkeeper.Keeper) BankModule {
+	return BankModule{
+		AppModule: bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:    keeper,
+		opbKeeper: opbKeeper,
+	}
+}
+
+// RegisterServices registers the bank services with the msg server wrapping
+// the bank keeper by the OPB TransferLimitBankKeeper
+func (am BankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(opbkeeper.NewTransferLimitBankKeeper(am.keeper, am.opbKeeper)))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.(bankkeeper.BaseKeeper))
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
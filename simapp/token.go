@@ -0,0 +1,77 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	opbtypes "github.com/aadhi0612/iritamod/modules/opb/types"
+)
+
+// TokenModuleName is the name of the module account minting the tokens of the TokenKeeper
+const TokenModuleName = "token"
+
+// Token defines a token registered in the TokenKeeper, whose symbol is also the denom
+type Token struct {
+	Symbol string
+	Owner  sdk.AccAddress
+}
+
+// GetSymbol implements opbtypes.Token
+func (t Token) GetSymbol() string {
+	return t.Symbol
+}
+
+// TokenKeeper is a minimal token keeper required by the OPB module, as the token
+// module is not part of the SimApp. The tokens are minted through the bank module
+type TokenKeeper struct {
+	bankKeeper bankkeeper.Keeper
+	tokens     map[string]Token
+}
+
+// NewTokenKeeper creates a new TokenKeeper instance
+func NewTokenKeeper(bankKeeper bankkeeper.Keeper) *TokenKeeper {
+	return &TokenKeeper{
+		bankKeeper: bankKeeper,
+		tokens:     make(map[string]Token),
+	}
+}
+
+// AddToken registers the token of the given symbol, replacing the existing one if any
+func (k *TokenKeeper) AddToken(symbol string, owner sdk.AccAddress) {
+	k.tokens[symbol] = Token{Symbol: symbol, Owner: owner}
+}
+
+// GetToken implements opbtypes.TokenKeeper
+func (k *TokenKeeper) GetToken(_ sdk.Context, denom string) (opbtypes.Token, error) {
+	token, ok := k.tokens[denom]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "token %s does not exist", denom)
+	}
+
+	return token, nil
+}
+
+// GetOwner implements opbtypes.TokenKeeper
+func (k *TokenKeeper) GetOwner(_ sdk.Context, denom string) (sdk.AccAddress, error) {
+	token, ok := k.tokens[denom]
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "token %s does not exist", denom)
+	}
+
+	return token.Owner, nil
+}
+
+// MintToken implements opbtypes.TokenKeeper
+func (k *TokenKeeper) MintToken(ctx sdk.Context, symbol string, amount uint64, recipient sdk.AccAddress, _ sdk.AccAddress) error {
+	if _, ok := k.tokens[symbol]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "token %s does not exist", symbol)
+	}
+
+	mintedCoins := sdk.NewCoins(sdk.NewCoin(symbol, sdk.NewIntFromUint64(amount)))
+	if err := k.bankKeeper.MintCoins(ctx, TokenModuleName, mintedCoins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, TokenModuleName, recipient, mintedCoins)
+}